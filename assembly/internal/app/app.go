@@ -12,6 +12,7 @@ import (
 	"github.com/radiophysiker/microservices-homework/platform/pkg/closer"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/metrics"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
 )
 
 type App struct {
@@ -57,6 +58,30 @@ func (a *App) Run(ctx context.Context) error {
 		return nil
 	})
 
+	// Запускаем consumer для OrderCancelled
+	g.Go(func() error {
+		orderCancelledConsumerService, err := a.diContainer.OrderCancelledConsumerService(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to get OrderCancelledConsumerService", zap.Error(err))
+			return err
+		}
+
+		logger.Info(ctx, "Starting OrderCancelled consumer")
+
+		if err := orderCancelledConsumerService.RunConsumer(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				logger.Info(ctx, "OrderCancelled consumer stopped")
+				return nil
+			}
+
+			logger.Error(ctx, "OrderCancelled consumer error", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+
 	// Завершаем по ctx
 	g.Go(func() error {
 		<-ctx.Done()
@@ -77,6 +102,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.initTracing,
 		a.initMetrics,
 	}
 
@@ -110,6 +136,16 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initTracing(ctx context.Context) error {
+	if err := tracing.InitTracer(ctx, config.AppConfig().Tracing); err != nil {
+		return err
+	}
+
+	closer.AddNamed("Tracer", tracing.ShutdownTracer)
+
+	return nil
+}
+
 func (a *App) initMetrics(ctx context.Context) error {
 	if err := metrics.InitProvider(ctx, config.AppConfig().Metrics); err != nil {
 		return err
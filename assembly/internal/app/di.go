@@ -3,39 +3,190 @@ package app
 import (
 	"context"
 	"fmt"
+	"net"
+	"time"
 
 	"github.com/IBM/sarama"
+	redigo "github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
 
 	"github.com/radiophysiker/microservices-homework/assembly/internal/config"
 	kafkaConverter "github.com/radiophysiker/microservices-homework/assembly/internal/converter/kafka"
 	"github.com/radiophysiker/microservices-homework/assembly/internal/converter/kafka/decoder"
 	svc "github.com/radiophysiker/microservices-homework/assembly/internal/service"
+	buildRegistrySvc "github.com/radiophysiker/microservices-homework/assembly/internal/service/build_registry"
+	orderCancelledConsumerSvc "github.com/radiophysiker/microservices-homework/assembly/internal/service/consumer/order_cancelled_consumer"
 	orderConsumerSvc "github.com/radiophysiker/microservices-homework/assembly/internal/service/consumer/order_consumer"
 	orderProducerSvc "github.com/radiophysiker/microservices-homework/assembly/internal/service/producer/order_producer"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/cache"
+	redisclient "github.com/radiophysiker/microservices-homework/platform/pkg/cache/redis"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/closer"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
 	kafkaConsumer "github.com/radiophysiker/microservices-homework/platform/pkg/kafka/consumer"
+	dedupRedis "github.com/radiophysiker/microservices-homework/platform/pkg/kafka/dedup/redis"
 	kafkaProducer "github.com/radiophysiker/microservices-homework/platform/pkg/kafka/producer"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
 )
 
 type diContainer struct {
+	deadLetterSyncProducer sarama.SyncProducer
+
+	redisPool   *redigo.Pool
+	redisClient cache.RedisClient
+
 	orderPaidConsumerGroup sarama.ConsumerGroup
 	orderPaidConsumer      kafka.Consumer
 
+	orderCancelledConsumerGroup sarama.ConsumerGroup
+	orderCancelledConsumer      kafka.Consumer
+
 	shipAssembledSyncProducer sarama.SyncProducer
 	shipAssembledProducer     kafka.Producer
 
-	orderPaidDecoder kafkaConverter.OrderPaidDecoder
+	orderPaidDecoder      kafkaConverter.OrderPaidDecoder
+	orderCancelledDecoder kafkaConverter.OrderCancelledDecoder
 
-	orderConsumerService         svc.OrderConsumerService
-	shipAssembledProducerService svc.ShipAssembledProducerService
+	buildRegistryService          svc.BuildRegistryService
+	orderConsumerService          svc.OrderConsumerService
+	orderCancelledConsumerService svc.OrderCancelledConsumerService
+	shipAssembledProducerService  svc.ShipAssembledProducerService
 }
 
 func newDiContainer() *diContainer {
 	return &diContainer{}
 }
 
+func (d *diContainer) DeadLetterSyncProducer(_ context.Context) (sarama.SyncProducer, error) {
+	if d.deadLetterSyncProducer == nil {
+		producerCfg := sarama.NewConfig()
+		producerCfg.Version = sarama.V4_0_0_0
+		producerCfg.Producer.Return.Successes = true
+
+		producer, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			producerCfg,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create dead letter sync producer: %w", err)
+		}
+
+		closer.AddNamed("Dead letter sync producer", func(ctx context.Context) error {
+			return producer.Close()
+		})
+
+		d.deadLetterSyncProducer = producer
+	}
+
+	return d.deadLetterSyncProducer, nil
+}
+
+// ConsumerMiddlewares возвращает цепочку middleware consumer'а: обработка продолжает трейс
+// producer'а, выполняется с повторами, а после исчерпания попыток сообщение отправляется в <topic>.dlq
+func (d *diContainer) ConsumerMiddlewares(ctx context.Context, extra ...kafkaConsumer.Middleware) ([]kafkaConsumer.Middleware, error) {
+	deadLetterProducer, err := d.DeadLetterSyncProducer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	retryCfg := config.AppConfig().ConsumerRetry
+	retryPolicy := kafkaConsumer.RetryPolicy{
+		MaxAttempts:    retryCfg.MaxAttempts(),
+		InitialBackoff: retryCfg.InitialBackoff(),
+		MaxBackoff:     retryCfg.MaxBackoff(),
+	}
+
+	middlewares := []kafkaConsumer.Middleware{
+		kafkaConsumer.Tracing(),
+		kafkaConsumer.DeadLetter(deadLetterProducer, logger.Logger()),
+		kafkaConsumer.Retry(retryPolicy, logger.Logger()),
+	}
+
+	return append(middlewares, extra...), nil
+}
+
+// RedisPool возвращает пул соединений Redis с lazy initialization.
+func (d *diContainer) RedisPool(ctx context.Context) (*redigo.Pool, error) {
+	if d.redisPool == nil {
+		cfg := config.AppConfig()
+		redisCfg := cfg.Redis
+
+		address := net.JoinHostPort(redisCfg.Host(), redisCfg.Port())
+
+		pool := &redigo.Pool{
+			MaxIdle:     redisCfg.MaxIdle(),
+			IdleTimeout: redisCfg.IdleTimeout(),
+			Dial: func() (redigo.Conn, error) {
+				conn, err := redigo.Dial("tcp", address)
+				if err != nil {
+					return nil, fmt.Errorf("dial redis: %w", err)
+				}
+				return conn, nil
+			},
+			TestOnBorrow: func(c redigo.Conn, t time.Time) error {
+				if time.Since(t) < time.Minute {
+					return nil
+				}
+				_, err := c.Do("PING")
+				return err
+			},
+		}
+
+		conn := pool.Get()
+		defer func() {
+			if err := conn.Close(); err != nil {
+				logger.Error(ctx, "failed to close redis connection", zap.Error(err))
+			}
+		}()
+
+		if _, err := conn.Do("PING"); err != nil {
+			if err := pool.Close(); err != nil {
+				logger.Error(ctx, "failed to close redis pool", zap.Error(err))
+			}
+
+			return nil, fmt.Errorf("ping redis: %w", err)
+		}
+
+		closer.AddNamed("Redis pool", func(ctx context.Context) error {
+			return pool.Close()
+		})
+
+		d.redisPool = pool
+	}
+
+	return d.redisPool, nil
+}
+
+// RedisClient возвращает Redis клиент с lazy initialization.
+func (d *diContainer) RedisClient(ctx context.Context) (cache.RedisClient, error) {
+	if d.redisClient == nil {
+		redisPool, err := d.RedisPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.redisClient = redisclient.NewClient(
+			redisPool,
+			logger.Logger(),
+			config.AppConfig().Redis.ConnectionTimeout(),
+		)
+	}
+
+	return d.redisClient, nil
+}
+
+// Deduplicate возвращает middleware, пропускающее события, уже обработанные consumer group.
+func (d *diContainer) Deduplicate(ctx context.Context, groupID string, eventID kafkaConsumer.EventIDFunc) (kafkaConsumer.Middleware, error) {
+	redisClient, err := d.RedisClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	store := dedupRedis.NewStore(redisClient, "processed_events:"+groupID, config.AppConfig().ProcessedEvents.TTL())
+
+	return kafkaConsumer.Deduplicate(store, eventID, logger.Logger()), nil
+}
+
 func (d *diContainer) OrderPaidConsumerGroup(ctx context.Context) (sarama.ConsumerGroup, error) {
 	if d.orderPaidConsumerGroup == nil {
 		cfg := config.AppConfig()
@@ -60,6 +211,30 @@ func (d *diContainer) OrderPaidConsumerGroup(ctx context.Context) (sarama.Consum
 	return d.orderPaidConsumerGroup, nil
 }
 
+func (d *diContainer) OrderCancelledConsumerGroup(ctx context.Context) (sarama.ConsumerGroup, error) {
+	if d.orderCancelledConsumerGroup == nil {
+		cfg := config.AppConfig()
+		consumerCfg := cfg.OrderCancelledConsumer
+
+		group, err := sarama.NewConsumerGroup(
+			cfg.Kafka.Brokers(),
+			consumerCfg.GroupID(),
+			consumerCfg.Config(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create consumer group: %w", err)
+		}
+
+		closer.AddNamed("OrderCancelled consumer group", func(ctx context.Context) error {
+			return group.Close()
+		})
+
+		d.orderCancelledConsumerGroup = group
+	}
+
+	return d.orderCancelledConsumerGroup, nil
+}
+
 func (d *diContainer) ShipAssembledSyncProducer(ctx context.Context) (sarama.SyncProducer, error) {
 	if d.shipAssembledSyncProducer == nil {
 		cfg := config.AppConfig()
@@ -93,16 +268,57 @@ func (d *diContainer) OrderPaidConsumer(ctx context.Context) (kafka.Consumer, er
 		cfg := config.AppConfig()
 		topics := []string{cfg.OrderPaidConsumer.Topic()}
 
+		deduplicate, err := d.Deduplicate(
+			ctx,
+			cfg.OrderPaidConsumer.GroupID(),
+			kafkaConsumer.ProtoEventID(func() *eventspb.OrderPaid { return &eventspb.OrderPaid{} }),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		middlewares, err := d.ConsumerMiddlewares(ctx, deduplicate)
+		if err != nil {
+			return nil, err
+		}
+
 		d.orderPaidConsumer = kafkaConsumer.NewConsumer(
 			group,
 			topics,
 			logger.Logger(),
+			middlewares...,
 		)
 	}
 
 	return d.orderPaidConsumer, nil
 }
 
+func (d *diContainer) OrderCancelledConsumer(ctx context.Context) (kafka.Consumer, error) {
+	if d.orderCancelledConsumer == nil {
+		group, err := d.OrderCancelledConsumerGroup(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := config.AppConfig()
+		topics := []string{cfg.OrderCancelledConsumer.Topic()}
+
+		middlewares, err := d.ConsumerMiddlewares(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.orderCancelledConsumer = kafkaConsumer.NewConsumer(
+			group,
+			topics,
+			logger.Logger(),
+			middlewares...,
+		)
+	}
+
+	return d.orderCancelledConsumer, nil
+}
+
 func (d *diContainer) ShipAssembledProducer(ctx context.Context) (kafka.Producer, error) {
 	if d.shipAssembledProducer == nil {
 		syncProducer, err := d.ShipAssembledSyncProducer(ctx)
@@ -131,6 +347,14 @@ func (d *diContainer) OrderPaidDecoder(_ context.Context) (kafkaConverter.OrderP
 	return d.orderPaidDecoder, nil
 }
 
+func (d *diContainer) OrderCancelledDecoder(_ context.Context) (kafkaConverter.OrderCancelledDecoder, error) {
+	if d.orderCancelledDecoder == nil {
+		d.orderCancelledDecoder = decoder.NewOrderCancelledDecoder()
+	}
+
+	return d.orderCancelledDecoder, nil
+}
+
 func (d *diContainer) ShipAssembledProducerService(ctx context.Context) (svc.ShipAssembledProducerService, error) {
 	if d.shipAssembledProducerService == nil {
 		producer, err := d.ShipAssembledProducer(ctx)
@@ -144,6 +368,14 @@ func (d *diContainer) ShipAssembledProducerService(ctx context.Context) (svc.Shi
 	return d.shipAssembledProducerService, nil
 }
 
+func (d *diContainer) BuildRegistryService(_ context.Context) (svc.BuildRegistryService, error) {
+	if d.buildRegistryService == nil {
+		d.buildRegistryService = buildRegistrySvc.NewService()
+	}
+
+	return d.buildRegistryService, nil
+}
+
 func (d *diContainer) OrderConsumerService(ctx context.Context) (svc.OrderConsumerService, error) {
 	if d.orderConsumerService == nil {
 		consumer, err := d.OrderPaidConsumer(ctx)
@@ -161,13 +393,46 @@ func (d *diContainer) OrderConsumerService(ctx context.Context) (svc.OrderConsum
 			return nil, err
 		}
 
+		buildRegistry, err := d.BuildRegistryService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		d.orderConsumerService = orderConsumerSvc.NewService(
 			ctx,
 			consumer,
 			decoder,
 			producerService,
+			buildRegistry,
 		)
 	}
 
 	return d.orderConsumerService, nil
 }
+
+func (d *diContainer) OrderCancelledConsumerService(ctx context.Context) (svc.OrderCancelledConsumerService, error) {
+	if d.orderCancelledConsumerService == nil {
+		consumer, err := d.OrderCancelledConsumer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder, err := d.OrderCancelledDecoder(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		buildRegistry, err := d.BuildRegistryService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.orderCancelledConsumerService = orderCancelledConsumerSvc.NewService(
+			consumer,
+			decoder,
+			buildRegistry,
+		)
+	}
+
+	return d.orderCancelledConsumerService, nil
+}
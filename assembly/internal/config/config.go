@@ -13,8 +13,13 @@ var appConfig *config
 type config struct {
 	Logger                 LoggerConfig
 	Metrics                MetricsConfig
+	Tracing                TracingConfig
 	Kafka                  KafkaConfig
+	ConsumerRetry          ConsumerRetryConfig
+	Redis                  RedisConfig
+	ProcessedEvents        ProcessedEventsConfig
 	OrderPaidConsumer      OrderPaidConsumerConfig
+	OrderCancelledConsumer OrderCancelledConsumerConfig
 	OrderAssembledProducer OrderAssembledProducerConfig
 }
 
@@ -34,16 +39,41 @@ func Load(path ...string) error {
 		return err
 	}
 
+	tracingCfg, err := env.NewTracingConfig()
+	if err != nil {
+		return err
+	}
+
 	kafkaCfg, err := env.NewKafkaConfig()
 	if err != nil {
 		return err
 	}
 
+	consumerRetryCfg, err := env.NewConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
+	redisCfg, err := env.NewRedisConfig()
+	if err != nil {
+		return err
+	}
+
+	processedEventsCfg, err := env.NewProcessedEventsConfig()
+	if err != nil {
+		return err
+	}
+
 	orderPaidConsumerCfg, err := env.NewOrderPaidConsumerConfig()
 	if err != nil {
 		return err
 	}
 
+	orderCancelledConsumerCfg, err := env.NewOrderCancelledConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	orderAssembledProducerCfg, err := env.NewOrderAssembledProducerConfig()
 	if err != nil {
 		return err
@@ -52,8 +82,13 @@ func Load(path ...string) error {
 	appConfig = &config{
 		Logger:                 loggerCfg,
 		Metrics:                metricsCfg,
+		Tracing:                tracingCfg,
 		Kafka:                  kafkaCfg,
+		ConsumerRetry:          consumerRetryCfg,
+		Redis:                  redisCfg,
+		ProcessedEvents:        processedEventsCfg,
 		OrderPaidConsumer:      orderPaidConsumerCfg,
+		OrderCancelledConsumer: orderCancelledConsumerCfg,
 		OrderAssembledProducer: orderAssembledProducerCfg,
 	}
 
@@ -0,0 +1,42 @@
+//nolint:dupl // Файл похож на order_paid_consumer.go, но это разные конфигурации для разных топиков
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type OrderCancelledConsumerEnvConfig struct {
+	Topic   string `env:"ORDER_CANCELLED_TOPIC_NAME,required"`
+	GroupID string `env:"ORDER_CANCELLED_CONSUMER_GROUP_ID,required"`
+}
+
+type orderCancelledConsumerConfig struct {
+	raw OrderCancelledConsumerEnvConfig
+}
+
+func NewOrderCancelledConsumerConfig() (*orderCancelledConsumerConfig, error) {
+	var raw OrderCancelledConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCancelledConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCancelledConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *orderCancelledConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *orderCancelledConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	return config
+}
@@ -0,0 +1,29 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type processedEventsEnvConfig struct {
+	TTL time.Duration `env:"PROCESSED_EVENTS_TTL" envDefault:"168h"`
+}
+
+type processedEventsConfig struct {
+	raw processedEventsEnvConfig
+}
+
+func NewProcessedEventsConfig() (*processedEventsConfig, error) {
+	var raw processedEventsEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &processedEventsConfig{raw: raw}, nil
+}
+
+// TTL — сколько хранить идентификаторы обработанных событий
+func (cfg *processedEventsConfig) TTL() time.Duration {
+	return cfg.raw.TTL
+}
@@ -19,10 +19,35 @@ type MetricsConfig interface {
 	CollectorInterval() time.Duration
 }
 
+type TracingConfig interface {
+	CollectorEndpoint() string
+	ServiceName() string
+	ServiceVersion() string
+	Environment() string
+}
+
 type KafkaConfig interface {
 	Brokers() []string
 }
 
+type ConsumerRetryConfig interface {
+	MaxAttempts() int
+	InitialBackoff() time.Duration
+	MaxBackoff() time.Duration
+}
+
+type RedisConfig interface {
+	Host() string
+	Port() string
+	ConnectionTimeout() time.Duration
+	MaxIdle() int
+	IdleTimeout() time.Duration
+}
+
+type ProcessedEventsConfig interface {
+	TTL() time.Duration
+}
+
 type OrderAssembledProducerConfig interface {
 	Topic() string
 	Config() *sarama.Config
@@ -33,3 +58,9 @@ type OrderPaidConsumerConfig interface {
 	GroupID() string
 	Config() *sarama.Config
 }
+
+type OrderCancelledConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
@@ -5,3 +5,7 @@ import "github.com/radiophysiker/microservices-homework/assembly/internal/model"
 type OrderPaidDecoder interface {
 	Decode(data []byte) (*model.OrderPaid, error)
 }
+
+type OrderCancelledDecoder interface {
+	Decode(data []byte) (*model.OrderCancelled, error)
+}
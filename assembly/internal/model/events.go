@@ -24,6 +24,15 @@ type OrderPaid struct {
 	TransactionUUID uuid.UUID
 }
 
+// OrderCancelled представляет событие об отмене заказа.
+// RefundUUID заполнен, если по заказу был выполнен возврат средств
+type OrderCancelled struct {
+	EventUUID  uuid.UUID
+	OrderUUID  uuid.UUID
+	UserUUID   uuid.UUID
+	RefundUUID *uuid.UUID
+}
+
 // ShipAssembled представляет событие о завершении сборки корабля
 type ShipAssembled struct {
 	EventUUID    uuid.UUID
@@ -0,0 +1,82 @@
+package build_registry
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	svc "github.com/radiophysiker/microservices-homework/assembly/internal/service"
+)
+
+// cancelledRetention - сколько помнить отмененный заказ. Событие OrderCancelled
+// может прийти раньше OrderPaid, так как они публикуются в разные топики
+const cancelledRetention = time.Hour
+
+type service struct {
+	mu        sync.Mutex
+	inFlight  map[uuid.UUID]context.CancelFunc
+	cancelled map[uuid.UUID]time.Time
+}
+
+func NewService() svc.BuildRegistryService {
+	return &service{
+		inFlight:  make(map[uuid.UUID]context.CancelFunc),
+		cancelled: make(map[uuid.UUID]time.Time),
+	}
+}
+
+// Begin регистрирует сборку заказа и возвращает контекст, который отменяется
+// при отмене заказа. Возвращает false, если заказ уже отменен
+func (s *service) Begin(ctx context.Context, orderUUID uuid.UUID) (context.Context, func(), bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.cancelled[orderUUID]; ok {
+		return ctx, func() {}, false
+	}
+
+	buildCtx, cancel := context.WithCancel(ctx)
+	s.inFlight[orderUUID] = cancel
+
+	done := func() {
+		s.mu.Lock()
+		delete(s.inFlight, orderUUID)
+		s.mu.Unlock()
+
+		cancel()
+	}
+
+	return buildCtx, done, true
+}
+
+// Abort помечает заказ отмененным и прерывает его сборку, если она идет.
+// Возвращает true, если сборка была прервана
+func (s *service) Abort(orderUUID uuid.UUID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.pruneCancelled(now)
+	s.cancelled[orderUUID] = now
+
+	cancel, ok := s.inFlight[orderUUID]
+	if !ok {
+		return false
+	}
+
+	cancel()
+	delete(s.inFlight, orderUUID)
+
+	return true
+}
+
+// pruneCancelled удаляет отмененные заказы старше cancelledRetention
+func (s *service) pruneCancelled(now time.Time) {
+	for orderUUID, cancelledAt := range s.cancelled {
+		if now.Sub(cancelledAt) > cancelledRetention {
+			delete(s.cancelled, orderUUID)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package order_cancelled_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+func (s *service) OrderCancelledHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.orderCancelledDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode OrderCancelled event",
+			zap.Error(err),
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+		)
+
+		return err
+	}
+
+	logger.Info(ctx, "OrderCancelled message received",
+		zap.String("topic", msg.Topic),
+		zap.Int32("partition", msg.Partition),
+		zap.Int64("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID.String()),
+		zap.String("order_uuid", event.OrderUUID.String()),
+		zap.String("user_uuid", event.UserUUID.String()),
+	)
+
+	if s.buildRegistry.Abort(event.OrderUUID) {
+		logger.Info(ctx, "Ship assembly aborted for cancelled order",
+			zap.String("order_uuid", event.OrderUUID.String()),
+		)
+	}
+
+	return nil
+}
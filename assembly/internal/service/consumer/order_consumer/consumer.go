@@ -17,6 +17,7 @@ type service struct {
 	orderPaidConsumer     kafka.Consumer
 	orderPaidDecoder      kafkaConverter.OrderPaidDecoder
 	shipAssembledProducer svc.ShipAssembledProducerService
+	buildRegistry         svc.BuildRegistryService
 	assemblyDuration      metric.Float64Histogram
 }
 
@@ -25,6 +26,7 @@ func NewService(
 	orderPaidConsumer kafka.Consumer,
 	orderPaidDecoder kafkaConverter.OrderPaidDecoder,
 	shipAssembledProducer svc.ShipAssembledProducerService,
+	buildRegistry svc.BuildRegistryService,
 ) svc.OrderConsumerService {
 	meter := otel.Meter("assembly-service")
 
@@ -41,6 +43,7 @@ func NewService(
 		orderPaidConsumer:     orderPaidConsumer,
 		orderPaidDecoder:      orderPaidDecoder,
 		shipAssembledProducer: shipAssembledProducer,
+		buildRegistry:         buildRegistry,
 		assemblyDuration:      assemblyDuration,
 	}
 }
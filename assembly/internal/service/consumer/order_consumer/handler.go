@@ -40,6 +40,16 @@ func (s *service) OrderHandler(ctx context.Context, msg kafka.Message) error {
 		zap.Int("payment_method", int(event.PaymentMethod)),
 	)
 
+	buildCtx, done, ok := s.buildRegistry.Begin(ctx, event.OrderUUID)
+	if !ok {
+		logger.Info(ctx, "Order already cancelled, skipping ship assembly",
+			zap.String("order_uuid", event.OrderUUID.String()),
+		)
+
+		return nil
+	}
+	defer done()
+
 	delay, err := getRandomDelaySeconds(1, 10)
 	if err != nil {
 		logger.Error(ctx, "Failed to get random delay",
@@ -57,13 +67,23 @@ func (s *service) OrderHandler(ctx context.Context, msg kafka.Message) error {
 	start := time.Now()
 
 	select {
-	case <-ctx.Done():
-		logger.Info(ctx, "Ship assembly cancelled",
+	case <-buildCtx.Done():
+		// Сообщение не подтверждается только при остановке сервиса;
+		// сборку отмененного заказа повторять не нужно
+		if ctx.Err() != nil {
+			logger.Info(ctx, "Ship assembly cancelled",
+				zap.String("order_uuid", event.OrderUUID.String()),
+				zap.Error(ctx.Err()),
+			)
+
+			return ctx.Err()
+		}
+
+		logger.Info(ctx, "Ship assembly aborted: order cancelled",
 			zap.String("order_uuid", event.OrderUUID.String()),
-			zap.Error(ctx.Err()),
 		)
 
-		return ctx.Err()
+		return nil
 	case <-time.After(delay):
 	}
 
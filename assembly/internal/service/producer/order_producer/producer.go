@@ -36,7 +36,7 @@ func (s *service) ProduceShipAssembled(ctx context.Context, shipAssembled model.
 
 	key := []byte(shipAssembled.OrderUUID.String())
 
-	if err := s.shipAssembledProducer.Send(ctx, key, value); err != nil {
+	if err := s.shipAssembledProducer.Send(ctx, key, value, nil); err != nil {
 		logger.Error(ctx, "Failed to send ShipAssembled event",
 			zap.Error(err),
 			zap.String("order_uuid", shipAssembled.OrderUUID.String()),
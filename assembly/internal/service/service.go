@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/radiophysiker/microservices-homework/assembly/internal/model"
 )
 
@@ -12,6 +14,21 @@ type OrderConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
 
+// OrderCancelledConsumerService представляет интерфейс для consumer'а событий OrderCancelled
+type OrderCancelledConsumerService interface {
+	// RunConsumer запускает consumer для обработки событий OrderCancelled
+	RunConsumer(ctx context.Context) error
+}
+
+// BuildRegistryService отслеживает идущие сборки, чтобы прерывать их при отмене заказа
+type BuildRegistryService interface {
+	// Begin регистрирует сборку заказа и возвращает ее контекст и функцию завершения.
+	// Если заказ уже отменен, возвращает false
+	Begin(ctx context.Context, orderUUID uuid.UUID) (context.Context, func(), bool)
+	// Abort помечает заказ отмененным и прерывает его сборку, если она идет
+	Abort(orderUUID uuid.UUID) bool
+}
+
 // ShipAssembledProducerService представляет интерфейс для producer'а событий ShipAssembled
 type ShipAssembledProducerService interface {
 	// ProduceShipAssembled отправляет событие ShipAssembled в Kafka
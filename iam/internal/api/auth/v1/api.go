@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"net/netip"
+
 	"github.com/radiophysiker/microservices-homework/iam/internal/service"
 	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/auth/v1"
 )
@@ -9,11 +11,14 @@ import (
 type API struct {
 	pb.UnimplementedAuthServiceServer
 	authService service.AuthService
+	// trustedProxies - подсети прокси, от которых принимается X-Forwarded-For
+	trustedProxies []netip.Prefix
 }
 
 // NewAPI создает новый экземпляр API
-func NewAPI(authService service.AuthService) *API {
+func NewAPI(authService service.AuthService, trustedProxies []netip.Prefix) *API {
 	return &API{
-		authService: authService,
+		authService:    authService,
+		trustedProxies: trustedProxies,
 	}
 }
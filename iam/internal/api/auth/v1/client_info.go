@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+)
+
+const (
+	// gatewayUserAgentKey — User-Agent HTTP-клиента, проброшенный grpc-gateway
+	gatewayUserAgentKey = "grpcgateway-user-agent"
+	userAgentKey        = "user-agent"
+	// forwardedForKey — адрес HTTP-клиента, проброшенный grpc-gateway
+	forwardedForKey = "x-forwarded-for"
+)
+
+// clientInfoFromContext извлекает данные клиента из gRPC metadata и адреса peer.
+// Для запросов через HTTP-шлюз берутся User-Agent и адрес исходного HTTP-клиента.
+// X-Forwarded-For учитывается только от доверенных прокси trustedProxies: metadata задает сам
+// вызывающий, поэтому при прямом gRPC-вызове клиент может подставить любой адрес.
+func clientInfoFromContext(ctx context.Context, trustedProxies []netip.Prefix) model.ClientInfo {
+	var info model.ClientInfo
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	info.UserAgent = firstMetadataValue(md, gatewayUserAgentKey)
+	if info.UserAgent == "" {
+		info.UserAgent = firstMetadataValue(md, userAgentKey)
+	}
+
+	peerIP := peerAddress(ctx)
+
+	// Первый адрес в X-Forwarded-For — исходный клиент, остальные — промежуточные прокси
+	if isTrustedProxy(peerIP, trustedProxies) {
+		if forwarded := firstMetadataValue(md, forwardedForKey); forwarded != "" {
+			info.IP = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+	}
+
+	if info.IP == "" {
+		info.IP = peerIP
+	}
+
+	return info
+}
+
+// peerAddress возвращает IP-адрес peer без порта или пустую строку, если адрес неизвестен
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+
+	return addr
+}
+
+// isTrustedProxy сообщает, входит ли адрес peer в одну из подсетей доверенных прокси
+func isTrustedProxy(peerIP string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(peerIP)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
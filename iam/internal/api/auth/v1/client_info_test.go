@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+)
+
+func TestClientInfoFromContext(t *testing.T) {
+	peerAddr := &net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 54321}
+	gatewayAddr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 40000}
+	trustedProxies := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("::1/128")}
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		peer net.Addr
+		want model.ClientInfo
+	}{
+		{
+			name: "gateway_request",
+			md: metadata.Pairs(
+				gatewayUserAgentKey, "Mozilla/5.0",
+				userAgentKey, "grpc-go/1.77.0",
+				forwardedForKey, "203.0.113.7, 10.0.0.1",
+			),
+			peer: gatewayAddr,
+			want: model.ClientInfo{IP: "203.0.113.7", UserAgent: "Mozilla/5.0"},
+		},
+		{
+			name: "direct_grpc_forging_gateway_metadata",
+			md: metadata.Pairs(
+				gatewayUserAgentKey, "Mozilla/5.0",
+				forwardedForKey, "203.0.113.7",
+			),
+			peer: peerAddr,
+			want: model.ClientInfo{IP: "10.0.0.5", UserAgent: "Mozilla/5.0"},
+		},
+		{
+			name: "trusted_proxy_ipv6_loopback",
+			md:   metadata.Pairs(forwardedForKey, "2001:db8::7"),
+			peer: &net.TCPAddr{IP: net.ParseIP("::1"), Port: 40000},
+			want: model.ClientInfo{IP: "2001:db8::7"},
+		},
+		{
+			name: "direct_grpc_ignores_forwarded_for",
+			md: metadata.Pairs(
+				userAgentKey, "grpc-go/1.77.0",
+				forwardedForKey, "203.0.113.7",
+			),
+			peer: peerAddr,
+			want: model.ClientInfo{IP: "10.0.0.5", UserAgent: "grpc-go/1.77.0"},
+		},
+		{
+			name: "gateway_without_forwarded_for",
+			md:   metadata.Pairs(gatewayUserAgentKey, "curl/8.5.0"),
+			peer: gatewayAddr,
+			want: model.ClientInfo{IP: "127.0.0.1", UserAgent: "curl/8.5.0"},
+		},
+		{
+			name: "no_metadata_and_peer",
+			want: model.ClientInfo{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			if tt.peer != nil {
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: tt.peer})
+			}
+
+			assert.Equal(t, tt.want, clientInfoFromContext(ctx, trustedProxies))
+		})
+	}
+}
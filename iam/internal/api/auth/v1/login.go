@@ -17,7 +17,7 @@ func (a *API) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginRespons
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	sessionUUID, err := a.authService.Login(ctx, req.Login, req.Password)
+	sessionUUID, err := a.authService.Login(ctx, req.Login, req.Password, clientInfoFromContext(ctx, a.trustedProxies))
 	if err != nil {
 		switch {
 		case errors.Is(err, model.ErrInvalidCredentials):
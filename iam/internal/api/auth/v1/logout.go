@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/auth/v1"
+)
+
+// Logout обрабатывает запрос на выход из текущей сессии
+func (a *API) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.authService.Logout(ctx, req.SessionUuid); err != nil {
+		switch {
+		case errors.Is(err, model.ErrSessionNotFound):
+			return nil, status.Error(codes.NotFound, "session not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &pb.LogoutResponse{}, nil
+}
+
+// LogoutAll обрабатывает запрос на выход из всех сессий пользователя
+func (a *API) LogoutAll(ctx context.Context, req *pb.LogoutAllRequest) (*pb.LogoutAllResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	revoked, err := a.authService.LogoutAll(ctx, req.SessionUuid)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrSessionNotFound):
+			return nil, status.Error(codes.NotFound, "session not found")
+		case errors.Is(err, model.ErrInvalidSession):
+			return nil, status.Error(codes.Unauthenticated, "invalid session")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &pb.LogoutAllResponse{
+		RevokedSessions: int32(revoked), //nolint:gosec // число сессий пользователя невелико
+	}, nil
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/converter"
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/auth/v1"
+)
+
+// ListSessions обрабатывает запрос списка активных сессий пользователя
+func (a *API) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sessions, err := a.authService.ListSessions(ctx, req.SessionUuid)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrSessionNotFound):
+			return nil, status.Error(codes.NotFound, "session not found")
+		case errors.Is(err, model.ErrInvalidSession):
+			return nil, status.Error(codes.Unauthenticated, "invalid session")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	protoSessions := make([]*pb.SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		protoSessions = append(protoSessions, converter.ToProtoSessionInfo(session, session.UUID == req.SessionUuid))
+	}
+
+	return &pb.ListSessionsResponse{
+		Sessions: protoSessions,
+	}, nil
+}
+
+// RevokeSession обрабатывает запрос на отзыв одной из сессий пользователя
+func (a *API) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.authService.RevokeSession(ctx, req.SessionUuid, req.SessionId); err != nil {
+		switch {
+		case errors.Is(err, model.ErrSessionNotFound):
+			return nil, status.Error(codes.NotFound, "session not found")
+		case errors.Is(err, model.ErrInvalidSession):
+			return nil, status.Error(codes.Unauthenticated, "invalid session")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &pb.RevokeSessionResponse{}, nil
+}
@@ -3,9 +3,14 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
+	"net/http"
+	"time"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -21,9 +26,11 @@ import (
 
 // App представляет основное приложение IAM сервиса.
 type App struct {
-	diContainer *diContainer
-	grpcServer  *grpc.Server
-	listener    net.Listener
+	diContainer   *diContainer
+	grpcServer    *grpc.Server
+	httpServer    *http.Server
+	listener      net.Listener
+	gatewayCancel context.CancelFunc
 }
 
 // New создает новый экземпляр App и инициализирует все зависимости.
@@ -38,17 +45,34 @@ func New(ctx context.Context) (*App, error) {
 	return a, nil
 }
 
-// Run запускает gRPC сервер и обрабатывает входящие запросы.
-// Блокирует выполнение до остановки сервера или ошибки.
+// Run запускает gRPC сервер и HTTP шлюз и обрабатывает входящие запросы.
+// Блокирует выполнение до остановки серверов или ошибки.
 func (a *App) Run(ctx context.Context) error {
-	logger.Info(ctx, "IAMService gRPC server listening", zap.String("address", a.listener.Addr().String()))
+	g, ctx := errgroup.WithContext(ctx)
 
-	if err := a.grpcServer.Serve(a.listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
-		logger.Error(ctx, "gRPC serve failed", zap.Error(err))
-		return err
-	}
+	g.Go(func() error {
+		logger.Info(ctx, "IAMService gRPC server listening", zap.String("address", a.listener.Addr().String()))
 
-	return nil
+		if err := a.grpcServer.Serve(a.listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			logger.Error(ctx, "gRPC serve failed", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		logger.Info(ctx, "IAMService HTTP Gateway listen", zap.String("addr", a.httpServer.Addr))
+
+		if err := a.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(ctx, "HTTP serve failed", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+
+	return g.Wait()
 }
 
 // initDeps инициализирует все зависимости приложения в правильном порядке.
@@ -60,6 +84,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initMigrations,
 		a.initListener,
 		a.initGRPCServer,
+		a.initHTTPGateway,
 	}
 
 	for _, f := range inits {
@@ -157,3 +182,45 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 
 	return nil
 }
+
+// initHTTPGateway инициализирует HTTP шлюз к gRPC API аутентификации.
+func (a *App) initHTTPGateway(ctx context.Context) error {
+	gatewayCtx, gatewayCancel := context.WithCancel(ctx)
+	a.gatewayCancel = gatewayCancel
+
+	mux := runtime.NewServeMux()
+
+	err := authpb.RegisterAuthServiceHandlerFromEndpoint(
+		gatewayCtx,
+		mux,
+		config.AppConfig().IAMGRPC.Address(),
+		[]grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register gateway: %w", err)
+	}
+
+	a.httpServer = &http.Server{
+		Addr:              config.AppConfig().IAMHTTP.Address(),
+		Handler:           mux,
+		ReadTimeout:       60 * time.Second,
+		WriteTimeout:      60 * time.Second,
+		ReadHeaderTimeout: 60 * time.Second,
+		IdleTimeout:       120 * time.Second,
+	}
+
+	closer.AddNamed("HTTP server", func(ctx context.Context) error {
+		a.gatewayCancel()
+
+		shutdownCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+
+		return a.httpServer.Shutdown(shutdownCtx)
+	})
+
+	logger.Info(ctx, "HTTP gateway initialized")
+
+	return nil
+}
@@ -229,7 +229,7 @@ func (d *diContainer) AuthAPI(ctx context.Context) (*v1.API, error) {
 			return nil, err
 		}
 
-		d.authAPI = v1.NewAPI(authService)
+		d.authAPI = v1.NewAPI(authService, config.AppConfig().IAMGRPC.TrustedProxies())
 	}
 
 	return d.authAPI, nil
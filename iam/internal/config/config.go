@@ -16,6 +16,7 @@ type config struct {
 	Migrations MigrationsConfig
 	Redis      RedisConfig
 	IAMGRPC    IAMGRPCConfig
+	IAMHTTP    IAMHTTPConfig
 	Session    SessionConfig
 }
 
@@ -52,6 +53,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	iamHTTPCfg, err := env.NewIAMHTTPConfig()
+	if err != nil {
+		return err
+	}
+
 	sessionCfg, err := env.NewSessionConfig()
 	if err != nil {
 		return err
@@ -63,6 +69,7 @@ func Load(path ...string) error {
 		Migrations: migrationsCfg,
 		Redis:      redisCfg,
 		IAMGRPC:    iamGRPCCfg,
+		IAMHTTP:    iamHTTPCfg,
 		Session:    sessionCfg,
 	}
 
@@ -2,6 +2,7 @@ package env
 
 import (
 	"net"
+	"net/netip"
 
 	"github.com/caarlos0/env/v11"
 )
@@ -9,6 +10,9 @@ import (
 type iamGRPCEnvConfig struct {
 	Host string `env:"GRPC_HOST,required"`
 	Port string `env:"GRPC_PORT,required"`
+	// TrustedProxies - подсети прокси, которым доверяется X-Forwarded-For.
+	// По умолчанию только loopback: через него gRPC-сервер вызывает встроенный HTTP-шлюз
+	TrustedProxies []netip.Prefix `env:"GRPC_TRUSTED_PROXIES" envSeparator:"," envDefault:"127.0.0.0/8,::1/128"`
 }
 
 type iamGRPCConfig struct {
@@ -35,3 +39,7 @@ func (cfg *iamGRPCConfig) Port() string {
 func (cfg *iamGRPCConfig) Address() string {
 	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
 }
+
+func (cfg *iamGRPCConfig) TrustedProxies() []netip.Prefix {
+	return cfg.raw.TrustedProxies
+}
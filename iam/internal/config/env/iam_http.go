@@ -0,0 +1,29 @@
+package env
+
+import (
+	"net"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type iamHTTPEnvConfig struct {
+	Host string `env:"HTTP_HOST" envDefault:"0.0.0.0"`
+	Port string `env:"HTTP_PORT" envDefault:"8083"`
+}
+
+type iamHTTPConfig struct {
+	raw iamHTTPEnvConfig
+}
+
+func NewIAMHTTPConfig() (*iamHTTPConfig, error) {
+	var raw iamHTTPEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &iamHTTPConfig{raw: raw}, nil
+}
+
+func (cfg *iamHTTPConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
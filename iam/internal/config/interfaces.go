@@ -1,6 +1,9 @@
 package config
 
-import "time"
+import (
+	"net/netip"
+	"time"
+)
 
 type LoggerConfig interface {
 	Level() string
@@ -34,6 +37,11 @@ type IAMGRPCConfig interface {
 	Host() string
 	Port() string
 	Address() string
+	TrustedProxies() []netip.Prefix
+}
+
+type IAMHTTPConfig interface {
+	Address() string
 }
 
 type SessionConfig interface {
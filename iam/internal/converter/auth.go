@@ -4,6 +4,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+	authpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/auth/v1"
 	commonpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/common/v1"
 )
 
@@ -20,3 +21,21 @@ func ToProtoSession(s *model.Session) *commonpb.Session {
 		ExpiresAt: timestamppb.New(s.ExpiresAt),
 	}
 }
+
+// ToProtoSessionInfo преобразует доменную модель Session в protobuf SessionInfo без UUID сессии.
+// current отмечает сессию, от имени которой выполнен запрос
+func ToProtoSessionInfo(s *model.Session, current bool) *authpb.SessionInfo {
+	if s == nil {
+		return nil
+	}
+
+	return &authpb.SessionInfo{
+		SessionId: s.ID(),
+		CreatedAt: timestamppb.New(s.CreatedAt),
+		UpdatedAt: timestamppb.New(s.UpdatedAt),
+		ExpiresAt: timestamppb.New(s.ExpiresAt),
+		Ip:        s.IP,
+		UserAgent: s.UserAgent,
+		Current:   current,
+	}
+}
@@ -1,6 +1,13 @@
 package model
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// sessionIDLength — длина несекретного идентификатора сессии в hex-символах
+const sessionIDLength = 16
 
 // Session — доменная сессия авторизации.
 type Session struct {
@@ -16,6 +23,19 @@ type Session struct {
 	RevokedAt *time.Time
 }
 
+// ID возвращает несекретный идентификатор сессии.
+// UUID сессии служит токеном доступа, поэтому наружу в списках сессий отдается только его хэш.
+func (s *Session) ID() string {
+	sum := sha256.Sum256([]byte(s.UUID))
+	return hex.EncodeToString(sum[:])[:sessionIDLength]
+}
+
+// ClientInfo — данные клиента, с которого выполнен вход
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
 // Credentials — доменные учётные данные
 type Credentials struct {
 	Login    string
@@ -0,0 +1,415 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package repository
+
+import (
+	"context"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSessionRepository creates a new instance of MockSessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionRepository {
+	mock := &MockSessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionRepository is an autogenerated mock type for the SessionRepository type
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+type MockSessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionRepository) EXPECT() *MockSessionRepository_Expecter {
+	return &MockSessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddSessionToUserSet provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) AddSessionToUserSet(ctx context.Context, userUUID string, sessionUUID string) error {
+	ret := _mock.Called(ctx, userUUID, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSessionToUserSet")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userUUID, sessionUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_AddSessionToUserSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSessionToUserSet'
+type MockSessionRepository_AddSessionToUserSet_Call struct {
+	*mock.Call
+}
+
+// AddSessionToUserSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - sessionUUID string
+func (_e *MockSessionRepository_Expecter) AddSessionToUserSet(ctx interface{}, userUUID interface{}, sessionUUID interface{}) *MockSessionRepository_AddSessionToUserSet_Call {
+	return &MockSessionRepository_AddSessionToUserSet_Call{Call: _e.mock.On("AddSessionToUserSet", ctx, userUUID, sessionUUID)}
+}
+
+func (_c *MockSessionRepository_AddSessionToUserSet_Call) Run(run func(ctx context.Context, userUUID string, sessionUUID string)) *MockSessionRepository_AddSessionToUserSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_AddSessionToUserSet_Call) Return(err error) *MockSessionRepository_AddSessionToUserSet_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_AddSessionToUserSet_Call) RunAndReturn(run func(ctx context.Context, userUUID string, sessionUUID string) error) *MockSessionRepository_AddSessionToUserSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) Create(ctx context.Context, session *model.Session) error {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Session) error); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSessionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *model.Session
+func (_e *MockSessionRepository_Expecter) Create(ctx interface{}, session interface{}) *MockSessionRepository_Create_Call {
+	return &MockSessionRepository_Create_Call{Call: _e.mock.On("Create", ctx, session)}
+}
+
+func (_c *MockSessionRepository_Create_Call) Run(run func(ctx context.Context, session *model.Session)) *MockSessionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Session
+		if args[1] != nil {
+			arg1 = args[1].(*model.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Create_Call) Return(err error) *MockSessionRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, session *model.Session) error) *MockSessionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) Get(ctx context.Context, sessionUUID string) (*model.Session, error) {
+	ret := _mock.Called(ctx, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.Session, error)); ok {
+		return returnFunc(ctx, sessionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.Session); ok {
+		r0 = returnFunc(ctx, sessionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sessionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockSessionRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID string
+func (_e *MockSessionRepository_Expecter) Get(ctx interface{}, sessionUUID interface{}) *MockSessionRepository_Get_Call {
+	return &MockSessionRepository_Get_Call{Call: _e.mock.On("Get", ctx, sessionUUID)}
+}
+
+func (_c *MockSessionRepository_Get_Call) Run(run func(ctx context.Context, sessionUUID string)) *MockSessionRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Get_Call) Return(session *model.Session, err error) *MockSessionRepository_Get_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockSessionRepository_Get_Call) RunAndReturn(run func(ctx context.Context, sessionUUID string) (*model.Session, error)) *MockSessionRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserSessionUUIDs provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) ListUserSessionUUIDs(ctx context.Context, userUUID string) ([]string, error) {
+	ret := _mock.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserSessionUUIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, userUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepository_ListUserSessionUUIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserSessionUUIDs'
+type MockSessionRepository_ListUserSessionUUIDs_Call struct {
+	*mock.Call
+}
+
+// ListUserSessionUUIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *MockSessionRepository_Expecter) ListUserSessionUUIDs(ctx interface{}, userUUID interface{}) *MockSessionRepository_ListUserSessionUUIDs_Call {
+	return &MockSessionRepository_ListUserSessionUUIDs_Call{Call: _e.mock.On("ListUserSessionUUIDs", ctx, userUUID)}
+}
+
+func (_c *MockSessionRepository_ListUserSessionUUIDs_Call) Run(run func(ctx context.Context, userUUID string)) *MockSessionRepository_ListUserSessionUUIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_ListUserSessionUUIDs_Call) Return(strings []string, err error) *MockSessionRepository_ListUserSessionUUIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSessionRepository_ListUserSessionUUIDs_Call) RunAndReturn(run func(ctx context.Context, userUUID string) ([]string, error)) *MockSessionRepository_ListUserSessionUUIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSessionFromUserSet provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) RemoveSessionFromUserSet(ctx context.Context, userUUID string, sessionUUID string) error {
+	ret := _mock.Called(ctx, userUUID, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSessionFromUserSet")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userUUID, sessionUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_RemoveSessionFromUserSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSessionFromUserSet'
+type MockSessionRepository_RemoveSessionFromUserSet_Call struct {
+	*mock.Call
+}
+
+// RemoveSessionFromUserSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - sessionUUID string
+func (_e *MockSessionRepository_Expecter) RemoveSessionFromUserSet(ctx interface{}, userUUID interface{}, sessionUUID interface{}) *MockSessionRepository_RemoveSessionFromUserSet_Call {
+	return &MockSessionRepository_RemoveSessionFromUserSet_Call{Call: _e.mock.On("RemoveSessionFromUserSet", ctx, userUUID, sessionUUID)}
+}
+
+func (_c *MockSessionRepository_RemoveSessionFromUserSet_Call) Run(run func(ctx context.Context, userUUID string, sessionUUID string)) *MockSessionRepository_RemoveSessionFromUserSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_RemoveSessionFromUserSet_Call) Return(err error) *MockSessionRepository_RemoveSessionFromUserSet_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_RemoveSessionFromUserSet_Call) RunAndReturn(run func(ctx context.Context, userUUID string, sessionUUID string) error) *MockSessionRepository_RemoveSessionFromUserSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) Update(ctx context.Context, session *model.Session) error {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Session) error); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockSessionRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *model.Session
+func (_e *MockSessionRepository_Expecter) Update(ctx interface{}, session interface{}) *MockSessionRepository_Update_Call {
+	return &MockSessionRepository_Update_Call{Call: _e.mock.On("Update", ctx, session)}
+}
+
+func (_c *MockSessionRepository_Update_Call) Run(run func(ctx context.Context, session *model.Session)) *MockSessionRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Session
+		if args[1] != nil {
+			arg1 = args[1].(*model.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Update_Call) Return(err error) *MockSessionRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_Update_Call) RunAndReturn(run func(ctx context.Context, session *model.Session) error) *MockSessionRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -19,4 +19,10 @@ type SessionRepository interface {
 	Create(ctx context.Context, session *model.Session) error
 	Get(ctx context.Context, sessionUUID string) (*model.Session, error)
 	AddSessionToUserSet(ctx context.Context, userUUID, sessionUUID string) error
+	// Update перезаписывает сессию, сохраняя ее до ExpiresAt
+	Update(ctx context.Context, session *model.Session) error
+	// ListUserSessionUUIDs возвращает UUID сессий из множества пользователя
+	ListUserSessionUUIDs(ctx context.Context, userUUID string) ([]string, error)
+	// RemoveSessionFromUserSet удаляет сессию из множества пользователя
+	RemoveSessionFromUserSet(ctx context.Context, userUUID, sessionUUID string) error
 }
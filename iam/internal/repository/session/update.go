@@ -0,0 +1,42 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+	"github.com/radiophysiker/microservices-homework/iam/internal/repository/converter"
+)
+
+// Update перезаписывает сессию в Redis с TTL до ее ExpiresAt.
+// Истекшая сессия удаляется.
+func (r *Repository) Update(ctx context.Context, session *model.Session) error {
+	repoSession := converter.ToRepoSession(session)
+	if repoSession == nil {
+		return fmt.Errorf("session is nil")
+	}
+
+	key := sessionKey(repoSession.UUID)
+
+	ttl := time.Until(repoSession.ExpiresAt)
+	if ttl <= 0 {
+		if err := r.client.Del(ctx, key); err != nil {
+			return fmt.Errorf("delete expired session from redis: %w", err)
+		}
+
+		return nil
+	}
+
+	payload, err := json.Marshal(repoSession)
+	if err != nil {
+		return fmt.Errorf("marshal session: %w", err)
+	}
+
+	if err := r.client.SetWithTTL(ctx, key, payload, ttl); err != nil {
+		return fmt.Errorf("set session in redis: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package session
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListUserSessionUUIDs возвращает UUID сессий из множества пользователя.
+// Множество может содержать уже истекшие сессии.
+func (r *Repository) ListUserSessionUUIDs(ctx context.Context, userUUID string) ([]string, error) {
+	sessionUUIDs, err := r.client.SMembers(ctx, userSessionsKey(userUUID))
+	if err != nil {
+		return nil, fmt.Errorf("list user sessions: %w", err)
+	}
+
+	return sessionUUIDs, nil
+}
+
+// RemoveSessionFromUserSet удаляет идентификатор сессии из множества пользователя.
+func (r *Repository) RemoveSessionFromUserSet(ctx context.Context, userUUID, sessionUUID string) error {
+	if err := r.client.SRem(ctx, userSessionsKey(userUUID), sessionUUID); err != nil {
+		return fmt.Errorf("remove session from user set: %w", err)
+	}
+
+	return nil
+}
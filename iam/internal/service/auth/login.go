@@ -14,8 +14,9 @@ import (
 
 // Login выполняет вход пользователя.
 // Проверяет логин и пароль, создает новую сессию в Redis с TTL и добавляет ее в множество сессий пользователя.
+// Данные клиента сохраняются в сессии, чтобы ее можно было узнать в списке сессий.
 // Возвращает UUID созданной сессии или ошибку.
-func (s *Service) Login(ctx context.Context, login, password string) (string, error) {
+func (s *Service) Login(ctx context.Context, login, password string, client model.ClientInfo) (string, error) {
 	user, err := s.userRepository.GetByLogin(ctx, login)
 	if err != nil {
 		switch {
@@ -44,6 +45,8 @@ func (s *Service) Login(ctx context.Context, login, password string) (string, er
 		CreatedAt: now,
 		UpdatedAt: now,
 		ExpiresAt: expiresAt,
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
 	}
 
 	if err := s.sessionRepository.Create(ctx, session); err != nil {
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+)
+
+// Logout отзывает сессию.
+// Сессия остается в Redis до истечения TTL с заполненным RevokedAt, поэтому Whoami ее отклоняет,
+// и удаляется из множества сессий пользователя. Повторный выход из отозванной сессии не является ошибкой.
+func (s *Service) Logout(ctx context.Context, sessionUUID string) error {
+	session, err := s.sessionRepository.Get(ctx, sessionUUID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidCredentials):
+			return model.NewErrSessionNotFound(sessionUUID)
+		default:
+			return fmt.Errorf("get session: %w", err)
+		}
+	}
+
+	if session == nil {
+		return model.ErrSessionNotFound
+	}
+
+	if _, err := s.revokeSession(ctx, session, time.Now()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// LogoutAll отзывает все сессии владельца сессии sessionUUID, включая ее саму.
+// Сессия sessionUUID должна быть действующей. Возвращает количество отозванных сессий.
+func (s *Service) LogoutAll(ctx context.Context, sessionUUID string) (int, error) {
+	current, err := s.activeSession(ctx, sessionUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	sessions, err := s.userSessions(ctx, current.UserUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	revoked := 0
+
+	for _, session := range sessions {
+		ok, err := s.revokeSession(ctx, session, now)
+		if err != nil {
+			return revoked, err
+		}
+
+		if ok {
+			revoked++
+		}
+	}
+
+	return revoked, nil
+}
+
+// revokeSession помечает сессию отозванной и удаляет ее из множества пользователя.
+// Возвращает false, если сессия уже была отозвана.
+func (s *Service) revokeSession(ctx context.Context, session *model.Session, now time.Time) (bool, error) {
+	alreadyRevoked := session.RevokedAt != nil && !session.RevokedAt.IsZero()
+
+	if !alreadyRevoked {
+		session.RevokedAt = &now
+		session.UpdatedAt = now
+
+		if err := s.sessionRepository.Update(ctx, session); err != nil {
+			return false, fmt.Errorf("update session: %w", err)
+		}
+	}
+
+	if err := s.sessionRepository.RemoveSessionFromUserSet(ctx, session.UserUUID, session.UUID); err != nil {
+		return false, fmt.Errorf("remove session from user set: %w", err)
+	}
+
+	return !alreadyRevoked, nil
+}
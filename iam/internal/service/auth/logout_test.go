@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+)
+
+func (s *ServiceTestSuite) TestLogout() {
+	tests := []struct {
+		name      string
+		session   *model.Session
+		setupMock func(session *model.Session)
+		checkErr  func(err error)
+	}{
+		{
+			name:    "success",
+			session: s.newSession(0),
+			setupMock: func(session *model.Session) {
+				s.sessionRepo.EXPECT().Get(s.ctx, session.UUID).Return(session, nil).Once()
+				s.sessionRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(updated *model.Session) bool {
+					return updated.UUID == session.UUID && updated.RevokedAt != nil
+				})).Return(nil).Once()
+				s.sessionRepo.EXPECT().RemoveSessionFromUserSet(s.ctx, session.UserUUID, session.UUID).Return(nil).Once()
+			},
+		},
+		{
+			name:    "already_revoked",
+			session: revoked(s.newSession(0)),
+			setupMock: func(session *model.Session) {
+				s.sessionRepo.EXPECT().Get(s.ctx, session.UUID).Return(session, nil).Once()
+				s.sessionRepo.EXPECT().RemoveSessionFromUserSet(s.ctx, session.UserUUID, session.UUID).Return(nil).Once()
+			},
+		},
+		{
+			name:    "session_not_found",
+			session: s.newSession(0),
+			setupMock: func(session *model.Session) {
+				s.sessionRepo.EXPECT().Get(s.ctx, session.UUID).Return(nil, model.ErrInvalidCredentials).Once()
+			},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrSessionNotFound)
+			},
+		},
+		{
+			name:    "update_error",
+			session: s.newSession(0),
+			setupMock: func(session *model.Session) {
+				s.sessionRepo.EXPECT().Get(s.ctx, session.UUID).Return(session, nil).Once()
+				s.sessionRepo.EXPECT().Update(s.ctx, mock.Anything).Return(errors.New("redis down")).Once()
+			},
+			checkErr: func(err error) {
+				assert.ErrorContains(s.T(), err, "update session")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			tt.setupMock(tt.session)
+
+			err := s.service.Logout(s.ctx, tt.session.UUID)
+
+			if tt.checkErr != nil {
+				tt.checkErr(err)
+				return
+			}
+
+			assert.NoError(s.T(), err)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestLogoutAll() {
+	s.Run("revokes_active_sessions_and_drops_stale_entries", func() {
+		s.SetupTest()
+
+		current := s.newSession(0)
+		other := s.newSession(time.Minute)
+		revokedOther := revoked(s.newSession(2 * time.Minute))
+		staleUUID := "stale-session"
+
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+		s.sessionRepo.EXPECT().ListUserSessionUUIDs(s.ctx, s.userUUID).
+			Return([]string{current.UUID, staleUUID, other.UUID, revokedOther.UUID}, nil).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, staleUUID).Return(nil, model.ErrInvalidCredentials).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, other.UUID).Return(other, nil).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, revokedOther.UUID).Return(revokedOther, nil).Once()
+
+		s.sessionRepo.EXPECT().RemoveSessionFromUserSet(s.ctx, s.userUUID, staleUUID).Return(nil).Once()
+		for _, session := range []*model.Session{current, other} {
+			s.sessionRepo.EXPECT().Update(s.ctx, session).Return(nil).Once()
+			s.sessionRepo.EXPECT().RemoveSessionFromUserSet(s.ctx, session.UserUUID, session.UUID).Return(nil).Once()
+		}
+		s.sessionRepo.EXPECT().RemoveSessionFromUserSet(s.ctx, s.userUUID, revokedOther.UUID).Return(nil).Once()
+
+		count, err := s.service.LogoutAll(s.ctx, current.UUID)
+
+		s.Require().NoError(err)
+		assert.Equal(s.T(), 2, count)
+		assert.NotNil(s.T(), current.RevokedAt)
+		assert.NotNil(s.T(), other.RevokedAt)
+	})
+
+	s.Run("revoked_current_session", func() {
+		s.SetupTest()
+
+		current := revoked(s.newSession(0))
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+
+		count, err := s.service.LogoutAll(s.ctx, current.UUID)
+
+		assert.ErrorIs(s.T(), err, model.ErrInvalidSession)
+		assert.Zero(s.T(), count)
+	})
+
+	s.Run("list_error", func() {
+		s.SetupTest()
+
+		current := s.newSession(0)
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+		s.sessionRepo.EXPECT().ListUserSessionUUIDs(s.ctx, s.userUUID).Return(nil, errors.New("redis down")).Once()
+
+		_, err := s.service.LogoutAll(s.ctx, current.UUID)
+
+		assert.ErrorContains(s.T(), err, "list user sessions")
+	})
+}
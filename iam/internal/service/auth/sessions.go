@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+)
+
+// ListSessions возвращает активные сессии владельца сессии sessionUUID, от новых к старым.
+// Сессия sessionUUID должна быть действующей.
+func (s *Service) ListSessions(ctx context.Context, sessionUUID string) ([]*model.Session, error) {
+	current, err := s.activeSession(ctx, sessionUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.userSessions(ctx, current.UserUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	active := make([]*model.Session, 0, len(sessions))
+
+	for _, session := range sessions {
+		if now.After(session.ExpiresAt) || (session.RevokedAt != nil && !session.RevokedAt.IsZero()) {
+			continue
+		}
+
+		active = append(active, session)
+	}
+
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].CreatedAt.After(active[j].CreatedAt)
+	})
+
+	return active, nil
+}
+
+// RevokeSession отзывает сессию владельца сессии sessionUUID по ее идентификатору из ListSessions.
+// Сессия sessionUUID должна быть действующей.
+func (s *Service) RevokeSession(ctx context.Context, sessionUUID, sessionID string) error {
+	current, err := s.activeSession(ctx, sessionUUID)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := s.userSessions(ctx, current.UserUUID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID() != sessionID {
+			continue
+		}
+
+		if _, err := s.revokeSession(ctx, session, time.Now()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	return model.NewErrSessionNotFound(sessionID)
+}
+
+// userSessions возвращает сессии из множества пользователя.
+// Ссылки на уже удаленные из Redis сессии убираются из множества.
+func (s *Service) userSessions(ctx context.Context, userUUID string) ([]*model.Session, error) {
+	sessionUUIDs, err := s.sessionRepository.ListUserSessionUUIDs(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("list user sessions: %w", err)
+	}
+
+	sessions := make([]*model.Session, 0, len(sessionUUIDs))
+
+	for _, sessionUUID := range sessionUUIDs {
+		session, err := s.sessionRepository.Get(ctx, sessionUUID)
+		if err != nil {
+			if !errors.Is(err, model.ErrInvalidCredentials) {
+				return nil, fmt.Errorf("get session: %w", err)
+			}
+
+			if err := s.sessionRepository.RemoveSessionFromUserSet(ctx, userUUID, sessionUUID); err != nil {
+				return nil, fmt.Errorf("remove session from user set: %w", err)
+			}
+
+			continue
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+)
+
+func (s *ServiceTestSuite) TestListSessions() {
+	s.Run("returns_active_sessions_newest_first", func() {
+		s.SetupTest()
+
+		current := s.newSession(time.Minute)
+		newer := s.newSession(0)
+		expired := s.newSession(2 * time.Hour)
+		revokedSession := revoked(s.newSession(30 * time.Second))
+		staleUUID := "stale-session"
+
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+		s.sessionRepo.EXPECT().ListUserSessionUUIDs(s.ctx, s.userUUID).
+			Return([]string{current.UUID, expired.UUID, staleUUID, newer.UUID, revokedSession.UUID}, nil).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, expired.UUID).Return(expired, nil).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, staleUUID).Return(nil, model.ErrInvalidCredentials).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, newer.UUID).Return(newer, nil).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, revokedSession.UUID).Return(revokedSession, nil).Once()
+		s.sessionRepo.EXPECT().RemoveSessionFromUserSet(s.ctx, s.userUUID, staleUUID).Return(nil).Once()
+
+		sessions, err := s.service.ListSessions(s.ctx, current.UUID)
+
+		s.Require().NoError(err)
+		assert.Equal(s.T(), []*model.Session{newer, current}, sessions)
+	})
+
+	s.Run("revoked_current_session", func() {
+		s.SetupTest()
+
+		current := revoked(s.newSession(0))
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+
+		_, err := s.service.ListSessions(s.ctx, current.UUID)
+
+		assert.ErrorIs(s.T(), err, model.ErrInvalidSession)
+	})
+
+	s.Run("get_session_error", func() {
+		s.SetupTest()
+
+		current := s.newSession(0)
+		other := s.newSession(time.Minute)
+
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+		s.sessionRepo.EXPECT().ListUserSessionUUIDs(s.ctx, s.userUUID).Return([]string{other.UUID}, nil).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, other.UUID).Return(nil, errors.New("redis down")).Once()
+
+		_, err := s.service.ListSessions(s.ctx, current.UUID)
+
+		assert.ErrorContains(s.T(), err, "get session")
+	})
+}
+
+func (s *ServiceTestSuite) TestRevokeSession() {
+	s.Run("revokes_session_by_id", func() {
+		s.SetupTest()
+
+		current := s.newSession(0)
+		other := s.newSession(time.Minute)
+
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+		s.sessionRepo.EXPECT().ListUserSessionUUIDs(s.ctx, s.userUUID).Return([]string{current.UUID, other.UUID}, nil).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+		s.sessionRepo.EXPECT().Get(s.ctx, other.UUID).Return(other, nil).Once()
+		s.sessionRepo.EXPECT().Update(s.ctx, other).Return(nil).Once()
+		s.sessionRepo.EXPECT().RemoveSessionFromUserSet(s.ctx, s.userUUID, other.UUID).Return(nil).Once()
+
+		err := s.service.RevokeSession(s.ctx, current.UUID, other.ID())
+
+		s.Require().NoError(err)
+		assert.NotNil(s.T(), other.RevokedAt)
+		assert.Nil(s.T(), current.RevokedAt)
+	})
+
+	s.Run("unknown_session_id", func() {
+		s.SetupTest()
+
+		current := s.newSession(0)
+
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Twice()
+		s.sessionRepo.EXPECT().ListUserSessionUUIDs(s.ctx, s.userUUID).Return([]string{current.UUID}, nil).Once()
+
+		err := s.service.RevokeSession(s.ctx, current.UUID, "0000000000000000")
+
+		assert.ErrorIs(s.T(), err, model.ErrSessionNotFound)
+	})
+
+	s.Run("expired_current_session", func() {
+		s.SetupTest()
+
+		current := s.newSession(2 * time.Hour)
+		s.sessionRepo.EXPECT().Get(s.ctx, current.UUID).Return(current, nil).Once()
+
+		err := s.service.RevokeSession(s.ctx, current.UUID, current.ID())
+
+		assert.ErrorIs(s.T(), err, model.ErrInvalidSession)
+	})
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+	repomocks "github.com/radiophysiker/microservices-homework/iam/internal/repository/mocks"
+)
+
+// ServiceTestSuite содержит общее окружение для всех тестов сервиса
+type ServiceTestSuite struct {
+	suite.Suite
+	userRepo    *repomocks.MockUserRepository
+	sessionRepo *repomocks.MockSessionRepository
+	service     *Service
+	ctx         context.Context
+	userUUID    string
+}
+
+// SetupTest запускается перед каждым тестом
+func (s *ServiceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.userUUID = uuid.NewString()
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.sessionRepo = repomocks.NewMockSessionRepository(s.T())
+	s.service = NewService(s.userRepo, s.sessionRepo, nil, time.Hour)
+}
+
+// newSession создает действующую сессию пользователя suite, созданную createdAgo назад
+func (s *ServiceTestSuite) newSession(createdAgo time.Duration) *model.Session {
+	createdAt := time.Now().Add(-createdAgo)
+
+	return &model.Session{
+		UUID:      uuid.NewString(),
+		UserUUID:  s.userUUID,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+		ExpiresAt: createdAt.Add(time.Hour),
+	}
+}
+
+// revoked помечает сессию отозванной минуту назад
+func revoked(session *model.Session) *model.Session {
+	revokedAt := time.Now().Add(-time.Minute)
+	session.RevokedAt = &revokedAt
+
+	return session
+}
+
+// TestServiceSuite запускает все тесты suite
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
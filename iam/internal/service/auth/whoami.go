@@ -13,27 +13,9 @@ import (
 // Проверяет существование сессии, ее срок действия и статус отзыва.
 // Возвращает сессию и пользователя или ошибку.
 func (s *Service) Whoami(ctx context.Context, sessionUUID string) (*model.Session, *model.User, error) {
-	session, err := s.sessionRepository.Get(ctx, sessionUUID)
+	session, err := s.activeSession(ctx, sessionUUID)
 	if err != nil {
-		switch {
-		case errors.Is(err, model.ErrInvalidCredentials):
-			return nil, nil, model.NewErrSessionNotFound(sessionUUID)
-		default:
-			return nil, nil, fmt.Errorf("get session: %w", err)
-		}
-	}
-
-	if session == nil {
-		return nil, nil, model.ErrSessionNotFound
-	}
-
-	if time.Now().After(session.ExpiresAt) {
-		return nil, nil, model.ErrInvalidSession
-	}
-
-	// Проверка, что сессия не отозвана
-	if session.RevokedAt != nil && !session.RevokedAt.IsZero() {
-		return nil, nil, model.ErrInvalidSession
+		return nil, nil, err
 	}
 
 	user, err := s.userService.Get(ctx, session.UserUUID)
@@ -52,3 +34,30 @@ func (s *Service) Whoami(ctx context.Context, sessionUUID string) (*model.Sessio
 
 	return session, user, nil
 }
+
+// activeSession возвращает сессию, если она существует, не истекла и не отозвана.
+func (s *Service) activeSession(ctx context.Context, sessionUUID string) (*model.Session, error) {
+	session, err := s.sessionRepository.Get(ctx, sessionUUID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidCredentials):
+			return nil, model.NewErrSessionNotFound(sessionUUID)
+		default:
+			return nil, fmt.Errorf("get session: %w", err)
+		}
+	}
+
+	if session == nil {
+		return nil, model.ErrSessionNotFound
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		return nil, model.ErrInvalidSession
+	}
+
+	if session.RevokedAt != nil && !session.RevokedAt.IsZero() {
+		return nil, model.ErrInvalidSession
+	}
+
+	return session, nil
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/radiophysiker/microservices-homework/iam/internal/model"
+)
+
+func (s *ServiceTestSuite) TestWhoamiRejectsInactiveSession() {
+	expired := s.newSession(2 * time.Hour)
+
+	tests := []struct {
+		name    string
+		session *model.Session
+		getErr  error
+		wantErr error
+	}{
+		{
+			name:    "revoked_session",
+			session: revoked(s.newSession(0)),
+			wantErr: model.ErrInvalidSession,
+		},
+		{
+			name:    "expired_session",
+			session: expired,
+			wantErr: model.ErrInvalidSession,
+		},
+		{
+			name:    "session_not_found",
+			session: s.newSession(0),
+			getErr:  model.ErrInvalidCredentials,
+			wantErr: model.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+
+			if tt.getErr != nil {
+				s.sessionRepo.EXPECT().Get(s.ctx, tt.session.UUID).Return(nil, tt.getErr).Once()
+			} else {
+				s.sessionRepo.EXPECT().Get(s.ctx, tt.session.UUID).Return(tt.session, nil).Once()
+			}
+
+			session, user, err := s.service.Whoami(s.ctx, tt.session.UUID)
+
+			assert.ErrorIs(s.T(), err, tt.wantErr)
+			assert.Nil(s.T(), session)
+			assert.Nil(s.T(), user)
+		})
+	}
+}
@@ -17,7 +17,15 @@ type UserService interface {
 // AuthService представляет интерфейс для аутентификации и авторизации
 type AuthService interface {
 	// Login выполняет вход пользователя
-	Login(ctx context.Context, login, password string) (string, error)
+	Login(ctx context.Context, login, password string, client model.ClientInfo) (string, error)
 	// Whoami возвращает информацию о текущей сессии и пользователе
 	Whoami(ctx context.Context, sessionUUID string) (*model.Session, *model.User, error)
+	// Logout отзывает сессию
+	Logout(ctx context.Context, sessionUUID string) error
+	// LogoutAll отзывает все сессии владельца сессии и возвращает их количество
+	LogoutAll(ctx context.Context, sessionUUID string) (int, error)
+	// ListSessions возвращает активные сессии владельца сессии
+	ListSessions(ctx context.Context, sessionUUID string) ([]*model.Session, error)
+	// RevokeSession отзывает сессию владельца сессии по ее несекретному идентификатору
+	RevokeSession(ctx context.Context, sessionUUID, sessionID string) error
 }
@@ -7,12 +7,14 @@ import (
 
 type API struct {
 	pb.UnimplementedInventoryServiceServer
-	partService service.PartService
+	partService        service.PartService
+	reservationService service.ReservationService
 }
 
 // NewAPI creates new API.
-func NewAPI(partService service.PartService) *API {
+func NewAPI(partService service.PartService, reservationService service.ReservationService) *API {
 	return &API{
-		partService: partService,
+		partService:        partService,
+		reservationService: reservationService,
 	}
 }
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/radiophysiker/microservices-homework/inventory/internal/converter"
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+	grpcMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/grpc"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/inventory/v1"
+)
+
+// ReserveParts резервирует детали под заказ
+func (a *API) ReserveParts(ctx context.Context, req *pb.ReservePartsRequest) (*pb.ReservePartsResponse, error) {
+	if err := requireServiceCall(ctx); err != nil {
+		return nil, err
+	}
+
+	items := converter.ToModelReservationItems(req.GetItems())
+
+	if err := a.reservationService.ReserveParts(ctx, req.GetOrderUuid(), items); err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &pb.ReservePartsResponse{}, nil
+}
+
+// ReleaseReservation снимает резерв заказа
+func (a *API) ReleaseReservation(
+	ctx context.Context,
+	req *pb.ReleaseReservationRequest,
+) (*pb.ReleaseReservationResponse, error) {
+	if err := requireServiceCall(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.reservationService.ReleaseReservation(ctx, req.GetOrderUuid()); err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &pb.ReleaseReservationResponse{}, nil
+}
+
+// CommitReservation подтверждает резерв заказа
+func (a *API) CommitReservation(
+	ctx context.Context,
+	req *pb.CommitReservationRequest,
+) (*pb.CommitReservationResponse, error) {
+	if err := requireServiceCall(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.reservationService.CommitReservation(ctx, req.GetOrderUuid()); err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &pb.CommitReservationResponse{}, nil
+}
+
+// RestockReservation возвращает на склад детали подтвержденного резерва
+func (a *API) RestockReservation(
+	ctx context.Context,
+	req *pb.RestockReservationRequest,
+) (*pb.RestockReservationResponse, error) {
+	if err := requireServiceCall(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.reservationService.RestockReservation(ctx, req.GetOrderUuid()); err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &pb.RestockReservationResponse{}, nil
+}
+
+// requireServiceCall пропускает только вызовы других сервисов: резервами управляет order,
+// пользовательская сессия не дает права менять остатки склада
+func requireServiceCall(ctx context.Context) error {
+	if !grpcMiddleware.IsServiceCall(ctx) {
+		return status.Error(codes.PermissionDenied, "reservations are managed by services only")
+	}
+
+	return nil
+}
+
+// reservationError преобразует ошибку резервирования в gRPC статус
+func reservationError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidUUID), errors.Is(err, model.ErrInvalidReservation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrPartNotFound), errors.Is(err, model.ErrReservationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrReservationAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrInsufficientStock), errors.Is(err, model.ErrReservationClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
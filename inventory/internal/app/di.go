@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -15,20 +16,25 @@ import (
 	"github.com/radiophysiker/microservices-homework/inventory/internal/config"
 	"github.com/radiophysiker/microservices-homework/inventory/internal/repository"
 	partRepo "github.com/radiophysiker/microservices-homework/inventory/internal/repository/part"
+	reservationRepo "github.com/radiophysiker/microservices-homework/inventory/internal/repository/reservation"
 	"github.com/radiophysiker/microservices-homework/inventory/internal/service"
 	partSvc "github.com/radiophysiker/microservices-homework/inventory/internal/service/part"
+	reservationSvc "github.com/radiophysiker/microservices-homework/inventory/internal/service/reservation"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/closer"
 	grpcMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/grpc"
 	authpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/auth/v1"
 )
 
 type diContainer struct {
-	mongoClient    *mongo.Client
-	collection     *mongo.Collection
-	iamConn        *grpc.ClientConn
-	partRepository repository.PartRepository
-	partService    service.PartService
-	api            *apiv1.API
+	mongoClient            *mongo.Client
+	collection             *mongo.Collection
+	reservationsCollection *mongo.Collection
+	iamConn                *grpc.ClientConn
+	partRepository         repository.PartRepository
+	reservationRepository  repository.ReservationRepository
+	partService            service.PartService
+	reservationService     service.ReservationService
+	api                    *apiv1.API
 }
 
 func newDiContainer() *diContainer {
@@ -82,6 +88,31 @@ func (d *diContainer) Collection(ctx context.Context) (*mongo.Collection, error)
 	return d.collection, nil
 }
 
+// ReservationsCollection возвращает коллекцию резервов с уникальным индексом по заказу,
+// чтобы под один заказ нельзя было создать два резерва
+func (d *diContainer) ReservationsCollection(ctx context.Context) (*mongo.Collection, error) {
+	if d.reservationsCollection == nil {
+		client, err := d.MongoClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		collection := client.Database(config.AppConfig().Mongo.DatabaseName()).Collection("reservations")
+
+		_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "orderUuid", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create reservations index: %w", err)
+		}
+
+		d.reservationsCollection = collection
+	}
+
+	return d.reservationsCollection, nil
+}
+
 func (d *diContainer) PartRepository(ctx context.Context) (repository.PartRepository, error) {
 	if d.partRepository == nil {
 		collection, err := d.Collection(ctx)
@@ -95,6 +126,24 @@ func (d *diContainer) PartRepository(ctx context.Context) (repository.PartReposi
 	return d.partRepository, nil
 }
 
+func (d *diContainer) ReservationRepository(ctx context.Context) (repository.ReservationRepository, error) {
+	if d.reservationRepository == nil {
+		parts, err := d.Collection(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		reservations, err := d.ReservationsCollection(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.reservationRepository = reservationRepo.NewRepository(parts, reservations)
+	}
+
+	return d.reservationRepository, nil
+}
+
 func (d *diContainer) PartService(ctx context.Context) (service.PartService, error) {
 	if d.partService == nil {
 		partRepo, err := d.PartRepository(ctx)
@@ -108,6 +157,19 @@ func (d *diContainer) PartService(ctx context.Context) (service.PartService, err
 	return d.partService, nil
 }
 
+func (d *diContainer) ReservationService(ctx context.Context) (service.ReservationService, error) {
+	if d.reservationService == nil {
+		reservationRepo, err := d.ReservationRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.reservationService = reservationSvc.NewService(reservationRepo)
+	}
+
+	return d.reservationService, nil
+}
+
 func (d *diContainer) API(ctx context.Context) (*apiv1.API, error) {
 	if d.api == nil {
 		partService, err := d.PartService(ctx)
@@ -115,7 +177,12 @@ func (d *diContainer) API(ctx context.Context) (*apiv1.API, error) {
 			return nil, err
 		}
 
-		d.api = apiv1.NewAPI(partService)
+		reservationService, err := d.ReservationService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.api = apiv1.NewAPI(partService, reservationService)
 	}
 
 	return d.api, nil
@@ -156,5 +223,8 @@ func (d *diContainer) AuthInterceptor(ctx context.Context) (*grpcMiddleware.Auth
 		return nil, err
 	}
 
-	return grpcMiddleware.NewAuthInterceptor(iamClient), nil
+	return grpcMiddleware.NewAuthInterceptor(
+		iamClient,
+		grpcMiddleware.WithServiceToken(config.AppConfig().ServiceAuth.Token()),
+	), nil
 }
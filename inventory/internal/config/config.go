@@ -15,6 +15,7 @@ type config struct {
 	InventoryGRPC InventoryGRPCConfig
 	IAMGRPC       IAMGRPCConfig
 	Mongo         MongoConfig
+	ServiceAuth   ServiceAuthConfig
 }
 
 func Load(path ...string) error {
@@ -43,11 +44,17 @@ func Load(path ...string) error {
 		return err
 	}
 
+	serviceAuthCfg, err := env.NewServiceAuthConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:        loggerCfg,
 		InventoryGRPC: inventoryGRPCCfg,
 		IAMGRPC:       iamGRPCCfg,
 		Mongo:         mongoCfg,
+		ServiceAuth:   serviceAuthCfg,
 	}
 
 	return nil
@@ -16,6 +16,10 @@ type IAMGRPCConfig interface {
 	IAMAddress() string
 }
 
+type ServiceAuthConfig interface {
+	Token() string
+}
+
 type MongoConfig interface {
 	URI() string
 	DatabaseName() string
@@ -14,16 +14,18 @@ func ToProtoPart(p *model.Part) *pb.Part {
 	}
 
 	return &pb.Part{
-		Uuid:         p.UUID,
-		Name:         p.Name,
-		Description:  p.Description,
-		Price:        p.Price,
-		Category:     toProtoCategory(p.Category),
-		Dimensions:   toProtoDimensions(p.Dimensions),
-		Manufacturer: toProtoManufacturer(p.Manufacturer),
-		Tags:         p.Tags,
-		CreatedAt:    timestamppb.New(p.CreatedAt),
-		UpdatedAt:    timestamppb.New(p.UpdatedAt),
+		Uuid:             p.UUID,
+		Name:             p.Name,
+		Description:      p.Description,
+		Price:            p.Price,
+		Category:         toProtoCategory(p.Category),
+		Dimensions:       toProtoDimensions(p.Dimensions),
+		Manufacturer:     toProtoManufacturer(p.Manufacturer),
+		Tags:             p.Tags,
+		StockQuantity:    p.StockQuantity,
+		ReservedQuantity: p.ReservedQuantity,
+		CreatedAt:        timestamppb.New(p.CreatedAt),
+		UpdatedAt:        timestamppb.New(p.UpdatedAt),
 	}
 }
 
@@ -0,0 +1,19 @@
+package converter
+
+import (
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/inventory/v1"
+)
+
+// ToModelReservationItems конвертирует позиции резерва из proto в доменную модель
+func ToModelReservationItems(items []*pb.ReservationItem) []model.ReservationItem {
+	result := make([]model.ReservationItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, model.ReservationItem{
+			PartUUID: item.GetPartUuid(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+
+	return result
+}
@@ -10,6 +10,16 @@ var (
 	ErrPartNotFound = errors.New("part not found")
 	// ErrInvalidUUID - ошибка "некорректный UUID"
 	ErrInvalidUUID = errors.New("invalid UUID")
+	// ErrInvalidReservation - ошибка "некорректный запрос на резервирование"
+	ErrInvalidReservation = errors.New("invalid reservation")
+	// ErrInsufficientStock - ошибка "недостаточно деталей на складе"
+	ErrInsufficientStock = errors.New("insufficient stock")
+	// ErrReservationNotFound - ошибка "резерв не найден"
+	ErrReservationNotFound = errors.New("reservation not found")
+	// ErrReservationAlreadyExists - ошибка "резерв для заказа уже существует"
+	ErrReservationAlreadyExists = errors.New("reservation already exists")
+	// ErrReservationClosed - ошибка "резерв уже подтвержден или снят"
+	ErrReservationClosed = errors.New("reservation is already committed or released")
 )
 
 // NewErrPartNotFound создает ошибку "деталь не найдена"
@@ -17,6 +27,11 @@ func NewErrPartNotFound(uuid string) error {
 	return fmt.Errorf("%w: %s", ErrPartNotFound, uuid)
 }
 
+// NewErrInsufficientStock создает ошибку "недостаточно деталей на складе"
+func NewErrInsufficientStock(uuid string) error {
+	return fmt.Errorf("%w: %s", ErrInsufficientStock, uuid)
+}
+
 // NewErrInvalidUUID создает ошибку "некорректный UUID"
 func NewErrInvalidUUID(uuid string) error {
 	return fmt.Errorf("%w: %s", ErrInvalidUUID, uuid)
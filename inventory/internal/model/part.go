@@ -25,8 +25,12 @@ type Part struct {
 	Dimensions   *Dimensions
 	Manufacturer *Manufacturer
 	Tags         []string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	// StockQuantity доступный для заказа остаток
+	StockQuantity int64
+	// ReservedQuantity количество, зарезервированное под неоплаченные заказы
+	ReservedQuantity int64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 // Dimensions представляет размеры детали
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+)
+
+// ReservationStatus представляет состояние резерва деталей под заказ
+type ReservationStatus int32
+
+const (
+	ReservationStatusUnspecified ReservationStatus = iota
+	// ReservationStatusReserved детали зарезервированы и недоступны другим заказам
+	ReservationStatusReserved
+	// ReservationStatusCommitted заказ оплачен, детали списаны со склада
+	ReservationStatusCommitted
+	// ReservationStatusReleased заказ отменен, детали возвращены в остаток
+	ReservationStatusReleased
+	// ReservationStatusReleasing резерв снимается: детали возвращаются в остаток
+	ReservationStatusReleasing
+	// ReservationStatusCommitting резерв подтверждается: детали списываются со склада
+	ReservationStatusCommitting
+	// ReservationStatusRestocking списанные детали возвращаются на склад после возврата оплаты
+	ReservationStatusRestocking
+	// ReservationStatusRestocked заказ отменен с возвратом оплаты, списанные детали возвращены на склад
+	ReservationStatusRestocked
+	// ReservationStatusReserving детали резервируются: часть позиций может быть еще не списана
+	ReservationStatusReserving
+)
+
+// ReservationItem представляет позицию резерва
+type ReservationItem struct {
+	PartUUID string
+	Quantity int64
+}
+
+// Reservation представляет резерв деталей под заказ
+type Reservation struct {
+	OrderUUID string
+	Items     []ReservationItem
+	Status    ReservationStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
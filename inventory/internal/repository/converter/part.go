@@ -12,16 +12,18 @@ func ToServicePart(repoPart *repoModel.Part) *model.Part {
 	}
 
 	return &model.Part{
-		UUID:         repoPart.UUID,
-		Name:         repoPart.Name,
-		Description:  repoPart.Description,
-		Price:        repoPart.Price,
-		Category:     toServiceCategory(repoPart.Category),
-		Dimensions:   toServiceDimensions(repoPart.Dimensions),
-		Manufacturer: toServiceManufacturer(repoPart.Manufacturer),
-		Tags:         repoPart.Tags,
-		CreatedAt:    repoPart.CreatedAt,
-		UpdatedAt:    repoPart.UpdatedAt,
+		UUID:             repoPart.UUID,
+		Name:             repoPart.Name,
+		Description:      repoPart.Description,
+		Price:            repoPart.Price,
+		Category:         toServiceCategory(repoPart.Category),
+		Dimensions:       toServiceDimensions(repoPart.Dimensions),
+		Manufacturer:     toServiceManufacturer(repoPart.Manufacturer),
+		Tags:             repoPart.Tags,
+		StockQuantity:    repoPart.StockQuantity,
+		ReservedQuantity: repoPart.ReservedQuantity,
+		CreatedAt:        repoPart.CreatedAt,
+		UpdatedAt:        repoPart.UpdatedAt,
 	}
 }
 
@@ -46,16 +48,18 @@ func ToRepoPart(servicePart *model.Part) *repoModel.Part {
 	}
 
 	return &repoModel.Part{
-		UUID:         servicePart.UUID,
-		Name:         servicePart.Name,
-		Description:  servicePart.Description,
-		Price:        servicePart.Price,
-		Category:     ToRepoCategory(servicePart.Category),
-		Dimensions:   toRepoDimensions(servicePart.Dimensions),
-		Manufacturer: toRepoManufacturer(servicePart.Manufacturer),
-		Tags:         servicePart.Tags,
-		CreatedAt:    servicePart.CreatedAt,
-		UpdatedAt:    servicePart.UpdatedAt,
+		UUID:             servicePart.UUID,
+		Name:             servicePart.Name,
+		Description:      servicePart.Description,
+		Price:            servicePart.Price,
+		Category:         ToRepoCategory(servicePart.Category),
+		Dimensions:       toRepoDimensions(servicePart.Dimensions),
+		Manufacturer:     toRepoManufacturer(servicePart.Manufacturer),
+		Tags:             servicePart.Tags,
+		StockQuantity:    servicePart.StockQuantity,
+		ReservedQuantity: servicePart.ReservedQuantity,
+		CreatedAt:        servicePart.CreatedAt,
+		UpdatedAt:        servicePart.UpdatedAt,
 	}
 }
 
@@ -53,9 +53,11 @@ func (s *ConverterTestSuite) TestToServicePart() {
 					Country: "USA",
 					Website: "https://test.com",
 				},
-				Tags:      []string{"tag1", "tag2"},
-				CreatedAt: now,
-				UpdatedAt: now,
+				Tags:             []string{"tag1", "tag2"},
+				StockQuantity:    7,
+				ReservedQuantity: 2,
+				CreatedAt:        now,
+				UpdatedAt:        now,
 			},
 			expected: &model.Part{
 				UUID:        "550e8400-e29b-41d4-a716-446655440001",
@@ -74,9 +76,11 @@ func (s *ConverterTestSuite) TestToServicePart() {
 					Country: "USA",
 					Website: "https://test.com",
 				},
-				Tags:      []string{"tag1", "tag2"},
-				CreatedAt: now,
-				UpdatedAt: now,
+				Tags:             []string{"tag1", "tag2"},
+				StockQuantity:    7,
+				ReservedQuantity: 2,
+				CreatedAt:        now,
+				UpdatedAt:        now,
 			},
 		},
 		{
@@ -215,9 +219,11 @@ func (s *ConverterTestSuite) TestToRepoPart() {
 					Country: "USA",
 					Website: "https://test.com",
 				},
-				Tags:      []string{"tag1", "tag2"},
-				CreatedAt: now,
-				UpdatedAt: now,
+				Tags:             []string{"tag1", "tag2"},
+				StockQuantity:    7,
+				ReservedQuantity: 2,
+				CreatedAt:        now,
+				UpdatedAt:        now,
 			},
 			expected: &repoModel.Part{
 				UUID:        "550e8400-e29b-41d4-a716-446655440001",
@@ -236,9 +242,11 @@ func (s *ConverterTestSuite) TestToRepoPart() {
 					Country: "USA",
 					Website: "https://test.com",
 				},
-				Tags:      []string{"tag1", "tag2"},
-				CreatedAt: now,
-				UpdatedAt: now,
+				Tags:             []string{"tag1", "tag2"},
+				StockQuantity:    7,
+				ReservedQuantity: 2,
+				CreatedAt:        now,
+				UpdatedAt:        now,
 			},
 		},
 		{
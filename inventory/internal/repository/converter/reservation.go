@@ -0,0 +1,52 @@
+package converter
+
+import (
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+	repoModel "github.com/radiophysiker/microservices-homework/inventory/internal/repository/model"
+)
+
+// ToRepoReservation конвертирует модель резерва service в модель repository
+func ToRepoReservation(reservation *model.Reservation) *repoModel.Reservation {
+	if reservation == nil {
+		return nil
+	}
+
+	items := make([]repoModel.ReservationItem, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		items = append(items, repoModel.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return &repoModel.Reservation{
+		OrderUUID: reservation.OrderUUID,
+		Items:     items,
+		Status:    repoModel.ReservationStatus(reservation.Status),
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
+
+// ToServiceReservation конвертирует модель резерва repository в модель service
+func ToServiceReservation(reservation *repoModel.Reservation) *model.Reservation {
+	if reservation == nil {
+		return nil
+	}
+
+	items := make([]model.ReservationItem, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		items = append(items, model.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return &model.Reservation{
+		OrderUUID: reservation.OrderUUID,
+		Items:     items,
+		Status:    model.ReservationStatus(reservation.Status),
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
@@ -0,0 +1,267 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package repository
+
+import (
+	"context"
+
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockReservationRepository creates a new instance of MockReservationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReservationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReservationRepository {
+	mock := &MockReservationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReservationRepository is an autogenerated mock type for the ReservationRepository type
+type MockReservationRepository struct {
+	mock.Mock
+}
+
+type MockReservationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReservationRepository) EXPECT() *MockReservationRepository_Expecter {
+	return &MockReservationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Commit provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) Commit(ctx context.Context, orderUUID string) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationRepository_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type MockReservationRepository_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *MockReservationRepository_Expecter) Commit(ctx interface{}, orderUUID interface{}) *MockReservationRepository_Commit_Call {
+	return &MockReservationRepository_Commit_Call{Call: _e.mock.On("Commit", ctx, orderUUID)}
+}
+
+func (_c *MockReservationRepository_Commit_Call) Run(run func(ctx context.Context, orderUUID string)) *MockReservationRepository_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_Commit_Call) Return(err error) *MockReservationRepository_Commit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationRepository_Commit_Call) RunAndReturn(run func(ctx context.Context, orderUUID string) error) *MockReservationRepository_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) Release(ctx context.Context, orderUUID string) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationRepository_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type MockReservationRepository_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *MockReservationRepository_Expecter) Release(ctx interface{}, orderUUID interface{}) *MockReservationRepository_Release_Call {
+	return &MockReservationRepository_Release_Call{Call: _e.mock.On("Release", ctx, orderUUID)}
+}
+
+func (_c *MockReservationRepository_Release_Call) Run(run func(ctx context.Context, orderUUID string)) *MockReservationRepository_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_Release_Call) Return(err error) *MockReservationRepository_Release_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationRepository_Release_Call) RunAndReturn(run func(ctx context.Context, orderUUID string) error) *MockReservationRepository_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reserve provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) Reserve(ctx context.Context, reservation *model.Reservation) error {
+	ret := _mock.Called(ctx, reservation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Reservation) error); ok {
+		r0 = returnFunc(ctx, reservation)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationRepository_Reserve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reserve'
+type MockReservationRepository_Reserve_Call struct {
+	*mock.Call
+}
+
+// Reserve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservation *model.Reservation
+func (_e *MockReservationRepository_Expecter) Reserve(ctx interface{}, reservation interface{}) *MockReservationRepository_Reserve_Call {
+	return &MockReservationRepository_Reserve_Call{Call: _e.mock.On("Reserve", ctx, reservation)}
+}
+
+func (_c *MockReservationRepository_Reserve_Call) Run(run func(ctx context.Context, reservation *model.Reservation)) *MockReservationRepository_Reserve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Reservation
+		if args[1] != nil {
+			arg1 = args[1].(*model.Reservation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_Reserve_Call) Return(err error) *MockReservationRepository_Reserve_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationRepository_Reserve_Call) RunAndReturn(run func(ctx context.Context, reservation *model.Reservation) error) *MockReservationRepository_Reserve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restock provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) Restock(ctx context.Context, orderUUID string) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationRepository_Restock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restock'
+type MockReservationRepository_Restock_Call struct {
+	*mock.Call
+}
+
+// Restock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *MockReservationRepository_Expecter) Restock(ctx interface{}, orderUUID interface{}) *MockReservationRepository_Restock_Call {
+	return &MockReservationRepository_Restock_Call{Call: _e.mock.On("Restock", ctx, orderUUID)}
+}
+
+func (_c *MockReservationRepository_Restock_Call) Run(run func(ctx context.Context, orderUUID string)) *MockReservationRepository_Restock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_Restock_Call) Return(err error) *MockReservationRepository_Restock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationRepository_Restock_Call) RunAndReturn(run func(ctx context.Context, orderUUID string) error) *MockReservationRepository_Restock_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -25,8 +25,12 @@ type Part struct {
 	Dimensions   *Dimensions   `bson:"dimensions,omitempty"`
 	Manufacturer *Manufacturer `bson:"manufacturer,omitempty"`
 	Tags         []string      `bson:"tags,omitempty"`
-	CreatedAt    time.Time     `bson:"createdAt"`
-	UpdatedAt    time.Time     `bson:"updatedAt"`
+	// StockQuantity доступный для заказа остаток
+	StockQuantity int64 `bson:"stockQuantity"`
+	// ReservedQuantity количество, зарезервированное под неоплаченные заказы
+	ReservedQuantity int64     `bson:"reservedQuantity"`
+	CreatedAt        time.Time `bson:"createdAt"`
+	UpdatedAt        time.Time `bson:"updatedAt"`
 }
 
 // Dimensions представляет размеры детали
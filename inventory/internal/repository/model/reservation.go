@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+)
+
+// ReservationStatus представляет состояние резерва в repository слое
+type ReservationStatus int32
+
+const (
+	ReservationStatusUnspecified ReservationStatus = iota
+	ReservationStatusReserved
+	ReservationStatusCommitted
+	ReservationStatusReleased
+	ReservationStatusReleasing
+	ReservationStatusCommitting
+	ReservationStatusRestocking
+	ReservationStatusRestocked
+	ReservationStatusReserving
+)
+
+// ReservationItem представляет позицию резерва в repository слое
+type ReservationItem struct {
+	PartUUID string `bson:"partUuid"`
+	Quantity int64  `bson:"quantity"`
+}
+
+// Reservation представляет документ резерва деталей под заказ
+type Reservation struct {
+	OrderUUID string            `bson:"orderUuid"`
+	Items     []ReservationItem `bson:"items"`
+	Status    ReservationStatus `bson:"status"`
+	CreatedAt time.Time         `bson:"createdAt"`
+	UpdatedAt time.Time         `bson:"updatedAt"`
+}
@@ -24,7 +24,8 @@ var testParts = []*model.Part{
 			Name:    "SpaceTech",
 			Country: "USA",
 		},
-		Tags: []string{"engine", "propulsion", "v8"},
+		Tags:          []string{"engine", "propulsion", "v8"},
+		StockQuantity: 10,
 	},
 	{
 		UUID:        fuelTankUUID,
@@ -36,7 +37,8 @@ var testParts = []*model.Part{
 			Name:    "FuelCorp",
 			Country: "Germany",
 		},
-		Tags: []string{"fuel", "storage", "tank"},
+		Tags:          []string{"fuel", "storage", "tank"},
+		StockQuantity: 25,
 	},
 	{
 		UUID:        wingUUID,
@@ -48,7 +50,8 @@ var testParts = []*model.Part{
 			Name:    "AeroParts",
 			Country: "France",
 		},
-		Tags: []string{"wing", "structure", "aerodynamics"},
+		Tags:          []string{"wing", "structure", "aerodynamics"},
+		StockQuantity: 20,
 	},
 	{
 		UUID:        cockpitUUID,
@@ -60,7 +63,8 @@ var testParts = []*model.Part{
 			Name:    "ControlTech",
 			Country: "Japan",
 		},
-		Tags: []string{"cockpit", "control", "pilot"},
+		Tags:          []string{"cockpit", "control", "pilot"},
+		StockQuantity: 5,
 	},
 }
 
@@ -14,3 +14,18 @@ type PartRepository interface {
 	// ListParts возвращает список деталей с возможностью фильтрации
 	ListParts(ctx context.Context, filter *model.Filter) ([]*model.Part, error)
 }
+
+// ReservationRepository представляет интерфейс для работы с резервами деталей в repository слое
+type ReservationRepository interface {
+	// Reserve атомарно резервирует детали под заказ
+	Reserve(ctx context.Context, reservation *model.Reservation) error
+
+	// Release снимает резерв заказа и возвращает детали в остаток
+	Release(ctx context.Context, orderUUID string) error
+
+	// Commit подтверждает резерв заказа и списывает детали со склада
+	Commit(ctx context.Context, orderUUID string) error
+
+	// Restock возвращает на склад детали подтвержденного резерва
+	Restock(ctx context.Context, orderUUID string) error
+}
@@ -0,0 +1,225 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+	"github.com/radiophysiker/microservices-homework/inventory/internal/repository/converter"
+	repoModel "github.com/radiophysiker/microservices-homework/inventory/internal/repository/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// appliedOperationsField - поле документа детали со списком уже примененных к ней операций резервов
+// (списания в резерв и закрытия).
+// Счетчик детали и отметка об операции меняются одним обновлением документа,
+// поэтому повтор прерванного закрытия не изменит остаток дважды
+const appliedOperationsField = "appliedReservationOps"
+
+// closeOperation описывает закрытие резерва: из какого статуса оно возможно, каким
+// промежуточным статусом резерв захватывается, в какой статус переходит в конце
+// и как на единицу позиции меняются доступный остаток и резерв детали
+type closeOperation struct {
+	name          string
+	from          repoModel.ReservationStatus
+	pending       repoModel.ReservationStatus
+	to            repoModel.ReservationStatus
+	stockDelta    int64
+	reservedDelta int64
+}
+
+var (
+	// releaseOperation возвращает зарезервированные детали в доступный остаток
+	releaseOperation = closeOperation{
+		name:          "release",
+		from:          repoModel.ReservationStatusReserved,
+		pending:       repoModel.ReservationStatusReleasing,
+		to:            repoModel.ReservationStatusReleased,
+		stockDelta:    1,
+		reservedDelta: -1,
+	}
+	// commitOperation окончательно списывает зарезервированные детали
+	commitOperation = closeOperation{
+		name:          "commit",
+		from:          repoModel.ReservationStatusReserved,
+		pending:       repoModel.ReservationStatusCommitting,
+		to:            repoModel.ReservationStatusCommitted,
+		reservedDelta: -1,
+	}
+	// restockOperation возвращает на склад уже списанные детали
+	restockOperation = closeOperation{
+		name:       "restock",
+		from:       repoModel.ReservationStatusCommitted,
+		pending:    repoModel.ReservationStatusRestocking,
+		to:         repoModel.ReservationStatusRestocked,
+		stockDelta: 1,
+	}
+)
+
+// Release снимает резерв заказа и возвращает детали в доступный остаток.
+// Незавершенное резервирование откатывается: возвращаются только уже списанные позиции.
+// Повторное снятие уже снятого резерва не считается ошибкой.
+func (r *Repository) Release(ctx context.Context, orderUUID string) error {
+	err := r.close(ctx, orderUUID, releaseOperation)
+	if !errors.Is(err, model.ErrReservationClosed) {
+		return err
+	}
+
+	if abortErr := r.abortReserving(ctx, orderUUID); !errors.Is(abortErr, model.ErrReservationClosed) {
+		return abortErr
+	}
+
+	return err
+}
+
+// Commit подтверждает резерв заказа: детали окончательно списываются со склада.
+// Повторное подтверждение уже подтвержденного резерва не считается ошибкой.
+func (r *Repository) Commit(ctx context.Context, orderUUID string) error {
+	return r.close(ctx, orderUUID, commitOperation)
+}
+
+// Restock возвращает на склад детали подтвержденного резерва.
+// Если резерв так и не был подтвержден, он снимается, как при Release.
+// Повторный возврат уже возвращенного резерва не считается ошибкой.
+func (r *Repository) Restock(ctx context.Context, orderUUID string) error {
+	err := r.close(ctx, orderUUID, restockOperation)
+	if !errors.Is(err, model.ErrReservationClosed) {
+		return err
+	}
+
+	if releaseErr := r.close(ctx, orderUUID, releaseOperation); releaseErr != nil {
+		return err
+	}
+
+	return nil
+}
+
+// close закрывает резерв операцией op. Резерв сначала захватывается промежуточным статусом,
+// затем меняются счетчики деталей, и только после этого резерв переходит в итоговый статус.
+// Если закрытие прервалось, резерв остается в промежуточном статусе, а повторный вызов
+// той же операции доводит его до конца, не изменяя уже обновленные детали повторно
+func (r *Repository) close(ctx context.Context, orderUUID string, op closeOperation) error {
+	now := time.Now()
+
+	reservation, err := r.claim(ctx, orderUUID, op, now)
+	if err != nil || reservation == nil {
+		return err
+	}
+
+	operationKey := orderUUID + ":" + op.name
+
+	for _, item := range reservation.Items {
+		if err := r.applyItem(ctx, operationKey, op, item, now); err != nil {
+			return err
+		}
+	}
+
+	filter := bson.M{"orderUuid": orderUUID, "status": op.pending}
+	update := bson.M{"$set": bson.M{"status": op.to, "updatedAt": now}}
+
+	if _, err := r.reservations.UpdateOne(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to finish reservation %s: %w", op.name, err)
+	}
+
+	r.forgetOperation(ctx, operationKey, reservation.Items)
+
+	return nil
+}
+
+// claim атомарно переводит резерв из статуса op.from в промежуточный статус op.pending.
+// Возвращает резерв, если его нужно закрыть этим вызовом (в том числе после прерванного
+// закрытия), и nil, если резерв уже был в статусе op.to
+func (r *Repository) claim(ctx context.Context, orderUUID string, op closeOperation, now time.Time) (*model.Reservation, error) {
+	filter := bson.M{
+		"orderUuid": orderUUID,
+		"status":    op.from,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":    op.pending,
+			"updatedAt": now,
+		},
+	}
+
+	var doc repoModel.Reservation
+
+	err := r.reservations.FindOneAndUpdate(ctx, filter, update).Decode(&doc)
+	if err == nil {
+		return converter.ToServiceReservation(&doc), nil
+	}
+
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to update reservation: %w", err)
+	}
+
+	err = r.reservations.FindOne(ctx, bson.M{"orderUuid": orderUUID}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrReservationNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get reservation: %w", err)
+	}
+
+	switch doc.Status {
+	case op.to:
+		return nil, nil
+	case op.pending:
+		return converter.ToServiceReservation(&doc), nil
+	default:
+		return nil, model.ErrReservationClosed
+	}
+}
+
+// applyItem меняет счетчики детали позиции резерва, если операция к ней еще не применялась
+func (r *Repository) applyItem(ctx context.Context, operationKey string, op closeOperation, item model.ReservationItem, now time.Time) error {
+	inc := bson.M{}
+	if op.stockDelta != 0 {
+		inc["stockQuantity"] = op.stockDelta * item.Quantity
+	}
+
+	if op.reservedDelta != 0 {
+		inc["reservedQuantity"] = op.reservedDelta * item.Quantity
+	}
+
+	filter := bson.M{
+		"uuid":                 item.PartUUID,
+		appliedOperationsField: bson.M{"$ne": operationKey},
+	}
+	update := bson.M{
+		"$inc":  inc,
+		"$push": bson.M{appliedOperationsField: operationKey},
+		"$set":  bson.M{"updatedAt": now},
+	}
+
+	if _, err := r.parts.UpdateOne(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to %s part %s: %w", op.name, item.PartUUID, err)
+	}
+
+	return nil
+}
+
+// forgetOperation убирает отметки закрытой операции из деталей.
+// Ошибка только логируется: оставшаяся отметка не влияет на остатки
+func (r *Repository) forgetOperation(ctx context.Context, operationKey string, items []model.ReservationItem) {
+	partUUIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		partUUIDs = append(partUUIDs, item.PartUUID)
+	}
+
+	filter := bson.M{"uuid": bson.M{"$in": partUUIDs}}
+	update := bson.M{"$pull": bson.M{appliedOperationsField: operationKey}}
+
+	if _, err := r.parts.UpdateMany(ctx, filter, update); err != nil {
+		logger.Warn(ctx, "Failed to clean up applied reservation operation",
+			zap.String("operation", operationKey),
+			zap.Error(err),
+		)
+	}
+}
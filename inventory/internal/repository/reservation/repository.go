@@ -0,0 +1,19 @@
+package reservation
+
+import "go.mongodb.org/mongo-driver/mongo"
+
+// Repository реализует интерфейс ReservationRepository.
+// Остатки хранятся в документах деталей, а сами резервы - в отдельной коллекции,
+// чтобы снятие и подтверждение резерва можно было выполнить ровно один раз.
+type Repository struct {
+	parts        *mongo.Collection
+	reservations *mongo.Collection
+}
+
+// NewRepository создает новый экземпляр Repository
+func NewRepository(parts, reservations *mongo.Collection) *Repository {
+	return &Repository{
+		parts:        parts,
+		reservations: reservations,
+	}
+}
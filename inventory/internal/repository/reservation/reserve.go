@@ -0,0 +1,197 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+	"github.com/radiophysiker/microservices-homework/inventory/internal/repository/converter"
+	repoModel "github.com/radiophysiker/microservices-homework/inventory/internal/repository/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// Reserve резервирует детали под заказ.
+// Резерв создается в статусе Reserving, затем каждая позиция списывается условным обновлением,
+// которое срабатывает только при достаточном остатке и тем же обновлением помечает деталь ключом
+// резервирования. Поэтому по деталям всегда видно, какие позиции уже списаны, и откат
+// (здесь или в Release прерванного резервирования) возвращает в остаток только их.
+func (r *Repository) Reserve(ctx context.Context, reservation *model.Reservation) error {
+	now := time.Now()
+
+	doc := converter.ToRepoReservation(reservation)
+	doc.Status = repoModel.ReservationStatusReserving
+	doc.CreatedAt = now
+	doc.UpdatedAt = now
+
+	if _, err := r.reservations.InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrReservationAlreadyExists
+		}
+
+		return fmt.Errorf("failed to insert reservation: %w", err)
+	}
+
+	operationKey := reserveOperationKey(reservation.OrderUUID)
+
+	for _, item := range reservation.Items {
+		if err := r.reserveItem(ctx, operationKey, item, now); err != nil {
+			r.rollback(ctx, reservation.OrderUUID, reservation.Items)
+			return err
+		}
+	}
+
+	filter := bson.M{"orderUuid": reservation.OrderUUID, "status": repoModel.ReservationStatusReserving}
+	update := bson.M{"$set": bson.M{"status": repoModel.ReservationStatusReserved, "updatedAt": time.Now()}}
+
+	// При ошибке резерв не откатывается: обновление могло примениться, а незавершенный
+	// резерв снимет Release
+	res, err := r.reservations.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to finish reservation: %w", err)
+	}
+
+	// Резерв сняли, пока позиции списывались: возвращаем то, что успели списать после этого
+	if res.MatchedCount == 0 {
+		if err := r.restoreStock(ctx, operationKey, reservation.Items); err != nil {
+			logger.Error(ctx, "Failed to roll back stock of released reservation",
+				zap.String("order_uuid", reservation.OrderUUID),
+				zap.Error(err),
+			)
+		}
+
+		return model.ErrReservationClosed
+	}
+
+	r.forgetOperation(ctx, operationKey, reservation.Items)
+
+	return nil
+}
+
+// reserveItem списывает позицию в резерв, если остатка хватает и позиция еще не списана этим резервом
+func (r *Repository) reserveItem(ctx context.Context, operationKey string, item model.ReservationItem, now time.Time) error {
+	filter := bson.M{
+		"uuid":                 item.PartUUID,
+		"stockQuantity":        bson.M{"$gte": item.Quantity},
+		appliedOperationsField: bson.M{"$ne": operationKey},
+	}
+	update := bson.M{
+		"$inc": bson.M{
+			"stockQuantity":    -item.Quantity,
+			"reservedQuantity": item.Quantity,
+		},
+		"$push": bson.M{appliedOperationsField: operationKey},
+		"$set":  bson.M{"updatedAt": now},
+	}
+
+	res, err := r.parts.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to reserve part %s: %w", item.PartUUID, err)
+	}
+
+	if res.MatchedCount > 0 {
+		return nil
+	}
+
+	// Условие не сработало: либо детали нет, либо остатка не хватает
+	count, err := r.parts.CountDocuments(ctx, bson.M{"uuid": item.PartUUID})
+	if err != nil {
+		return fmt.Errorf("failed to check part %s: %w", item.PartUUID, err)
+	}
+
+	if count == 0 {
+		return model.NewErrPartNotFound(item.PartUUID)
+	}
+
+	return model.NewErrInsufficientStock(item.PartUUID)
+}
+
+// rollback возвращает в остаток уже списанные позиции и удаляет документ незавершенного резерва.
+// Ошибки только логируются: исходная ошибка резервирования важнее для вызывающей стороны.
+// Если откат прервался, резерв остается в статусе Reserving и его снимет Release
+func (r *Repository) rollback(ctx context.Context, orderUUID string, items []model.ReservationItem) {
+	if err := r.restoreStock(ctx, reserveOperationKey(orderUUID), items); err != nil {
+		logger.Error(ctx, "Failed to roll back reserved stock",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	filter := bson.M{"orderUuid": orderUUID, "status": repoModel.ReservationStatusReserving}
+	if _, err := r.reservations.DeleteOne(ctx, filter); err != nil {
+		logger.Error(ctx, "Failed to delete rolled back reservation",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+	}
+}
+
+// abortReserving снимает резерв, резервирование которого не завершилось: возвращает в остаток
+// только помеченные ключом резервирования позиции и переводит резерв в статус Released.
+// Возвращает model.ErrReservationClosed, если резерв не находится в статусе Reserving
+func (r *Repository) abortReserving(ctx context.Context, orderUUID string) error {
+	var doc repoModel.Reservation
+
+	err := r.reservations.FindOne(ctx, bson.M{"orderUuid": orderUUID, "status": repoModel.ReservationStatusReserving}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.ErrReservationClosed
+		}
+
+		return fmt.Errorf("failed to get reservation: %w", err)
+	}
+
+	reservation := converter.ToServiceReservation(&doc)
+
+	if err := r.restoreStock(ctx, reserveOperationKey(orderUUID), reservation.Items); err != nil {
+		return err
+	}
+
+	filter := bson.M{"orderUuid": orderUUID, "status": repoModel.ReservationStatusReserving}
+	update := bson.M{"$set": bson.M{"status": repoModel.ReservationStatusReleased, "updatedAt": time.Now()}}
+
+	if _, err := r.reservations.UpdateOne(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to finish reservation release: %w", err)
+	}
+
+	return nil
+}
+
+// restoreStock возвращает в доступный остаток позиции, помеченные ключом резервирования, и снимает
+// пометку тем же обновлением, поэтому повторный или параллельный откат не вернет позицию дважды
+func (r *Repository) restoreStock(ctx context.Context, operationKey string, items []model.ReservationItem) error {
+	now := time.Now()
+
+	for _, item := range items {
+		filter := bson.M{
+			"uuid":                 item.PartUUID,
+			appliedOperationsField: operationKey,
+		}
+		update := bson.M{
+			"$inc": bson.M{
+				"stockQuantity":    item.Quantity,
+				"reservedQuantity": -item.Quantity,
+			},
+			"$pull": bson.M{appliedOperationsField: operationKey},
+			"$set":  bson.M{"updatedAt": now},
+		}
+
+		if _, err := r.parts.UpdateOne(ctx, filter, update); err != nil {
+			return fmt.Errorf("failed to restore part %s: %w", item.PartUUID, err)
+		}
+	}
+
+	return nil
+}
+
+// reserveOperationKey возвращает ключ, которым помечаются детали, списанные в резерв заказа
+func reserveOperationKey(orderUUID string) string {
+	return orderUUID + ":reserve"
+}
@@ -0,0 +1,273 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package service
+
+import (
+	"context"
+
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockReservationService creates a new instance of MockReservationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReservationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReservationService {
+	mock := &MockReservationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReservationService is an autogenerated mock type for the ReservationService type
+type MockReservationService struct {
+	mock.Mock
+}
+
+type MockReservationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReservationService) EXPECT() *MockReservationService_Expecter {
+	return &MockReservationService_Expecter{mock: &_m.Mock}
+}
+
+// CommitReservation provides a mock function for the type MockReservationService
+func (_mock *MockReservationService) CommitReservation(ctx context.Context, orderUUID string) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationService_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type MockReservationService_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *MockReservationService_Expecter) CommitReservation(ctx interface{}, orderUUID interface{}) *MockReservationService_CommitReservation_Call {
+	return &MockReservationService_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, orderUUID)}
+}
+
+func (_c *MockReservationService_CommitReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *MockReservationService_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationService_CommitReservation_Call) Return(err error) *MockReservationService_CommitReservation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationService_CommitReservation_Call) RunAndReturn(run func(ctx context.Context, orderUUID string) error) *MockReservationService_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function for the type MockReservationService
+func (_mock *MockReservationService) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationService_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type MockReservationService_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *MockReservationService_Expecter) ReleaseReservation(ctx interface{}, orderUUID interface{}) *MockReservationService_ReleaseReservation_Call {
+	return &MockReservationService_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, orderUUID)}
+}
+
+func (_c *MockReservationService_ReleaseReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *MockReservationService_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationService_ReleaseReservation_Call) Return(err error) *MockReservationService_ReleaseReservation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationService_ReleaseReservation_Call) RunAndReturn(run func(ctx context.Context, orderUUID string) error) *MockReservationService_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function for the type MockReservationService
+func (_mock *MockReservationService) ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) error {
+	ret := _mock.Called(ctx, orderUUID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []model.ReservationItem) error); ok {
+		r0 = returnFunc(ctx, orderUUID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationService_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type MockReservationService_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - items []model.ReservationItem
+func (_e *MockReservationService_Expecter) ReserveParts(ctx interface{}, orderUUID interface{}, items interface{}) *MockReservationService_ReserveParts_Call {
+	return &MockReservationService_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, orderUUID, items)}
+}
+
+func (_c *MockReservationService_ReserveParts_Call) Run(run func(ctx context.Context, orderUUID string, items []model.ReservationItem)) *MockReservationService_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []model.ReservationItem
+		if args[2] != nil {
+			arg2 = args[2].([]model.ReservationItem)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationService_ReserveParts_Call) Return(err error) *MockReservationService_ReserveParts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationService_ReserveParts_Call) RunAndReturn(run func(ctx context.Context, orderUUID string, items []model.ReservationItem) error) *MockReservationService_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestockReservation provides a mock function for the type MockReservationService
+func (_mock *MockReservationService) RestockReservation(ctx context.Context, orderUUID string) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestockReservation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationService_RestockReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestockReservation'
+type MockReservationService_RestockReservation_Call struct {
+	*mock.Call
+}
+
+// RestockReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *MockReservationService_Expecter) RestockReservation(ctx interface{}, orderUUID interface{}) *MockReservationService_RestockReservation_Call {
+	return &MockReservationService_RestockReservation_Call{Call: _e.mock.On("RestockReservation", ctx, orderUUID)}
+}
+
+func (_c *MockReservationService_RestockReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *MockReservationService_RestockReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationService_RestockReservation_Call) Return(err error) *MockReservationService_RestockReservation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationService_RestockReservation_Call) RunAndReturn(run func(ctx context.Context, orderUUID string) error) *MockReservationService_RestockReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,49 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+)
+
+// ReleaseReservation снимает резерв заказа и возвращает детали в остаток
+func (s *Service) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	if _, err := uuid.Parse(orderUUID); err != nil {
+		return model.NewErrInvalidUUID(orderUUID)
+	}
+
+	if err := s.reservationRepository.Release(ctx, orderUUID); err != nil {
+		return fmt.Errorf("failed to release reservation: %w", err)
+	}
+
+	return nil
+}
+
+// CommitReservation подтверждает резерв заказа и списывает детали со склада
+func (s *Service) CommitReservation(ctx context.Context, orderUUID string) error {
+	if _, err := uuid.Parse(orderUUID); err != nil {
+		return model.NewErrInvalidUUID(orderUUID)
+	}
+
+	if err := s.reservationRepository.Commit(ctx, orderUUID); err != nil {
+		return fmt.Errorf("failed to commit reservation: %w", err)
+	}
+
+	return nil
+}
+
+// RestockReservation возвращает на склад детали резерва заказа, оплата которого возвращена
+func (s *Service) RestockReservation(ctx context.Context, orderUUID string) error {
+	if _, err := uuid.Parse(orderUUID); err != nil {
+		return model.NewErrInvalidUUID(orderUUID)
+	}
+
+	if err := s.reservationRepository.Restock(ctx, orderUUID); err != nil {
+		return fmt.Errorf("failed to restock reservation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package reservation
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+)
+
+// TestReleaseReservation проверяет метод ReleaseReservation
+func (s *ServiceTestSuite) TestReleaseReservation() {
+	const orderUUID = "a23e4567-e89b-12d3-a456-426614174000"
+
+	tests := []struct {
+		name      string
+		orderUUID string
+		setupMock func()
+		wantErr   error
+	}{
+		{
+			name:      "success",
+			orderUUID: orderUUID,
+			setupMock: func() {
+				s.repo.EXPECT().Release(s.ctx, orderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name:      "invalid_uuid",
+			orderUUID: "bad",
+			setupMock: func() {},
+			wantErr:   model.ErrInvalidUUID,
+		},
+		{
+			name:      "not_found",
+			orderUUID: orderUUID,
+			setupMock: func() {
+				s.repo.EXPECT().Release(s.ctx, orderUUID).Return(model.ErrReservationNotFound).Once()
+			},
+			wantErr: model.ErrReservationNotFound,
+		},
+		{
+			name:      "already_committed",
+			orderUUID: orderUUID,
+			setupMock: func() {
+				s.repo.EXPECT().Release(s.ctx, orderUUID).Return(model.ErrReservationClosed).Once()
+			},
+			wantErr: model.ErrReservationClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			err := s.service.ReleaseReservation(s.ctx, tt.orderUUID)
+
+			if tt.wantErr == nil {
+				require.NoError(s.T(), err)
+				return
+			}
+
+			require.ErrorIs(s.T(), err, tt.wantErr)
+		})
+	}
+}
+
+// TestCommitReservation проверяет метод CommitReservation
+func (s *ServiceTestSuite) TestCommitReservation() {
+	const orderUUID = "a23e4567-e89b-12d3-a456-426614174000"
+
+	repoErr := errors.New("database connection failed")
+
+	tests := []struct {
+		name      string
+		orderUUID string
+		setupMock func()
+		wantErr   error
+	}{
+		{
+			name:      "success",
+			orderUUID: orderUUID,
+			setupMock: func() {
+				s.repo.EXPECT().Commit(s.ctx, orderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name:      "invalid_uuid",
+			orderUUID: "",
+			setupMock: func() {},
+			wantErr:   model.ErrInvalidUUID,
+		},
+		{
+			name:      "already_released",
+			orderUUID: orderUUID,
+			setupMock: func() {
+				s.repo.EXPECT().Commit(s.ctx, orderUUID).Return(model.ErrReservationClosed).Once()
+			},
+			wantErr: model.ErrReservationClosed,
+		},
+		{
+			name:      "repository_error",
+			orderUUID: orderUUID,
+			setupMock: func() {
+				s.repo.EXPECT().Commit(s.ctx, orderUUID).Return(repoErr).Once()
+			},
+			wantErr: repoErr,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			err := s.service.CommitReservation(s.ctx, tt.orderUUID)
+
+			if tt.wantErr == nil {
+				require.NoError(s.T(), err)
+				return
+			}
+
+			require.ErrorIs(s.T(), err, tt.wantErr)
+		})
+	}
+}
+
+// TestRestockReservation проверяет метод RestockReservation
+func (s *ServiceTestSuite) TestRestockReservation() {
+	const orderUUID = "a23e4567-e89b-12d3-a456-426614174000"
+
+	repoErr := errors.New("database connection failed")
+
+	tests := []struct {
+		name      string
+		orderUUID string
+		setupMock func()
+		wantErr   error
+	}{
+		{
+			name:      "success",
+			orderUUID: orderUUID,
+			setupMock: func() {
+				s.repo.EXPECT().Restock(s.ctx, orderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name:      "invalid_uuid",
+			orderUUID: "bad",
+			setupMock: func() {},
+			wantErr:   model.ErrInvalidUUID,
+		},
+		{
+			name:      "not_found",
+			orderUUID: orderUUID,
+			setupMock: func() {
+				s.repo.EXPECT().Restock(s.ctx, orderUUID).Return(model.ErrReservationNotFound).Once()
+			},
+			wantErr: model.ErrReservationNotFound,
+		},
+		{
+			name:      "repository_error",
+			orderUUID: orderUUID,
+			setupMock: func() {
+				s.repo.EXPECT().Restock(s.ctx, orderUUID).Return(repoErr).Once()
+			},
+			wantErr: repoErr,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			err := s.service.RestockReservation(s.ctx, tt.orderUUID)
+
+			if tt.wantErr == nil {
+				require.NoError(s.T(), err)
+				return
+			}
+
+			require.ErrorIs(s.T(), err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+)
+
+// ReserveParts резервирует детали под заказ.
+// Повторяющиеся позиции одной детали объединяются в одну.
+func (s *Service) ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) error {
+	if _, err := uuid.Parse(orderUUID); err != nil {
+		return model.NewErrInvalidUUID(orderUUID)
+	}
+
+	merged, err := mergeItems(items)
+	if err != nil {
+		return err
+	}
+
+	reservation := &model.Reservation{
+		OrderUUID: orderUUID,
+		Items:     merged,
+		Status:    model.ReservationStatusReserved,
+	}
+
+	if err := s.reservationRepository.Reserve(ctx, reservation); err != nil {
+		return fmt.Errorf("failed to reserve parts: %w", err)
+	}
+
+	return nil
+}
+
+// mergeItems проверяет позиции резерва и объединяет повторяющиеся детали, сохраняя порядок
+func mergeItems(items []model.ReservationItem) ([]model.ReservationItem, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: no items", model.ErrInvalidReservation)
+	}
+
+	merged := make([]model.ReservationItem, 0, len(items))
+	index := make(map[string]int, len(items))
+
+	for _, item := range items {
+		if _, err := uuid.Parse(item.PartUUID); err != nil {
+			return nil, model.NewErrInvalidUUID(item.PartUUID)
+		}
+
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity of part %s must be positive", model.ErrInvalidReservation, item.PartUUID)
+		}
+
+		if i, ok := index[item.PartUUID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+
+		index[item.PartUUID] = len(merged)
+		merged = append(merged, item)
+	}
+
+	return merged, nil
+}
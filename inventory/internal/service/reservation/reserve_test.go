@@ -0,0 +1,127 @@
+package reservation
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/inventory/internal/model"
+)
+
+// TestReserveParts проверяет метод ReserveParts с различными сценариями
+func (s *ServiceTestSuite) TestReserveParts() {
+	const (
+		orderUUID = "a23e4567-e89b-12d3-a456-426614174000"
+		engine    = "123e4567-e89b-12d3-a456-426614174000"
+		wing      = "323e4567-e89b-12d3-a456-426614174002"
+	)
+
+	tests := []struct {
+		name      string
+		orderUUID string
+		items     []model.ReservationItem
+		setupMock func()
+		wantErr   error
+	}{
+		{
+			name:      "success_duplicates_merged",
+			orderUUID: orderUUID,
+			items: []model.ReservationItem{
+				{PartUUID: engine, Quantity: 1},
+				{PartUUID: wing, Quantity: 2},
+				{PartUUID: engine, Quantity: 3},
+			},
+			setupMock: func() {
+				s.repo.EXPECT().
+					Reserve(s.ctx, &model.Reservation{
+						OrderUUID: orderUUID,
+						Items: []model.ReservationItem{
+							{PartUUID: engine, Quantity: 4},
+							{PartUUID: wing, Quantity: 2},
+						},
+						Status: model.ReservationStatusReserved,
+					}).
+					Return(nil).
+					Once()
+			},
+		},
+		{
+			name:      "invalid_order_uuid",
+			orderUUID: "bad",
+			items:     []model.ReservationItem{{PartUUID: engine, Quantity: 1}},
+			setupMock: func() {},
+			wantErr:   model.ErrInvalidUUID,
+		},
+		{
+			name:      "invalid_part_uuid",
+			orderUUID: orderUUID,
+			items:     []model.ReservationItem{{PartUUID: "bad", Quantity: 1}},
+			setupMock: func() {},
+			wantErr:   model.ErrInvalidUUID,
+		},
+		{
+			name:      "no_items",
+			orderUUID: orderUUID,
+			setupMock: func() {},
+			wantErr:   model.ErrInvalidReservation,
+		},
+		{
+			name:      "non_positive_quantity",
+			orderUUID: orderUUID,
+			items:     []model.ReservationItem{{PartUUID: engine, Quantity: 0}},
+			setupMock: func() {},
+			wantErr:   model.ErrInvalidReservation,
+		},
+		{
+			name:      "insufficient_stock",
+			orderUUID: orderUUID,
+			items:     []model.ReservationItem{{PartUUID: engine, Quantity: 100}},
+			setupMock: func() {
+				s.repo.EXPECT().
+					Reserve(s.ctx, &model.Reservation{
+						OrderUUID: orderUUID,
+						Items:     []model.ReservationItem{{PartUUID: engine, Quantity: 100}},
+						Status:    model.ReservationStatusReserved,
+					}).
+					Return(model.NewErrInsufficientStock(engine)).
+					Once()
+			},
+			wantErr: model.ErrInsufficientStock,
+		},
+		{
+			name:      "repository_error",
+			orderUUID: orderUUID,
+			items:     []model.ReservationItem{{PartUUID: engine, Quantity: 1}},
+			setupMock: func() {
+				s.repo.EXPECT().
+					Reserve(s.ctx, &model.Reservation{
+						OrderUUID: orderUUID,
+						Items:     []model.ReservationItem{{PartUUID: engine, Quantity: 1}},
+						Status:    model.ReservationStatusReserved,
+					}).
+					Return(errors.New("database connection failed")).
+					Once()
+			},
+			wantErr: errors.New("failed to reserve parts"),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			err := s.service.ReserveParts(s.ctx, tt.orderUUID, tt.items)
+
+			switch {
+			case tt.wantErr == nil:
+				require.NoError(s.T(), err)
+			case errors.Is(tt.wantErr, model.ErrInvalidUUID),
+				errors.Is(tt.wantErr, model.ErrInvalidReservation),
+				errors.Is(tt.wantErr, model.ErrInsufficientStock):
+				require.ErrorIs(s.T(), err, tt.wantErr)
+			default:
+				require.ErrorContains(s.T(), err, tt.wantErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package reservation
+
+import (
+	"github.com/radiophysiker/microservices-homework/inventory/internal/repository"
+)
+
+// Service реализует интерфейс ReservationService
+type Service struct {
+	reservationRepository repository.ReservationRepository
+}
+
+// NewService создает новый экземпляр Service
+func NewService(reservationRepository repository.ReservationRepository) *Service {
+	return &Service{
+		reservationRepository: reservationRepository,
+	}
+}
@@ -0,0 +1,27 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	repomocks "github.com/radiophysiker/microservices-homework/inventory/internal/repository/mocks"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	repo    *repomocks.MockReservationRepository
+	service *Service
+	ctx     context.Context
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.repo = repomocks.NewMockReservationRepository(s.T())
+	s.service = NewService(s.repo)
+	s.ctx = context.Background()
+}
+
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
@@ -14,3 +14,18 @@ type PartService interface {
 	// ListParts возвращает список деталей с возможностью фильтрации
 	ListParts(ctx context.Context, filter *model.Filter) ([]*model.Part, error)
 }
+
+// ReservationService представляет интерфейс для резервирования деталей под заказы
+type ReservationService interface {
+	// ReserveParts резервирует детали под заказ
+	ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) error
+
+	// ReleaseReservation снимает резерв заказа
+	ReleaseReservation(ctx context.Context, orderUUID string) error
+
+	// CommitReservation подтверждает резерв заказа
+	CommitReservation(ctx context.Context, orderUUID string) error
+
+	// RestockReservation возвращает на склад детали подтвержденного резерва
+	RestockReservation(ctx context.Context, orderUUID string) error
+}
@@ -10,6 +10,7 @@ require (
 	github.com/IBM/sarama v1.46.3
 	github.com/caarlos0/env/v11 v11.3.1
 	github.com/go-telegram/bot v1.17.0
+	github.com/gomodule/redigo v1.9.3
 	github.com/google/uuid v1.6.0
 	github.com/joho/godotenv v1.5.1
 	github.com/radiophysiker/microservices-homework/platform v0.0.0-20251112151515-a870437b7b54
@@ -12,6 +12,7 @@ import (
 	"github.com/radiophysiker/microservices-homework/notification/internal/config"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/closer"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
 )
 
 type App struct {
@@ -45,6 +46,29 @@ func (a *App) Run(ctx context.Context) error {
 		return nil
 	})
 
+	g.Go(func() error {
+		orderCreatedConsumerService, err := a.diContainer.OrderCreatedConsumerService(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to get OrderCreatedConsumerService", zap.Error(err))
+			return err
+		}
+
+		logger.Info(ctx, "Starting OrderCreated consumer")
+
+		if err := orderCreatedConsumerService.RunConsumer(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				logger.Info(ctx, "OrderCreated consumer stopped")
+				return nil
+			}
+
+			logger.Error(ctx, "OrderCreated consumer error", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+
 	g.Go(func() error {
 		orderPaidConsumerService, err := a.diContainer.OrderPaidConsumerService(ctx)
 		if err != nil {
@@ -68,6 +92,29 @@ func (a *App) Run(ctx context.Context) error {
 		return nil
 	})
 
+	g.Go(func() error {
+		orderCancelledConsumerService, err := a.diContainer.OrderCancelledConsumerService(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to get OrderCancelledConsumerService", zap.Error(err))
+			return err
+		}
+
+		logger.Info(ctx, "Starting OrderCancelled consumer")
+
+		if err := orderCancelledConsumerService.RunConsumer(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				logger.Info(ctx, "OrderCancelled consumer stopped")
+				return nil
+			}
+
+			logger.Error(ctx, "OrderCancelled consumer error", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+
 	g.Go(func() error {
 		orderAssembledConsumerService, err := a.diContainer.OrderAssembledConsumerService(ctx)
 		if err != nil {
@@ -110,6 +157,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.initTracing,
 		a.initHTTPServer,
 	}
 
@@ -143,6 +191,16 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initTracing(ctx context.Context) error {
+	if err := tracing.InitTracer(ctx, config.AppConfig().Tracing); err != nil {
+		return err
+	}
+
+	closer.AddNamed("Tracer", tracing.ShutdownTracer)
+
+	return nil
+}
+
 func (a *App) initHTTPServer(ctx context.Context) error {
 	api, err := a.diContainer.API(ctx)
 	if err != nil {
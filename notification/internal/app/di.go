@@ -3,8 +3,12 @@ package app
 import (
 	"context"
 	"fmt"
+	"net"
+	"time"
 
 	"github.com/IBM/sarama"
+	redigo "github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
 
 	v1 "github.com/radiophysiker/microservices-homework/notification/internal/api/telegram/v1"
 	"github.com/radiophysiker/microservices-homework/notification/internal/client/http/telegram"
@@ -13,27 +17,46 @@ import (
 	"github.com/radiophysiker/microservices-homework/notification/internal/converter/kafka/decoder"
 	svc "github.com/radiophysiker/microservices-homework/notification/internal/service"
 	orderAssembledConsumerSvc "github.com/radiophysiker/microservices-homework/notification/internal/service/consumer/order_assembled_consumer"
+	orderCancelledConsumerSvc "github.com/radiophysiker/microservices-homework/notification/internal/service/consumer/order_cancelled_consumer"
+	orderCreatedConsumerSvc "github.com/radiophysiker/microservices-homework/notification/internal/service/consumer/order_created_consumer"
 	orderPaidConsumerSvc "github.com/radiophysiker/microservices-homework/notification/internal/service/consumer/order_paid_consumer"
 	telegramSvc "github.com/radiophysiker/microservices-homework/notification/internal/service/telegram"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/cache"
+	redisclient "github.com/radiophysiker/microservices-homework/platform/pkg/cache/redis"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/closer"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
 	kafkaConsumer "github.com/radiophysiker/microservices-homework/platform/pkg/kafka/consumer"
+	dedupRedis "github.com/radiophysiker/microservices-homework/platform/pkg/kafka/dedup/redis"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
 )
 
 type diContainer struct {
+	deadLetterSyncProducer sarama.SyncProducer
+
+	redisPool   *redigo.Pool
+	redisClient cache.RedisClient
+
+	orderCreatedConsumerGroup   sarama.ConsumerGroup
+	orderCreatedConsumer        kafka.Consumer
 	orderPaidConsumerGroup      sarama.ConsumerGroup
 	orderPaidConsumer           kafka.Consumer
+	orderCancelledConsumerGroup sarama.ConsumerGroup
+	orderCancelledConsumer      kafka.Consumer
 	orderAssembledConsumerGroup sarama.ConsumerGroup
 	orderAssembledConsumer      kafka.Consumer
 
+	orderCreatedDecoder   kafkaConverter.OrderCreatedDecoder
 	orderPaidDecoder      kafkaConverter.OrderPaidDecoder
+	orderCancelledDecoder kafkaConverter.OrderCancelledDecoder
 	orderAssembledDecoder kafkaConverter.OrderAssembledDecoder
 
 	telegramClient  *telegram.Client
 	telegramService svc.TelegramService
 
+	orderCreatedConsumerService   svc.OrderCreatedConsumerService
 	orderPaidConsumerService      svc.OrderPaidConsumerService
+	orderCancelledConsumerService svc.OrderCancelledConsumerService
 	orderAssembledConsumerService svc.OrderAssembledConsumerService
 
 	api *v1.API
@@ -43,6 +66,160 @@ func newDiContainer() *diContainer {
 	return &diContainer{}
 }
 
+func (d *diContainer) DeadLetterSyncProducer(_ context.Context) (sarama.SyncProducer, error) {
+	if d.deadLetterSyncProducer == nil {
+		producerCfg := sarama.NewConfig()
+		producerCfg.Version = sarama.V4_0_0_0
+		producerCfg.Producer.Return.Successes = true
+
+		producer, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			producerCfg,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create dead letter sync producer: %w", err)
+		}
+
+		closer.AddNamed("Dead letter sync producer", func(ctx context.Context) error {
+			return producer.Close()
+		})
+
+		d.deadLetterSyncProducer = producer
+	}
+
+	return d.deadLetterSyncProducer, nil
+}
+
+// ConsumerMiddlewares возвращает цепочку middleware consumer'а: обработка продолжает трейс
+// producer'а, выполняется с повторами, а после исчерпания попыток сообщение отправляется в <topic>.dlq
+func (d *diContainer) ConsumerMiddlewares(ctx context.Context, extra ...kafkaConsumer.Middleware) ([]kafkaConsumer.Middleware, error) {
+	deadLetterProducer, err := d.DeadLetterSyncProducer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	retryCfg := config.AppConfig().ConsumerRetry
+	retryPolicy := kafkaConsumer.RetryPolicy{
+		MaxAttempts:    retryCfg.MaxAttempts(),
+		InitialBackoff: retryCfg.InitialBackoff(),
+		MaxBackoff:     retryCfg.MaxBackoff(),
+	}
+
+	middlewares := []kafkaConsumer.Middleware{
+		kafkaConsumer.Tracing(),
+		kafkaConsumer.DeadLetter(deadLetterProducer, logger.Logger()),
+		kafkaConsumer.Retry(retryPolicy, logger.Logger()),
+	}
+
+	return append(middlewares, extra...), nil
+}
+
+// RedisPool возвращает пул соединений Redis с lazy initialization.
+func (d *diContainer) RedisPool(ctx context.Context) (*redigo.Pool, error) {
+	if d.redisPool == nil {
+		cfg := config.AppConfig()
+		redisCfg := cfg.Redis
+
+		address := net.JoinHostPort(redisCfg.Host(), redisCfg.Port())
+
+		pool := &redigo.Pool{
+			MaxIdle:     redisCfg.MaxIdle(),
+			IdleTimeout: redisCfg.IdleTimeout(),
+			Dial: func() (redigo.Conn, error) {
+				conn, err := redigo.Dial("tcp", address)
+				if err != nil {
+					return nil, fmt.Errorf("dial redis: %w", err)
+				}
+				return conn, nil
+			},
+			TestOnBorrow: func(c redigo.Conn, t time.Time) error {
+				if time.Since(t) < time.Minute {
+					return nil
+				}
+				_, err := c.Do("PING")
+				return err
+			},
+		}
+
+		conn := pool.Get()
+		defer func() {
+			if err := conn.Close(); err != nil {
+				logger.Error(ctx, "failed to close redis connection", zap.Error(err))
+			}
+		}()
+
+		if _, err := conn.Do("PING"); err != nil {
+			if err := pool.Close(); err != nil {
+				logger.Error(ctx, "failed to close redis pool", zap.Error(err))
+			}
+
+			return nil, fmt.Errorf("ping redis: %w", err)
+		}
+
+		closer.AddNamed("Redis pool", func(ctx context.Context) error {
+			return pool.Close()
+		})
+
+		d.redisPool = pool
+	}
+
+	return d.redisPool, nil
+}
+
+// RedisClient возвращает Redis клиент с lazy initialization.
+func (d *diContainer) RedisClient(ctx context.Context) (cache.RedisClient, error) {
+	if d.redisClient == nil {
+		redisPool, err := d.RedisPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.redisClient = redisclient.NewClient(
+			redisPool,
+			logger.Logger(),
+			config.AppConfig().Redis.ConnectionTimeout(),
+		)
+	}
+
+	return d.redisClient, nil
+}
+
+// Deduplicate возвращает middleware, пропускающее события, уже обработанные consumer group.
+func (d *diContainer) Deduplicate(ctx context.Context, groupID string, eventID kafkaConsumer.EventIDFunc) (kafkaConsumer.Middleware, error) {
+	redisClient, err := d.RedisClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	store := dedupRedis.NewStore(redisClient, "processed_events:"+groupID, config.AppConfig().ProcessedEvents.TTL())
+
+	return kafkaConsumer.Deduplicate(store, eventID, logger.Logger()), nil
+}
+
+func (d *diContainer) OrderCreatedConsumerGroup(ctx context.Context) (sarama.ConsumerGroup, error) {
+	if d.orderCreatedConsumerGroup == nil {
+		cfg := config.AppConfig()
+		consumerCfg := cfg.OrderCreatedConsumer
+
+		group, err := sarama.NewConsumerGroup(
+			cfg.Kafka.Brokers(),
+			consumerCfg.GroupID(),
+			consumerCfg.Config(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create consumer group: %w", err)
+		}
+
+		closer.AddNamed("OrderCreated consumer group", func(ctx context.Context) error {
+			return group.Close()
+		})
+
+		d.orderCreatedConsumerGroup = group
+	}
+
+	return d.orderCreatedConsumerGroup, nil
+}
+
 func (d *diContainer) OrderPaidConsumerGroup(ctx context.Context) (sarama.ConsumerGroup, error) {
 	if d.orderPaidConsumerGroup == nil {
 		cfg := config.AppConfig()
@@ -67,6 +244,30 @@ func (d *diContainer) OrderPaidConsumerGroup(ctx context.Context) (sarama.Consum
 	return d.orderPaidConsumerGroup, nil
 }
 
+func (d *diContainer) OrderCancelledConsumerGroup(ctx context.Context) (sarama.ConsumerGroup, error) {
+	if d.orderCancelledConsumerGroup == nil {
+		cfg := config.AppConfig()
+		consumerCfg := cfg.OrderCancelledConsumer
+
+		group, err := sarama.NewConsumerGroup(
+			cfg.Kafka.Brokers(),
+			consumerCfg.GroupID(),
+			consumerCfg.Config(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create consumer group: %w", err)
+		}
+
+		closer.AddNamed("OrderCancelled consumer group", func(ctx context.Context) error {
+			return group.Close()
+		})
+
+		d.orderCancelledConsumerGroup = group
+	}
+
+	return d.orderCancelledConsumerGroup, nil
+}
+
 func (d *diContainer) OrderAssembledConsumerGroup(ctx context.Context) (sarama.ConsumerGroup, error) {
 	if d.orderAssembledConsumerGroup == nil {
 		cfg := config.AppConfig()
@@ -91,6 +292,41 @@ func (d *diContainer) OrderAssembledConsumerGroup(ctx context.Context) (sarama.C
 	return d.orderAssembledConsumerGroup, nil
 }
 
+func (d *diContainer) OrderCreatedConsumer(ctx context.Context) (kafka.Consumer, error) {
+	if d.orderCreatedConsumer == nil {
+		group, err := d.OrderCreatedConsumerGroup(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := config.AppConfig()
+		topics := []string{cfg.OrderCreatedConsumer.Topic()}
+
+		deduplicate, err := d.Deduplicate(
+			ctx,
+			cfg.OrderCreatedConsumer.GroupID(),
+			kafkaConsumer.ProtoEventID(func() *eventspb.OrderCreated { return &eventspb.OrderCreated{} }),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		middlewares, err := d.ConsumerMiddlewares(ctx, deduplicate)
+		if err != nil {
+			return nil, err
+		}
+
+		d.orderCreatedConsumer = kafkaConsumer.NewConsumer(
+			group,
+			topics,
+			logger.Logger(),
+			middlewares...,
+		)
+	}
+
+	return d.orderCreatedConsumer, nil
+}
+
 func (d *diContainer) OrderPaidConsumer(ctx context.Context) (kafka.Consumer, error) {
 	if d.orderPaidConsumer == nil {
 		group, err := d.OrderPaidConsumerGroup(ctx)
@@ -101,16 +337,66 @@ func (d *diContainer) OrderPaidConsumer(ctx context.Context) (kafka.Consumer, er
 		cfg := config.AppConfig()
 		topics := []string{cfg.OrderPaidConsumer.Topic()}
 
+		deduplicate, err := d.Deduplicate(
+			ctx,
+			cfg.OrderPaidConsumer.GroupID(),
+			kafkaConsumer.ProtoEventID(func() *eventspb.OrderPaid { return &eventspb.OrderPaid{} }),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		middlewares, err := d.ConsumerMiddlewares(ctx, deduplicate)
+		if err != nil {
+			return nil, err
+		}
+
 		d.orderPaidConsumer = kafkaConsumer.NewConsumer(
 			group,
 			topics,
 			logger.Logger(),
+			middlewares...,
 		)
 	}
 
 	return d.orderPaidConsumer, nil
 }
 
+func (d *diContainer) OrderCancelledConsumer(ctx context.Context) (kafka.Consumer, error) {
+	if d.orderCancelledConsumer == nil {
+		group, err := d.OrderCancelledConsumerGroup(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := config.AppConfig()
+		topics := []string{cfg.OrderCancelledConsumer.Topic()}
+
+		deduplicate, err := d.Deduplicate(
+			ctx,
+			cfg.OrderCancelledConsumer.GroupID(),
+			kafkaConsumer.ProtoEventID(func() *eventspb.OrderCancelled { return &eventspb.OrderCancelled{} }),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		middlewares, err := d.ConsumerMiddlewares(ctx, deduplicate)
+		if err != nil {
+			return nil, err
+		}
+
+		d.orderCancelledConsumer = kafkaConsumer.NewConsumer(
+			group,
+			topics,
+			logger.Logger(),
+			middlewares...,
+		)
+	}
+
+	return d.orderCancelledConsumer, nil
+}
+
 func (d *diContainer) OrderAssembledConsumer(ctx context.Context) (kafka.Consumer, error) {
 	if d.orderAssembledConsumer == nil {
 		group, err := d.OrderAssembledConsumerGroup(ctx)
@@ -121,16 +407,39 @@ func (d *diContainer) OrderAssembledConsumer(ctx context.Context) (kafka.Consume
 		cfg := config.AppConfig()
 		topics := []string{cfg.OrderAssembledConsumer.Topic()}
 
+		deduplicate, err := d.Deduplicate(
+			ctx,
+			cfg.OrderAssembledConsumer.GroupID(),
+			kafkaConsumer.ProtoEventID(func() *eventspb.ShipAssembled { return &eventspb.ShipAssembled{} }),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		middlewares, err := d.ConsumerMiddlewares(ctx, deduplicate)
+		if err != nil {
+			return nil, err
+		}
+
 		d.orderAssembledConsumer = kafkaConsumer.NewConsumer(
 			group,
 			topics,
 			logger.Logger(),
+			middlewares...,
 		)
 	}
 
 	return d.orderAssembledConsumer, nil
 }
 
+func (d *diContainer) OrderCreatedDecoder(_ context.Context) (kafkaConverter.OrderCreatedDecoder, error) {
+	if d.orderCreatedDecoder == nil {
+		d.orderCreatedDecoder = decoder.NewOrderCreatedDecoder()
+	}
+
+	return d.orderCreatedDecoder, nil
+}
+
 func (d *diContainer) OrderPaidDecoder(_ context.Context) (kafkaConverter.OrderPaidDecoder, error) {
 	if d.orderPaidDecoder == nil {
 		d.orderPaidDecoder = decoder.NewOrderPaidDecoder()
@@ -139,6 +448,14 @@ func (d *diContainer) OrderPaidDecoder(_ context.Context) (kafkaConverter.OrderP
 	return d.orderPaidDecoder, nil
 }
 
+func (d *diContainer) OrderCancelledDecoder(_ context.Context) (kafkaConverter.OrderCancelledDecoder, error) {
+	if d.orderCancelledDecoder == nil {
+		d.orderCancelledDecoder = decoder.NewOrderCancelledDecoder()
+	}
+
+	return d.orderCancelledDecoder, nil
+}
+
 func (d *diContainer) OrderAssembledDecoder(_ context.Context) (kafkaConverter.OrderAssembledDecoder, error) {
 	if d.orderAssembledDecoder == nil {
 		d.orderAssembledDecoder = decoder.NewOrderAssembledDecoder()
@@ -184,6 +501,33 @@ func (d *diContainer) TelegramService(ctx context.Context) (svc.TelegramService,
 	return d.telegramService, nil
 }
 
+func (d *diContainer) OrderCreatedConsumerService(ctx context.Context) (svc.OrderCreatedConsumerService, error) {
+	if d.orderCreatedConsumerService == nil {
+		consumer, err := d.OrderCreatedConsumer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder, err := d.OrderCreatedDecoder(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		telegramService, err := d.TelegramService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.orderCreatedConsumerService = orderCreatedConsumerSvc.NewService(
+			consumer,
+			decoder,
+			telegramService,
+		)
+	}
+
+	return d.orderCreatedConsumerService, nil
+}
+
 func (d *diContainer) OrderPaidConsumerService(ctx context.Context) (svc.OrderPaidConsumerService, error) {
 	if d.orderPaidConsumerService == nil {
 		consumer, err := d.OrderPaidConsumer(ctx)
@@ -211,6 +555,33 @@ func (d *diContainer) OrderPaidConsumerService(ctx context.Context) (svc.OrderPa
 	return d.orderPaidConsumerService, nil
 }
 
+func (d *diContainer) OrderCancelledConsumerService(ctx context.Context) (svc.OrderCancelledConsumerService, error) {
+	if d.orderCancelledConsumerService == nil {
+		consumer, err := d.OrderCancelledConsumer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder, err := d.OrderCancelledDecoder(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		telegramService, err := d.TelegramService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.orderCancelledConsumerService = orderCancelledConsumerSvc.NewService(
+			consumer,
+			decoder,
+			telegramService,
+		)
+	}
+
+	return d.orderCancelledConsumerService, nil
+}
+
 func (d *diContainer) OrderAssembledConsumerService(ctx context.Context) (svc.OrderAssembledConsumerService, error) {
 	if d.orderAssembledConsumerService == nil {
 		consumer, err := d.OrderAssembledConsumer(ctx)
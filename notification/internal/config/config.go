@@ -12,8 +12,14 @@ var appConfig *config
 
 type config struct {
 	Logger                 LoggerConfig
+	Tracing                TracingConfig
 	Kafka                  KafkaConfig
+	ConsumerRetry          ConsumerRetryConfig
+	Redis                  RedisConfig
+	ProcessedEvents        ProcessedEventsConfig
+	OrderCreatedConsumer   OrderCreatedConsumerConfig
 	OrderPaidConsumer      OrderPaidConsumerConfig
+	OrderCancelledConsumer OrderCancelledConsumerConfig
 	OrderAssembledConsumer OrderAssembledConsumerConfig
 	TelegramBot            TelegramBotConfig
 	HTTP                   HTTPConfig
@@ -30,16 +36,46 @@ func Load(path ...string) error {
 		return err
 	}
 
+	tracingCfg, err := env.NewTracingConfig()
+	if err != nil {
+		return err
+	}
+
 	kafkaCfg, err := env.NewKafkaConfig()
 	if err != nil {
 		return err
 	}
 
+	consumerRetryCfg, err := env.NewConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
+	redisCfg, err := env.NewRedisConfig()
+	if err != nil {
+		return err
+	}
+
+	processedEventsCfg, err := env.NewProcessedEventsConfig()
+	if err != nil {
+		return err
+	}
+
+	orderCreatedConsumerCfg, err := env.NewOrderCreatedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	orderPaidConsumerCfg, err := env.NewOrderPaidConsumerConfig()
 	if err != nil {
 		return err
 	}
 
+	orderCancelledConsumerCfg, err := env.NewOrderCancelledConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	orderAssembledConsumerCfg, err := env.NewOrderAssembledConsumerConfig()
 	if err != nil {
 		return err
@@ -57,8 +93,14 @@ func Load(path ...string) error {
 
 	appConfig = &config{
 		Logger:                 loggerCfg,
+		Tracing:                tracingCfg,
 		Kafka:                  kafkaCfg,
+		ConsumerRetry:          consumerRetryCfg,
+		Redis:                  redisCfg,
+		ProcessedEvents:        processedEventsCfg,
+		OrderCreatedConsumer:   orderCreatedConsumerCfg,
 		OrderPaidConsumer:      orderPaidConsumerCfg,
+		OrderCancelledConsumer: orderCancelledConsumerCfg,
 		OrderAssembledConsumer: orderAssembledConsumerCfg,
 		TelegramBot:            telegramBotCfg,
 		HTTP:                   httpCfg,
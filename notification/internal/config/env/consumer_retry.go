@@ -0,0 +1,41 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type consumerRetryEnvConfig struct {
+	MaxAttempts    int           `env:"KAFKA_CONSUMER_MAX_ATTEMPTS" envDefault:"5"`
+	InitialBackoff time.Duration `env:"KAFKA_CONSUMER_INITIAL_BACKOFF" envDefault:"500ms"`
+	MaxBackoff     time.Duration `env:"KAFKA_CONSUMER_MAX_BACKOFF" envDefault:"30s"`
+}
+
+type consumerRetryConfig struct {
+	raw consumerRetryEnvConfig
+}
+
+func NewConsumerRetryConfig() (*consumerRetryConfig, error) {
+	var raw consumerRetryEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &consumerRetryConfig{raw: raw}, nil
+}
+
+// MaxAttempts — число попыток обработки сообщения до отправки в DLQ
+func (cfg *consumerRetryConfig) MaxAttempts() int {
+	return cfg.raw.MaxAttempts
+}
+
+// InitialBackoff — пауза перед второй попыткой, далее она удваивается
+func (cfg *consumerRetryConfig) InitialBackoff() time.Duration {
+	return cfg.raw.InitialBackoff
+}
+
+// MaxBackoff — верхняя граница паузы между попытками
+func (cfg *consumerRetryConfig) MaxBackoff() time.Duration {
+	return cfg.raw.MaxBackoff
+}
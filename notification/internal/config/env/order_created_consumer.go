@@ -0,0 +1,42 @@
+//nolint:dupl // Файл похож на order_paid_consumer.go, но это разные конфигурации для разных топиков
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type OrderCreatedConsumerEnvConfig struct {
+	Topic   string `env:"ORDER_CREATED_TOPIC_NAME,required"`
+	GroupID string `env:"ORDER_CREATED_CONSUMER_GROUP_ID,required"`
+}
+
+type orderCreatedConsumerConfig struct {
+	raw OrderCreatedConsumerEnvConfig
+}
+
+func NewOrderCreatedConsumerConfig() (*orderCreatedConsumerConfig, error) {
+	var raw OrderCreatedConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCreatedConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCreatedConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *orderCreatedConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *orderCreatedConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	return config
+}
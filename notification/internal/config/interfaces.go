@@ -1,6 +1,10 @@
 package config
 
-import "github.com/IBM/sarama"
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 type LoggerConfig interface {
 	Level() string
@@ -10,16 +14,53 @@ type LoggerConfig interface {
 	ServiceName() string
 }
 
+type TracingConfig interface {
+	CollectorEndpoint() string
+	ServiceName() string
+	ServiceVersion() string
+	Environment() string
+}
+
 type KafkaConfig interface {
 	Brokers() []string
 }
 
+type ConsumerRetryConfig interface {
+	MaxAttempts() int
+	InitialBackoff() time.Duration
+	MaxBackoff() time.Duration
+}
+
+type RedisConfig interface {
+	Host() string
+	Port() string
+	ConnectionTimeout() time.Duration
+	MaxIdle() int
+	IdleTimeout() time.Duration
+}
+
+type ProcessedEventsConfig interface {
+	TTL() time.Duration
+}
+
+type OrderCreatedConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
 type OrderPaidConsumerConfig interface {
 	Topic() string
 	GroupID() string
 	Config() *sarama.Config
 }
 
+type OrderCancelledConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
 type OrderAssembledConsumerConfig interface {
 	Topic() string
 	GroupID() string
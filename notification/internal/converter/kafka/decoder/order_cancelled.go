@@ -0,0 +1,60 @@
+package decoder
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/notification/internal/model"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+type orderCancelledDecoder struct{}
+
+func NewOrderCancelledDecoder() *orderCancelledDecoder {
+	return &orderCancelledDecoder{}
+}
+
+func (d *orderCancelledDecoder) Decode(data []byte) (*model.OrderCancelled, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty message data")
+	}
+
+	var pb eventspb.OrderCancelled
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal OrderCancelled: %w", err)
+	}
+
+	eventUUID, err := uuid.Parse(pb.GetEventUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid event_uuid: %w", err)
+	}
+
+	orderUUID, err := uuid.Parse(pb.GetOrderUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid order_uuid: %w", err)
+	}
+
+	userUUID, err := uuid.Parse(pb.GetUserUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_uuid: %w", err)
+	}
+
+	event := &model.OrderCancelled{
+		EventUUID: eventUUID,
+		OrderUUID: orderUUID,
+		UserUUID:  userUUID,
+	}
+
+	if pb.GetRefundUuid() != "" {
+		refundUUID, err := uuid.Parse(pb.GetRefundUuid())
+		if err != nil {
+			return nil, fmt.Errorf("invalid refund_uuid: %w", err)
+		}
+
+		event.RefundUUID = &refundUUID
+	}
+
+	return event, nil
+}
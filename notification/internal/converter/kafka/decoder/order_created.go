@@ -0,0 +1,64 @@
+package decoder
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/notification/internal/model"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+type orderCreatedDecoder struct{}
+
+func NewOrderCreatedDecoder() *orderCreatedDecoder {
+	return &orderCreatedDecoder{}
+}
+
+func (d *orderCreatedDecoder) Decode(data []byte) (*model.OrderCreated, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty message data")
+	}
+
+	var pb eventspb.OrderCreated
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal OrderCreated: %w", err)
+	}
+
+	eventUUID, err := uuid.Parse(pb.GetEventUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid event_uuid: %w", err)
+	}
+
+	orderUUID, err := uuid.Parse(pb.GetOrderUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid order_uuid: %w", err)
+	}
+
+	userUUID, err := uuid.Parse(pb.GetUserUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_uuid: %w", err)
+	}
+
+	items := make([]model.OrderCreatedItem, 0, len(pb.GetItems()))
+	for _, item := range pb.GetItems() {
+		partUUID, err := uuid.Parse(item.GetPartUuid())
+		if err != nil {
+			return nil, fmt.Errorf("invalid part_uuid: %w", err)
+		}
+
+		items = append(items, model.OrderCreatedItem{
+			PartUUID: partUUID,
+			Quantity: item.GetQuantity(),
+		})
+	}
+
+	return &model.OrderCreated{
+		EventUUID:  eventUUID,
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		TotalPrice: pb.GetTotalPrice(),
+		Items:      items,
+	}, nil
+}
@@ -4,6 +4,11 @@ import (
 	"github.com/radiophysiker/microservices-homework/notification/internal/model"
 )
 
+// OrderCreatedDecoder декодирует сообщения OrderCreated из Kafka
+type OrderCreatedDecoder interface {
+	Decode(data []byte) (*model.OrderCreated, error)
+}
+
 // OrderPaidDecoder декодирует сообщения OrderPaid из Kafka
 type OrderPaidDecoder interface {
 	Decode(data []byte) (*model.OrderPaid, error)
@@ -13,3 +18,8 @@ type OrderPaidDecoder interface {
 type OrderAssembledDecoder interface {
 	Decode(data []byte) (*model.ShipAssembled, error)
 }
+
+// OrderCancelledDecoder декодирует сообщения OrderCancelled из Kafka
+type OrderCancelledDecoder interface {
+	Decode(data []byte) (*model.OrderCancelled, error)
+}
@@ -15,6 +15,21 @@ const (
 	PaymentMethodInvestorMoney
 )
 
+// OrderCreatedItem представляет позицию созданного заказа
+type OrderCreatedItem struct {
+	PartUUID uuid.UUID
+	Quantity int64
+}
+
+// OrderCreated представляет событие о создании заказа
+type OrderCreated struct {
+	EventUUID  uuid.UUID
+	OrderUUID  uuid.UUID
+	UserUUID   uuid.UUID
+	TotalPrice float64
+	Items      []OrderCreatedItem
+}
+
 // OrderPaid представляет событие об оплате заказа
 type OrderPaid struct {
 	EventUUID       uuid.UUID
@@ -24,6 +39,15 @@ type OrderPaid struct {
 	TransactionUUID uuid.UUID
 }
 
+// OrderCancelled представляет событие об отмене заказа.
+// RefundUUID заполнен, если по заказу был выполнен возврат средств
+type OrderCancelled struct {
+	EventUUID  uuid.UUID
+	OrderUUID  uuid.UUID
+	UserUUID   uuid.UUID
+	RefundUUID *uuid.UUID
+}
+
 // ShipAssembled представляет событие о завершении сборки корабля
 type ShipAssembled struct {
 	EventUUID    uuid.UUID
@@ -0,0 +1,42 @@
+package order_cancelled_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/radiophysiker/microservices-homework/notification/internal/converter/kafka"
+	svc "github.com/radiophysiker/microservices-homework/notification/internal/service"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+type service struct {
+	orderCancelledConsumer kafka.Consumer
+	orderCancelledDecoder  kafkaConverter.OrderCancelledDecoder
+	telegramService        svc.TelegramService
+}
+
+func NewService(
+	orderCancelledConsumer kafka.Consumer,
+	orderCancelledDecoder kafkaConverter.OrderCancelledDecoder,
+	telegramService svc.TelegramService,
+) svc.OrderCancelledConsumerService {
+	return &service{
+		orderCancelledConsumer: orderCancelledConsumer,
+		orderCancelledDecoder:  orderCancelledDecoder,
+		telegramService:        telegramService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting OrderCancelled consumer service")
+
+	err := s.orderCancelledConsumer.Consume(ctx, s.OrderCancelledHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from order.cancelled topic error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package order_cancelled_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+func (s *service) OrderCancelledHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.orderCancelledDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode OrderCancelled event",
+			zap.Error(err),
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+		)
+
+		return err
+	}
+
+	logger.Info(ctx, "OrderCancelled message received",
+		zap.String("topic", msg.Topic),
+		zap.Int32("partition", msg.Partition),
+		zap.Int64("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID.String()),
+		zap.String("order_uuid", event.OrderUUID.String()),
+		zap.String("user_uuid", event.UserUUID.String()),
+		zap.Bool("refunded", event.RefundUUID != nil),
+	)
+
+	if err := s.telegramService.SendOrderCancelledNotification(ctx, event); err != nil {
+		logger.Error(ctx, "Failed to send OrderCancelled notification",
+			zap.Error(err),
+			zap.String("order_uuid", event.OrderUUID.String()),
+		)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package order_created_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/radiophysiker/microservices-homework/notification/internal/converter/kafka"
+	svc "github.com/radiophysiker/microservices-homework/notification/internal/service"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+type service struct {
+	orderCreatedConsumer kafka.Consumer
+	orderCreatedDecoder  kafkaConverter.OrderCreatedDecoder
+	telegramService      svc.TelegramService
+}
+
+func NewService(
+	orderCreatedConsumer kafka.Consumer,
+	orderCreatedDecoder kafkaConverter.OrderCreatedDecoder,
+	telegramService svc.TelegramService,
+) svc.OrderCreatedConsumerService {
+	return &service{
+		orderCreatedConsumer: orderCreatedConsumer,
+		orderCreatedDecoder:  orderCreatedDecoder,
+		telegramService:      telegramService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting OrderCreated consumer service")
+
+	err := s.orderCreatedConsumer.Consume(ctx, s.OrderCreatedHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from order.created topic error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package order_created_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+func (s *service) OrderCreatedHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.orderCreatedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode OrderCreated event",
+			zap.Error(err),
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+		)
+
+		return err
+	}
+
+	logger.Info(ctx, "OrderCreated message received",
+		zap.String("topic", msg.Topic),
+		zap.Int32("partition", msg.Partition),
+		zap.Int64("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID.String()),
+		zap.String("order_uuid", event.OrderUUID.String()),
+		zap.String("user_uuid", event.UserUUID.String()),
+		zap.Float64("total_price", event.TotalPrice),
+		zap.Int("items", len(event.Items)),
+	)
+
+	if err := s.telegramService.SendOrderCreatedNotification(ctx, event); err != nil {
+		logger.Error(ctx, "Failed to send OrderCreated notification",
+			zap.Error(err),
+			zap.String("order_uuid", event.OrderUUID.String()),
+		)
+
+		return err
+	}
+
+	return nil
+}
@@ -6,12 +6,24 @@ import (
 	"github.com/radiophysiker/microservices-homework/notification/internal/model"
 )
 
+// OrderCreatedConsumerService представляет интерфейс для consumer'а событий OrderCreated
+type OrderCreatedConsumerService interface {
+	// RunConsumer запускает consumer для обработки событий OrderCreated
+	RunConsumer(ctx context.Context) error
+}
+
 // OrderPaidConsumerService представляет интерфейс для consumer'а событий OrderPaid
 type OrderPaidConsumerService interface {
 	// RunConsumer запускает consumer для обработки событий OrderPaid
 	RunConsumer(ctx context.Context) error
 }
 
+// OrderCancelledConsumerService представляет интерфейс для consumer'а событий OrderCancelled
+type OrderCancelledConsumerService interface {
+	// RunConsumer запускает consumer для обработки событий OrderCancelled
+	RunConsumer(ctx context.Context) error
+}
+
 // OrderAssembledConsumerService представляет интерфейс для consumer'а событий ShipAssembled
 type OrderAssembledConsumerService interface {
 	// RunConsumer запускает consumer для обработки событий ShipAssembled
@@ -20,7 +32,9 @@ type OrderAssembledConsumerService interface {
 
 // TelegramService представляет интерфейс для отправки уведомлений в Telegram
 type TelegramService interface {
+	SendOrderCreatedNotification(ctx context.Context, event *model.OrderCreated) error
 	SendOrderPaidNotification(ctx context.Context, event *model.OrderPaid) error
+	SendOrderCancelledNotification(ctx context.Context, event *model.OrderCancelled) error
 	SendShipAssembledNotification(ctx context.Context, event *model.ShipAssembled) error
 	HandleStartCommand(ctx context.Context, chatID string) error
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"text/template"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/radiophysiker/microservices-homework/notification/internal/client/http/telegram"
@@ -18,7 +19,9 @@ import (
 var templatesFS embed.FS
 
 type Service interface {
+	SendOrderCreatedNotification(ctx context.Context, event *model.OrderCreated) error
 	SendOrderPaidNotification(ctx context.Context, event *model.OrderPaid) error
+	SendOrderCancelledNotification(ctx context.Context, event *model.OrderCancelled) error
 	SendShipAssembledNotification(ctx context.Context, event *model.ShipAssembled) error
 	HandleStartCommand(ctx context.Context, chatID string) error
 }
@@ -26,7 +29,9 @@ type Service interface {
 type service struct {
 	client        telegramClient
 	chatID        string
+	createdTmpl   *template.Template
 	paidTmpl      *template.Template
+	cancelledTmpl *template.Template
 	assembledTmpl *template.Template
 }
 
@@ -36,78 +41,87 @@ type telegramClient interface {
 }
 
 func NewService(client *telegram.Client, chatID string) (Service, error) {
-	paidTemplateData, err := templatesFS.ReadFile("templates/paid_notification.tmpl")
+	createdTmpl, err := parseTemplate("created")
 	if err != nil {
-		return nil, fmt.Errorf("read paid template: %w", err)
+		return nil, err
 	}
 
-	paidTmpl, err := template.New("paid").Parse(string(paidTemplateData))
+	paidTmpl, err := parseTemplate("paid")
 	if err != nil {
-		return nil, fmt.Errorf("parse paid template: %w", err)
+		return nil, err
 	}
 
-	assembledTemplateData, err := templatesFS.ReadFile("templates/assembled_notification.tmpl")
+	cancelledTmpl, err := parseTemplate("cancelled")
 	if err != nil {
-		return nil, fmt.Errorf("read assembled template: %w", err)
+		return nil, err
 	}
 
-	assembledTmpl, err := template.New("assembled").Parse(string(assembledTemplateData))
+	assembledTmpl, err := parseTemplate("assembled")
 	if err != nil {
-		return nil, fmt.Errorf("parse assembled template: %w", err)
+		return nil, err
 	}
 
 	return &service{
 		client:        client,
 		chatID:        chatID,
+		createdTmpl:   createdTmpl,
 		paidTmpl:      paidTmpl,
+		cancelledTmpl: cancelledTmpl,
 		assembledTmpl: assembledTmpl,
 	}, nil
 }
 
-func (s *service) SendOrderPaidNotification(ctx context.Context, event *model.OrderPaid) error {
-	var buf bytes.Buffer
-	if err := s.paidTmpl.Execute(&buf, event); err != nil {
-		return fmt.Errorf("execute template: %w", err)
+// parseTemplate загружает шаблон templates/<name>_notification.tmpl
+func parseTemplate(name string) (*template.Template, error) {
+	data, err := templatesFS.ReadFile("templates/" + name + "_notification.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("read %s template: %w", name, err)
 	}
 
-	message := buf.String()
+	tmpl, err := template.New(name).Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("parse %s template: %w", name, err)
+	}
 
-	if err := s.client.SendMessage(ctx, s.chatID, message); err != nil {
-		logger.Error(ctx, "Failed to send OrderPaid notification",
-			zap.Error(err),
-			zap.String("order_uuid", event.OrderUUID.String()),
-		)
+	return tmpl, nil
+}
 
-		return fmt.Errorf("send message: %w", err)
-	}
+func (s *service) SendOrderCreatedNotification(ctx context.Context, event *model.OrderCreated) error {
+	return s.send(ctx, s.createdTmpl, event, "OrderCreated", event.OrderUUID)
+}
 
-	logger.Info(ctx, "OrderPaid notification sent",
-		zap.String("order_uuid", event.OrderUUID.String()),
-		zap.String("chat_id", s.chatID),
-	)
+func (s *service) SendOrderPaidNotification(ctx context.Context, event *model.OrderPaid) error {
+	return s.send(ctx, s.paidTmpl, event, "OrderPaid", event.OrderUUID)
+}
 
-	return nil
+func (s *service) SendOrderCancelledNotification(ctx context.Context, event *model.OrderCancelled) error {
+	return s.send(ctx, s.cancelledTmpl, event, "OrderCancelled", event.OrderUUID)
 }
 
 func (s *service) SendShipAssembledNotification(ctx context.Context, event *model.ShipAssembled) error {
+	return s.send(ctx, s.assembledTmpl, event, "ShipAssembled", event.OrderUUID)
+}
+
+// send рендерит шаблон по событию и отправляет сообщение в чат
+func (s *service) send(ctx context.Context, tmpl *template.Template, event any, eventName string, orderUUID uuid.UUID) error {
 	var buf bytes.Buffer
-	if err := s.assembledTmpl.Execute(&buf, event); err != nil {
+	if err := tmpl.Execute(&buf, event); err != nil {
 		return fmt.Errorf("execute template: %w", err)
 	}
 
 	message := buf.String()
 
 	if err := s.client.SendMessage(ctx, s.chatID, message); err != nil {
-		logger.Error(ctx, "Failed to send ShipAssembled notification",
+		logger.Error(ctx, "Failed to send "+eventName+" notification",
 			zap.Error(err),
-			zap.String("order_uuid", event.OrderUUID.String()),
+			zap.String("order_uuid", orderUUID.String()),
 		)
 
 		return fmt.Errorf("send message: %w", err)
 	}
 
-	logger.Info(ctx, "ShipAssembled notification sent",
-		zap.String("order_uuid", event.OrderUUID.String()),
+	logger.Info(ctx, eventName+" notification sent",
+		zap.String("order_uuid", orderUUID.String()),
 		zap.String("chat_id", s.chatID),
 	)
 
@@ -0,0 +1,204 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/order/internal/service"
+	grpcMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/grpc"
+)
+
+const (
+	// IdempotencyKeyHeader HTTP заголовок с ключом идемпотентности
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotencyKeyMetadataKey ключ для передачи ключа идемпотентности в gRPC metadata
+	IdempotencyKeyMetadataKey = "idempotency-key"
+
+	// maxIdempotencyKeyLength максимальная длина ключа идемпотентности
+	maxIdempotencyKeyLength = 255
+)
+
+// IdempotencyInterceptor interceptor, который повторно не выполняет запрос
+// с уже использованным ключом идемпотентности, а возвращает сохраненный ответ
+type IdempotencyInterceptor struct {
+	idempotencyService service.IdempotencyService
+	methods            map[string]struct{}
+}
+
+// NewIdempotencyInterceptor создает interceptor для перечисленных gRPC методов
+func NewIdempotencyInterceptor(idempotencyService service.IdempotencyService, fullMethods ...string) *IdempotencyInterceptor {
+	methods := make(map[string]struct{}, len(fullMethods))
+	for _, m := range fullMethods {
+		methods[m] = struct{}{}
+	}
+
+	return &IdempotencyInterceptor{
+		idempotencyService: idempotencyService,
+		methods:            methods,
+	}
+}
+
+// Unary возвращает unary server interceptor идемпотентности
+func (i *IdempotencyInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if _, ok := i.methods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		// Ключ идемпотентности необязателен: без него запрос выполняется как обычно
+		key := idempotencyKeyFromContext(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d characters", maxIdempotencyKeyLength)
+		}
+
+		scope, ok := idempotencyScope(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "idempotency key requires an authenticated session")
+		}
+
+		reqMsg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		requestHash, err := hashRequest(reqMsg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hash request: %v", err)
+		}
+
+		var handlerResp any
+
+		data, err := i.idempotencyService.Execute(ctx, model.IdempotencyKey{
+			Scope:  scope,
+			Key:    key,
+			Method: info.FullMethod,
+		}, requestHash, func(ctx context.Context) ([]byte, error) {
+			resp, handlerErr := handler(ctx, req)
+			if handlerErr != nil {
+				return nil, handlerErr
+			}
+
+			respMsg, ok := resp.(proto.Message)
+			if !ok {
+				return nil, fmt.Errorf("unexpected response type %T", resp)
+			}
+
+			handlerResp = resp
+
+			return proto.Marshal(respMsg)
+		})
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+
+		if handlerResp != nil {
+			return handlerResp, nil
+		}
+
+		return decodeResponse(info.FullMethod, data)
+	}
+}
+
+// idempotencyKeyFromContext извлекает ключ идемпотентности из входящих gRPC metadata
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(IdempotencyKeyMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(values[0])
+}
+
+// idempotencyScope определяет область действия ключа: пользователь, а при его отсутствии сессия
+func idempotencyScope(ctx context.Context) (string, bool) {
+	if user, ok := grpcMiddleware.GetUserFromContext(ctx); ok && user.GetUuid() != "" {
+		return "user:" + user.GetUuid(), true
+	}
+
+	if sessionUUID, ok := grpcMiddleware.GetSessionUUIDFromContext(ctx); ok && sessionUUID != "" {
+		return "session:" + sessionUUID, true
+	}
+
+	return "", false
+}
+
+// hashRequest вычисляет отпечаток запроса для сравнения повторов
+func hashRequest(req proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return sum[:], nil
+}
+
+// decodeResponse восстанавливает сохраненный ответ по типу выходного сообщения метода
+func decodeResponse(fullMethod string, data []byte) (any, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find method descriptor: %v", err)
+	}
+
+	methodDesc, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "%s is not a method", name)
+	}
+
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(methodDesc.Output().FullName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find response type: %v", err)
+	}
+
+	resp := msgType.New().Interface()
+	if err = proto.Unmarshal(data, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode stored response: %v", err)
+	}
+
+	return resp, nil
+}
+
+// toStatusError преобразует ошибку в gRPC статус, сохраняя статус ошибок обработчика
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrIdempotencyKeyReused):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, model.ErrIdempotencyRequestInProgress):
+		return status.Errorf(codes.Aborted, "%v", err)
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	return status.Errorf(codes.Internal, "idempotency check failed: %v", err)
+}
@@ -15,20 +15,33 @@ import (
 
 // CancelOrder отменяет заказ
 func (a *API) CancelOrder(ctx context.Context, req *orderpb.CancelOrderRequest) (*emptypb.Empty, error) {
+	userUUID, err := userUUIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	orderUUID, err := uuid.Parse(req.GetOrderUuid())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid order UUID: %v", err)
 	}
 
-	_, err = a.orderService.CancelOrder(ctx, orderUUID)
+	_, err = a.orderService.CancelOrder(ctx, userUUID, orderUUID)
 	if err != nil {
 		switch {
 		case errors.Is(err, model.ErrInvalidOrderData):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid order data: %v", err)
 		case errors.Is(err, model.ErrOrderNotFound):
 			return nil, status.Errorf(codes.NotFound, "order not found: %v", err)
+		case errors.Is(err, model.ErrOrderAccessDenied):
+			return nil, status.Errorf(codes.PermissionDenied, "access to order denied: %v", err)
 		case errors.Is(err, model.ErrOrderCannotBeCancelled):
 			return nil, status.Errorf(codes.FailedPrecondition, "order cannot be cancelled: %v", err)
+		case errors.Is(err, model.ErrOrderVersionConflict):
+			return nil, status.Errorf(codes.Aborted, "order was modified concurrently: %v", err)
+		case errors.Is(err, model.ErrPaymentServiceUnavailable):
+			return nil, status.Errorf(codes.Unavailable, "payment service unavailable: %v", err)
+		case errors.Is(err, model.ErrInventoryServiceUnavailable):
+			return nil, status.Errorf(codes.Unavailable, "inventory service unavailable: %v", err)
 		default:
 			return nil, status.Errorf(codes.Internal, "failed to cancel order: %v", err)
 		}
@@ -14,27 +14,33 @@ import (
 
 // CreateOrder создает новый заказ (gRPC)
 func (a *API) CreateOrder(ctx context.Context, req *orderpb.CreateOrderRequest) (*orderpb.CreateOrderResponse, error) {
-	userUUID, err := uuid.Parse(req.GetUserUuid())
+	// Заказ всегда оформляется на пользователя текущей сессии
+	userUUID, err := userUUIDFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid user UUID: %v", err)
+		return nil, err
 	}
 
-	partUUIDs := make([]uuid.UUID, len(req.GetPartUuids()))
+	items := make([]model.OrderItem, len(req.GetItems()))
 
-	for i, partUUIDStr := range req.GetPartUuids() {
-		partUUID, err := uuid.Parse(partUUIDStr)
+	for i, item := range req.GetItems() {
+		partUUID, err := uuid.Parse(item.GetPartUuid())
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid part UUID at index %d: %v", i, err)
 		}
 
-		partUUIDs[i] = partUUID
+		items[i] = model.OrderItem{
+			PartUUID: partUUID,
+			Quantity: int(item.GetQuantity()),
+		}
 	}
 
-	order, err := a.orderService.CreateOrder(ctx, userUUID, partUUIDs)
+	order, err := a.orderService.CreateOrder(ctx, userUUID, items)
 	if err != nil {
 		switch {
 		case errors.Is(err, model.ErrInvalidOrderData):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid order data: %v", err)
+		case errors.Is(err, model.ErrInsufficientStock):
+			return nil, status.Errorf(codes.FailedPrecondition, "insufficient stock: %v", err)
 		case errors.Is(err, model.ErrInventoryServiceUnavailable):
 			return nil, status.Errorf(codes.Unavailable, "inventory service unavailable: %v", err)
 		default:
@@ -15,18 +15,25 @@ import (
 
 // GetOrder возвращает заказ по UUID
 func (a *API) GetOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*orderpb.GetOrderResponse, error) {
+	userUUID, err := userUUIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	orderUUID, err := uuid.Parse(req.GetOrderUuid())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid order UUID: %v", err)
 	}
 
-	order, err := a.orderService.GetOrder(ctx, orderUUID)
+	order, err := a.orderService.GetOrder(ctx, userUUID, orderUUID)
 	if err != nil {
 		switch {
 		case errors.Is(err, model.ErrInvalidOrderData):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid order data: %v", err)
 		case errors.Is(err, model.ErrOrderNotFound):
 			return nil, status.Errorf(codes.NotFound, "order not found: %v", err)
+		case errors.Is(err, model.ErrOrderAccessDenied):
+			return nil, status.Errorf(codes.PermissionDenied, "access to order denied: %v", err)
 		default:
 			return nil, status.Errorf(codes.Internal, "failed to get order: %v", err)
 		}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/converter"
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	orderpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/order/v1"
+)
+
+// GetOrderHistory возвращает историю статусов заказа
+func (a *API) GetOrderHistory(ctx context.Context, req *orderpb.GetOrderHistoryRequest) (*orderpb.GetOrderHistoryResponse, error) {
+	userUUID, err := userUUIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderUUID, err := uuid.Parse(req.GetOrderUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order UUID: %v", err)
+	}
+
+	history, err := a.orderService.GetOrderHistory(ctx, userUUID, orderUUID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidOrderData):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order data: %v", err)
+		case errors.Is(err, model.ErrOrderNotFound):
+			return nil, status.Errorf(codes.NotFound, "order not found: %v", err)
+		case errors.Is(err, model.ErrOrderAccessDenied):
+			return nil, status.Errorf(codes.PermissionDenied, "access to order denied: %v", err)
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to get order history: %v", err)
+		}
+	}
+
+	return converter.ToProtoOrderHistory(history), nil
+}
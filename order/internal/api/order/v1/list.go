@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/converter"
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	grpcMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/grpc"
+	orderpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/order/v1"
+)
+
+// ListOrders возвращает список заказов пользователя.
+// Вызов другого сервиса может запросить заказы любого пользователя или всех пользователей сразу
+func (a *API) ListOrders(ctx context.Context, req *orderpb.ListOrdersRequest) (*orderpb.ListOrdersResponse, error) {
+	filter, err := listOrdersOwner(ctx, req.GetUserUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Statuses = make([]model.Status, 0, len(req.GetStatuses()))
+
+	for _, s := range req.GetStatuses() {
+		filter.Statuses = append(filter.Statuses, converter.StatusFromProtobuf(s))
+	}
+
+	if req.GetCreatedFrom() != nil {
+		createdFrom := req.GetCreatedFrom().AsTime()
+		filter.CreatedFrom = &createdFrom
+	}
+
+	if req.GetCreatedTo() != nil {
+		createdTo := req.GetCreatedTo().AsTime()
+		filter.CreatedTo = &createdTo
+	}
+
+	if req.GetUpdatedFrom() != nil {
+		updatedFrom := req.GetUpdatedFrom().AsTime()
+		filter.UpdatedFrom = &updatedFrom
+	}
+
+	if req.GetUpdatedTo() != nil {
+		updatedTo := req.GetUpdatedTo().AsTime()
+		filter.UpdatedTo = &updatedTo
+	}
+
+	for _, rawOrderUUID := range req.GetOrderUuids() {
+		orderUUID, err := uuid.Parse(rawOrderUUID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order UUID: %v", err)
+		}
+
+		filter.OrderUUIDs = append(filter.OrderUUIDs, orderUUID)
+	}
+
+	cursor, err := converter.DecodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+
+	page, err := a.orderService.ListOrders(ctx, filter, cursor, int(req.GetPageSize()))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidOrderData) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid list orders request: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to list orders: %v", err)
+	}
+
+	return converter.ToProtoListOrdersResponse(page), nil
+}
+
+// listOrdersOwner определяет, чьи заказы можно вернуть. Пользователь видит только собственные заказы,
+// а вызов другого сервиса получает заказы пользователя userUUID или, если он пуст, всех пользователей
+func listOrdersOwner(ctx context.Context, rawUserUUID string) (model.OrderFilter, error) {
+	var requestedUUID uuid.UUID
+
+	if rawUserUUID != "" {
+		parsed, err := uuid.Parse(rawUserUUID)
+		if err != nil {
+			return model.OrderFilter{}, status.Errorf(codes.InvalidArgument, "invalid user UUID: %v", err)
+		}
+
+		requestedUUID = parsed
+	}
+
+	if grpcMiddleware.IsServiceCall(ctx) {
+		return model.OrderFilter{UserUUID: requestedUUID, AllUsers: requestedUUID == uuid.Nil}, nil
+	}
+
+	userUUID, err := userUUIDFromContext(ctx)
+	if err != nil {
+		return model.OrderFilter{}, err
+	}
+
+	if requestedUUID != uuid.Nil && requestedUUID != userUUID {
+		return model.OrderFilter{}, status.Error(codes.PermissionDenied, "cannot list orders of another user")
+	}
+
+	return model.OrderFilter{UserUUID: userUUID}, nil
+}
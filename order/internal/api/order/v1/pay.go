@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -15,6 +16,11 @@ import (
 
 // PayOrder проводит оплату заказа
 func (a *API) PayOrder(ctx context.Context, req *orderpb.PayOrderRequest) (*orderpb.PayOrderResponse, error) {
+	userUUID, err := userUUIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	orderUUID, err := uuid.Parse(req.GetOrderUuid())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid order UUID: %v", err)
@@ -22,7 +28,7 @@ func (a *API) PayOrder(ctx context.Context, req *orderpb.PayOrderRequest) (*orde
 
 	paymentMethod := converter.PaymentMethodFromProtobuf(req.PaymentMethod)
 
-	order, err := a.orderService.PayOrder(ctx, orderUUID, paymentMethod)
+	order, err := a.orderService.PayOrder(ctx, userUUID, orderUUID, paymentMethod, req.GetCardToken())
 	if err != nil {
 		// Обработка различных типов ошибок
 		switch {
@@ -30,8 +36,18 @@ func (a *API) PayOrder(ctx context.Context, req *orderpb.PayOrderRequest) (*orde
 			return nil, status.Errorf(codes.InvalidArgument, "invalid order data: %v", err)
 		case errors.Is(err, model.ErrOrderNotFound):
 			return nil, status.Errorf(codes.NotFound, "order not found: %v", err)
+		case errors.Is(err, model.ErrOrderAccessDenied):
+			return nil, status.Errorf(codes.PermissionDenied, "access to order denied: %v", err)
 		case errors.Is(err, model.ErrOrderCannotBePaid):
 			return nil, status.Errorf(codes.FailedPrecondition, "order cannot be paid: %v", err)
+		case errors.Is(err, model.ErrOrderVersionConflict):
+			return nil, status.Errorf(codes.Aborted, "order was modified concurrently: %v", err)
+		case errors.Is(err, model.ErrPaymentDeclined):
+			return nil, paymentDeclinedStatus(err)
+		case errors.Is(err, model.ErrOrderAlreadyPaid):
+			return nil, status.Errorf(codes.AlreadyExists, "order already paid: %v", err)
+		case errors.Is(err, model.ErrInvalidPaymentRequest):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid payment request: %v", err)
 		case errors.Is(err, model.ErrPaymentServiceUnavailable):
 			return nil, status.Errorf(codes.Unavailable, "payment service unavailable: %v", err)
 		default:
@@ -45,5 +61,24 @@ func (a *API) PayOrder(ctx context.Context, req *orderpb.PayOrderRequest) (*orde
 
 	return &orderpb.PayOrderResponse{
 		TransactionUuid: order.TransactionUUID.String(),
+		Status:          converter.StatusToProtobuf(order.Status),
 	}, nil
 }
+
+// paymentDeclinedStatus возвращает FailedPrecondition и передает клиенту причину отказа в ErrorInfo
+func paymentDeclinedStatus(err error) error {
+	var declined *model.PaymentDeclinedError
+	if !errors.As(err, &declined) || declined.Reason == "" {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	st, detailsErr := status.New(codes.FailedPrecondition, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: declined.Reason,
+		Domain: "payment",
+	})
+	if detailsErr != nil {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return st.Err()
+}
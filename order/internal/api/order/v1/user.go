@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpcMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/grpc"
+)
+
+// userUUIDFromContext возвращает UUID пользователя, аутентифицированного AuthInterceptor
+func userUUIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	user, ok := grpcMiddleware.GetUserFromContext(ctx)
+	if !ok || user.GetUuid() == "" {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+
+	userUUID, err := uuid.Parse(user.GetUuid())
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.Unauthenticated, "invalid user UUID in session: %v", err)
+	}
+
+	return userUUID, nil
+}
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/radiophysiker/microservices-homework/order/internal/api/interceptor"
 	"github.com/radiophysiker/microservices-homework/order/internal/config"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/closer"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/grpc/health"
@@ -96,6 +97,71 @@ func (a *App) Run(ctx context.Context) error {
 		return nil
 	})
 
+	g.Go(func() error {
+		paymentConsumerService, err := a.diContainer.PaymentConsumerService(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to get PaymentConsumerService", zap.Error(err))
+			return err
+		}
+
+		logger.Info(ctx, "Starting payment result consumer")
+
+		if err := paymentConsumerService.RunConsumer(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				logger.Info(ctx, "Payment result consumer stopped")
+				return nil
+			}
+
+			logger.Error(ctx, "Payment result consumer error", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		outboxRelayService, err := a.diContainer.OutboxRelayService(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to get OutboxRelayService", zap.Error(err))
+			return err
+		}
+
+		if err := outboxRelayService.Run(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				logger.Info(ctx, "Outbox relay stopped")
+				return nil
+			}
+
+			logger.Error(ctx, "Outbox relay error", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		orderExpiryService, err := a.diContainer.OrderExpiryService(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to get OrderExpiryService", zap.Error(err))
+			return err
+		}
+
+		if err := orderExpiryService.Run(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				logger.Info(ctx, "Order expiry job stopped")
+				return nil
+			}
+
+			logger.Error(ctx, "Order expiry job error", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+
 	// Завершаем по ctx
 	g.Go(func() error {
 		<-ctx.Done()
@@ -201,10 +267,21 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 		return nil
 	})
 
+	authInterceptor, err := a.diContainer.AuthInterceptor(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create auth interceptor: %w", err)
+	}
+
+	idempotencyInterceptor, err := a.diContainer.IdempotencyInterceptor(ctx)
+	if err != nil {
+		return err
+	}
+
 	a.grpcServer = grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			tracing.UnaryServerInterceptor(config.AppConfig().Tracing.ServiceName()),
-			grpcMiddleware.SessionForwardInterceptor(),
+			authInterceptor.Unary(),
+			idempotencyInterceptor.Unary(),
 		),
 	)
 
@@ -230,13 +307,17 @@ func (a *App) initHTTPGateway(ctx context.Context) error {
 	gatewayCtx, gatewayCancel := context.WithCancel(ctx)
 	a.gatewayCancel = gatewayCancel
 
-	// Пробрасываем заголовок X-Session-Uuid из HTTP в gRPC metadata (session-uuid)
+	// Пробрасываем заголовки X-Session-Uuid и Idempotency-Key из HTTP в gRPC metadata
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
 			if strings.EqualFold(key, httpMiddleware.SessionUUIDHeader) {
 				return grpcMiddleware.SessionUUIDMetadataKey, true
 			}
 
+			if strings.EqualFold(key, interceptor.IdempotencyKeyHeader) {
+				return interceptor.IdempotencyKeyMetadataKey, true
+			}
+
 			return runtime.DefaultHeaderMatcher(key)
 		}),
 	)
@@ -10,57 +10,131 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/radiophysiker/microservices-homework/order/internal/api/interceptor"
 	apiv1 "github.com/radiophysiker/microservices-homework/order/internal/api/order/v1"
 	clientGrpc "github.com/radiophysiker/microservices-homework/order/internal/client/grpc"
 	inventoryClient "github.com/radiophysiker/microservices-homework/order/internal/client/grpc/inventory/v1"
 	paymentClient "github.com/radiophysiker/microservices-homework/order/internal/client/grpc/payment/v1"
 	"github.com/radiophysiker/microservices-homework/order/internal/config"
 	"github.com/radiophysiker/microservices-homework/order/internal/converter/kafka/decoder"
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
 	"github.com/radiophysiker/microservices-homework/order/internal/repository"
+	idempotencyRepo "github.com/radiophysiker/microservices-homework/order/internal/repository/idempotency"
 	orderRepo "github.com/radiophysiker/microservices-homework/order/internal/repository/order"
+	outboxRepo "github.com/radiophysiker/microservices-homework/order/internal/repository/outbox"
 	"github.com/radiophysiker/microservices-homework/order/internal/service"
 	orderConsumerSvc "github.com/radiophysiker/microservices-homework/order/internal/service/consumer/order_consumer"
+	paymentConsumerSvc "github.com/radiophysiker/microservices-homework/order/internal/service/consumer/payment_consumer"
+	idempotencySvc "github.com/radiophysiker/microservices-homework/order/internal/service/idempotency"
 	orderSvc "github.com/radiophysiker/microservices-homework/order/internal/service/order"
-	orderProducerSvc "github.com/radiophysiker/microservices-homework/order/internal/service/producer/order_producer"
+	orderExpirySvc "github.com/radiophysiker/microservices-homework/order/internal/service/order_expiry"
+	outboxRelaySvc "github.com/radiophysiker/microservices-homework/order/internal/service/outbox_relay"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/closer"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
 	kafkaConsumer "github.com/radiophysiker/microservices-homework/platform/pkg/kafka/consumer"
+	dedupPostgres "github.com/radiophysiker/microservices-homework/platform/pkg/kafka/dedup/postgres"
 	kafkaProducer "github.com/radiophysiker/microservices-homework/platform/pkg/kafka/producer"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
 	grpcMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/grpc"
 	httpMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/http"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
 	authpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/auth/v1"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
 	inventorypb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/inventory/v1"
+	orderpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/order/v1"
 	paymentpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
 )
 
 type diContainer struct {
-	pool            *pgxpool.Pool
-	inventoryConn   *grpc.ClientConn
-	paymentConn     *grpc.ClientConn
-	iamConn         *grpc.ClientConn
-	orderRepository repository.OrderRepository
-	inventoryClient clientGrpc.InventoryClient
-	paymentClient   clientGrpc.PaymentClient
-	iamClient       authpb.AuthServiceClient
-	orderService    service.OrderService
-	api             *apiv1.API
-
-	orderPaidSyncProducer sarama.SyncProducer
-	orderPaidProducer     kafka.Producer
-	orderProducerService  *orderProducerSvc.Service
+	deadLetterSyncProducer sarama.SyncProducer
+
+	pool                  *pgxpool.Pool
+	inventoryConn         *grpc.ClientConn
+	paymentConn           *grpc.ClientConn
+	iamConn               *grpc.ClientConn
+	orderRepository       repository.OrderRepository
+	outboxRepository      repository.OutboxRepository
+	idempotencyRepository repository.IdempotencyRepository
+	inventoryClient       clientGrpc.InventoryClient
+	paymentClient         clientGrpc.PaymentClient
+	iamClient             authpb.AuthServiceClient
+	orderService          service.OrderService
+	orderExpiryService    service.OrderExpiryService
+	idempotencyService    service.IdempotencyService
+	api                   *apiv1.API
+
+	orderCreatedSyncProducer   sarama.SyncProducer
+	orderCreatedProducer       kafka.Producer
+	orderPaidSyncProducer      sarama.SyncProducer
+	orderPaidProducer          kafka.Producer
+	orderCancelledSyncProducer sarama.SyncProducer
+	orderCancelledProducer     kafka.Producer
+	outboxRelayService         service.OutboxRelayService
 
 	orderAssembledConsumerGroup sarama.ConsumerGroup
 	orderAssembledConsumer      kafka.Consumer
 	orderAssembledDecoder       *decoder.Decoder
 	orderConsumerService        service.OrderConsumerService
+
+	paymentConsumerGroup    sarama.ConsumerGroup
+	paymentConsumer         kafka.Consumer
+	paymentCompletedDecoder *decoder.PaymentCompletedDecoder
+	paymentFailedDecoder    *decoder.PaymentFailedDecoder
+	paymentConsumerService  service.PaymentConsumerService
 }
 
 func newDiContainer() *diContainer {
 	return &diContainer{}
 }
 
+func (d *diContainer) DeadLetterSyncProducer(_ context.Context) (sarama.SyncProducer, error) {
+	if d.deadLetterSyncProducer == nil {
+		producerCfg := sarama.NewConfig()
+		producerCfg.Version = sarama.V4_0_0_0
+		producerCfg.Producer.Return.Successes = true
+
+		producer, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			producerCfg,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create dead letter sync producer: %w", err)
+		}
+
+		closer.AddNamed("Dead letter sync producer", func(ctx context.Context) error {
+			return producer.Close()
+		})
+
+		d.deadLetterSyncProducer = producer
+	}
+
+	return d.deadLetterSyncProducer, nil
+}
+
+// ConsumerMiddlewares возвращает цепочку middleware consumer'а: обработка продолжает трейс
+// producer'а, выполняется с повторами, а после исчерпания попыток сообщение отправляется в <topic>.dlq
+func (d *diContainer) ConsumerMiddlewares(ctx context.Context, extra ...kafkaConsumer.Middleware) ([]kafkaConsumer.Middleware, error) {
+	deadLetterProducer, err := d.DeadLetterSyncProducer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	retryCfg := config.AppConfig().ConsumerRetry
+	retryPolicy := kafkaConsumer.RetryPolicy{
+		MaxAttempts:    retryCfg.MaxAttempts(),
+		InitialBackoff: retryCfg.InitialBackoff(),
+		MaxBackoff:     retryCfg.MaxBackoff(),
+	}
+
+	middlewares := []kafkaConsumer.Middleware{
+		kafkaConsumer.Tracing(),
+		kafkaConsumer.DeadLetter(deadLetterProducer, logger.Logger()),
+		kafkaConsumer.Retry(retryPolicy, logger.Logger()),
+	}
+
+	return append(middlewares, extra...), nil
+}
+
 func (d *diContainer) Pool(ctx context.Context) (*pgxpool.Pool, error) {
 	if d.pool == nil {
 		pc, err := pgxpool.ParseConfig(config.AppConfig().Postgres.DSN())
@@ -102,8 +176,9 @@ func (d *diContainer) InventoryConn(ctx context.Context) (*grpc.ClientConn, erro
 		conn, err := grpc.NewClient(
 			config.AppConfig().InventoryGRPC.InventoryAddress(),
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithUnaryInterceptor(
+			grpc.WithChainUnaryInterceptor(
 				tracing.UnaryClientInterceptor(config.AppConfig().Tracing.ServiceName()),
+				grpcMiddleware.ServiceTokenClientInterceptor(config.AppConfig().InventoryGRPC.ServiceToken()),
 			),
 		)
 		if err != nil {
@@ -125,8 +200,9 @@ func (d *diContainer) PaymentConn(ctx context.Context) (*grpc.ClientConn, error)
 		conn, err := grpc.NewClient(
 			config.AppConfig().PaymentGRPC.PaymentAddress(),
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithUnaryInterceptor(
+			grpc.WithChainUnaryInterceptor(
 				tracing.UnaryClientInterceptor(config.AppConfig().Tracing.ServiceName()),
+				grpcMiddleware.ServiceTokenClientInterceptor(config.AppConfig().PaymentGRPC.ServiceToken()),
 			),
 		)
 		if err != nil {
@@ -192,6 +268,32 @@ func (d *diContainer) OrderRepository(ctx context.Context) (repository.OrderRepo
 	return d.orderRepository, nil
 }
 
+func (d *diContainer) OutboxRepository(ctx context.Context) (repository.OutboxRepository, error) {
+	if d.outboxRepository == nil {
+		pool, err := d.Pool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.outboxRepository = outboxRepo.NewRepository(pool)
+	}
+
+	return d.outboxRepository, nil
+}
+
+func (d *diContainer) IdempotencyRepository(ctx context.Context) (repository.IdempotencyRepository, error) {
+	if d.idempotencyRepository == nil {
+		pool, err := d.Pool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.idempotencyRepository = idempotencyRepo.NewRepository(pool)
+	}
+
+	return d.idempotencyRepository, nil
+}
+
 func (d *diContainer) InventoryClient(ctx context.Context) (clientGrpc.InventoryClient, error) {
 	if d.inventoryClient == nil {
 		conn, err := d.InventoryConn(ctx)
@@ -222,6 +324,49 @@ func (d *diContainer) PaymentClient(ctx context.Context) (clientGrpc.PaymentClie
 	return d.paymentClient, nil
 }
 
+func (d *diContainer) OrderCreatedSyncProducer(ctx context.Context) (sarama.SyncProducer, error) {
+	if d.orderCreatedSyncProducer == nil {
+		cfg := config.AppConfig()
+		producerCfg := cfg.OrderCreatedProducer
+
+		producer, err := sarama.NewSyncProducer(
+			cfg.Kafka.Brokers(),
+			producerCfg.Config(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create sync producer: %w", err)
+		}
+
+		closer.AddNamed("OrderCreated sync producer", func(ctx context.Context) error {
+			return producer.Close()
+		})
+
+		d.orderCreatedSyncProducer = producer
+	}
+
+	return d.orderCreatedSyncProducer, nil
+}
+
+func (d *diContainer) OrderCreatedProducer(ctx context.Context) (kafka.Producer, error) {
+	if d.orderCreatedProducer == nil {
+		syncProducer, err := d.OrderCreatedSyncProducer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := config.AppConfig()
+		topic := cfg.OrderCreatedProducer.Topic()
+
+		d.orderCreatedProducer = kafkaProducer.NewProducer(
+			syncProducer,
+			topic,
+			logger.Logger(),
+		)
+	}
+
+	return d.orderCreatedProducer, nil
+}
+
 func (d *diContainer) OrderPaidSyncProducer(ctx context.Context) (sarama.SyncProducer, error) {
 	if d.orderPaidSyncProducer == nil {
 		cfg := config.AppConfig()
@@ -265,17 +410,86 @@ func (d *diContainer) OrderPaidProducer(ctx context.Context) (kafka.Producer, er
 	return d.orderPaidProducer, nil
 }
 
-func (d *diContainer) OrderProducerService(ctx context.Context) (*orderProducerSvc.Service, error) {
-	if d.orderProducerService == nil {
-		producer, err := d.OrderPaidProducer(ctx)
+func (d *diContainer) OrderCancelledSyncProducer(ctx context.Context) (sarama.SyncProducer, error) {
+	if d.orderCancelledSyncProducer == nil {
+		cfg := config.AppConfig()
+		producerCfg := cfg.OrderCancelledProducer
+
+		producer, err := sarama.NewSyncProducer(
+			cfg.Kafka.Brokers(),
+			producerCfg.Config(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create sync producer: %w", err)
+		}
+
+		closer.AddNamed("OrderCancelled sync producer", func(ctx context.Context) error {
+			return producer.Close()
+		})
+
+		d.orderCancelledSyncProducer = producer
+	}
+
+	return d.orderCancelledSyncProducer, nil
+}
+
+func (d *diContainer) OrderCancelledProducer(ctx context.Context) (kafka.Producer, error) {
+	if d.orderCancelledProducer == nil {
+		syncProducer, err := d.OrderCancelledSyncProducer(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		d.orderProducerService = orderProducerSvc.NewService(producer)
+		cfg := config.AppConfig()
+		topic := cfg.OrderCancelledProducer.Topic()
+
+		d.orderCancelledProducer = kafkaProducer.NewProducer(
+			syncProducer,
+			topic,
+			logger.Logger(),
+		)
 	}
 
-	return d.orderProducerService, nil
+	return d.orderCancelledProducer, nil
+}
+
+func (d *diContainer) OutboxRelayService(ctx context.Context) (service.OutboxRelayService, error) {
+	if d.outboxRelayService == nil {
+		outboxRepository, err := d.OutboxRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		orderCreatedProducer, err := d.OrderCreatedProducer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		orderPaidProducer, err := d.OrderPaidProducer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		orderCancelledProducer, err := d.OrderCancelledProducer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := config.AppConfig().OutboxRelay
+
+		d.outboxRelayService = outboxRelaySvc.NewService(
+			outboxRepository,
+			map[string]kafka.Producer{
+				model.EventTypeOrderCreated:   orderCreatedProducer,
+				model.EventTypeOrderPaid:      orderPaidProducer,
+				model.EventTypeOrderCancelled: orderCancelledProducer,
+			},
+			cfg.Interval(),
+			cfg.BatchSize(),
+		)
+	}
+
+	return d.outboxRelayService, nil
 }
 
 func (d *diContainer) OrderService(ctx context.Context) (service.OrderService, error) {
@@ -295,23 +509,39 @@ func (d *diContainer) OrderService(ctx context.Context) (service.OrderService, e
 			return nil, err
 		}
 
-		orderProducer, err := d.OrderProducerService(ctx)
-		if err != nil {
-			return nil, err
-		}
-
 		d.orderService = orderSvc.NewService(
 			ctx,
 			orderRepo,
 			inventoryClient,
 			paymentClient,
-			orderProducer,
 		)
 	}
 
 	return d.orderService, nil
 }
 
+func (d *diContainer) OrderExpiryService(ctx context.Context) (service.OrderExpiryService, error) {
+	if d.orderExpiryService == nil {
+		orderService, err := d.OrderService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := config.AppConfig().OrderExpiry
+
+		d.orderExpiryService = orderExpirySvc.NewService(
+			orderService,
+			cfg.TTL(),
+			cfg.Interval(),
+			cfg.BatchSize(),
+		)
+
+		closer.AddNamed("Order expiry job", d.orderExpiryService.Stop)
+	}
+
+	return d.orderExpiryService, nil
+}
+
 func (d *diContainer) OrderAssembledConsumerGroup(ctx context.Context) (sarama.ConsumerGroup, error) {
 	if d.orderAssembledConsumerGroup == nil {
 		cfg := config.AppConfig()
@@ -346,10 +576,24 @@ func (d *diContainer) OrderAssembledConsumer(ctx context.Context) (kafka.Consume
 		cfg := config.AppConfig()
 		topics := []string{cfg.OrderAssembledConsumer.Topic()}
 
+		pool, err := d.Pool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		processedEvents := dedupPostgres.NewStore(pool, cfg.OrderAssembledConsumer.GroupID())
+		eventID := kafkaConsumer.ProtoEventID(func() *eventspb.ShipAssembled { return &eventspb.ShipAssembled{} })
+
+		middlewares, err := d.ConsumerMiddlewares(ctx, kafkaConsumer.Deduplicate(processedEvents, eventID, logger.Logger()))
+		if err != nil {
+			return nil, err
+		}
+
 		d.orderAssembledConsumer = kafkaConsumer.NewConsumer(
 			group,
 			topics,
 			logger.Logger(),
+			middlewares...,
 		)
 	}
 
@@ -391,6 +635,126 @@ func (d *diContainer) OrderConsumerService(ctx context.Context) (service.OrderCo
 	return d.orderConsumerService, nil
 }
 
+func (d *diContainer) PaymentConsumerGroup(ctx context.Context) (sarama.ConsumerGroup, error) {
+	if d.paymentConsumerGroup == nil {
+		cfg := config.AppConfig()
+		consumerCfg := cfg.PaymentConsumer
+
+		group, err := sarama.NewConsumerGroup(
+			cfg.Kafka.Brokers(),
+			consumerCfg.GroupID(),
+			consumerCfg.Config(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create consumer group: %w", err)
+		}
+
+		closer.AddNamed("Payment consumer group", func(ctx context.Context) error {
+			return group.Close()
+		})
+
+		d.paymentConsumerGroup = group
+	}
+
+	return d.paymentConsumerGroup, nil
+}
+
+func (d *diContainer) PaymentConsumer(ctx context.Context) (kafka.Consumer, error) {
+	if d.paymentConsumer == nil {
+		group, err := d.PaymentConsumerGroup(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		consumerCfg := config.AppConfig().PaymentConsumer
+		topics := []string{consumerCfg.CompletedTopic(), consumerCfg.FailedTopic()}
+
+		pool, err := d.Pool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Оба топика читаются одной группой, поэтому тип события для дедупликации выбирается по топику
+		processedEvents := dedupPostgres.NewStore(pool, consumerCfg.GroupID())
+		completedEventID := kafkaConsumer.ProtoEventID(func() *eventspb.PaymentCompleted { return &eventspb.PaymentCompleted{} })
+		failedEventID := kafkaConsumer.ProtoEventID(func() *eventspb.PaymentFailed { return &eventspb.PaymentFailed{} })
+		eventID := func(msg kafka.Message) (string, error) {
+			if msg.Topic == consumerCfg.FailedTopic() {
+				return failedEventID(msg)
+			}
+
+			return completedEventID(msg)
+		}
+
+		middlewares, err := d.ConsumerMiddlewares(ctx, kafkaConsumer.Deduplicate(processedEvents, eventID, logger.Logger()))
+		if err != nil {
+			return nil, err
+		}
+
+		d.paymentConsumer = kafkaConsumer.NewConsumer(
+			group,
+			topics,
+			logger.Logger(),
+			middlewares...,
+		)
+	}
+
+	return d.paymentConsumer, nil
+}
+
+func (d *diContainer) PaymentCompletedDecoder(_ context.Context) (*decoder.PaymentCompletedDecoder, error) {
+	if d.paymentCompletedDecoder == nil {
+		d.paymentCompletedDecoder = decoder.NewPaymentCompletedDecoder()
+	}
+
+	return d.paymentCompletedDecoder, nil
+}
+
+func (d *diContainer) PaymentFailedDecoder(_ context.Context) (*decoder.PaymentFailedDecoder, error) {
+	if d.paymentFailedDecoder == nil {
+		d.paymentFailedDecoder = decoder.NewPaymentFailedDecoder()
+	}
+
+	return d.paymentFailedDecoder, nil
+}
+
+func (d *diContainer) PaymentConsumerService(ctx context.Context) (service.PaymentConsumerService, error) {
+	if d.paymentConsumerService == nil {
+		consumer, err := d.PaymentConsumer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		completedDecoder, err := d.PaymentCompletedDecoder(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		failedDecoder, err := d.PaymentFailedDecoder(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		orderService, err := d.OrderService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		consumerCfg := config.AppConfig().PaymentConsumer
+
+		d.paymentConsumerService = paymentConsumerSvc.NewService(
+			consumer,
+			consumerCfg.CompletedTopic(),
+			consumerCfg.FailedTopic(),
+			completedDecoder,
+			failedDecoder,
+			orderService,
+		)
+	}
+
+	return d.paymentConsumerService, nil
+}
+
 func (d *diContainer) API(ctx context.Context) (*apiv1.API, error) {
 	if d.api == nil {
 		orderService, err := d.OrderService(ctx)
@@ -404,6 +768,35 @@ func (d *diContainer) API(ctx context.Context) (*apiv1.API, error) {
 	return d.api, nil
 }
 
+func (d *diContainer) IdempotencyService(ctx context.Context) (service.IdempotencyService, error) {
+	if d.idempotencyService == nil {
+		idempotencyRepository, err := d.IdempotencyRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.idempotencyService = idempotencySvc.NewService(
+			idempotencyRepository,
+			config.AppConfig().Idempotency.KeyTTL(),
+		)
+	}
+
+	return d.idempotencyService, nil
+}
+
+func (d *diContainer) IdempotencyInterceptor(ctx context.Context) (*interceptor.IdempotencyInterceptor, error) {
+	idempotencyService, err := d.IdempotencyService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return interceptor.NewIdempotencyInterceptor(
+		idempotencyService,
+		orderpb.OrderService_CreateOrder_FullMethodName,
+		orderpb.OrderService_PayOrder_FullMethodName,
+	), nil
+}
+
 func (d *diContainer) AuthMiddleware(ctx context.Context) (*httpMiddleware.AuthMiddleware, error) {
 	iamClient, err := d.IAMClient(ctx)
 	if err != nil {
@@ -419,5 +812,8 @@ func (d *diContainer) AuthInterceptor(ctx context.Context) (*grpcMiddleware.Auth
 		return nil, err
 	}
 
-	return grpcMiddleware.NewAuthInterceptor(iamClient), nil
+	return grpcMiddleware.NewAuthInterceptor(
+		iamClient,
+		grpcMiddleware.WithServiceToken(config.AppConfig().ServiceAuth.Token()),
+	), nil
 }
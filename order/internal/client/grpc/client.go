@@ -11,10 +11,24 @@ import (
 type InventoryClient interface {
 	// ListParts возвращает список деталей по UUID
 	ListParts(ctx context.Context, partUUIDs []string) ([]*model.Part, error)
+	// ReserveParts резервирует детали на складе под заказ
+	ReserveParts(ctx context.Context, orderUUID string, items []model.OrderItem) error
+	// ReleaseReservation снимает резерв деталей заказа
+	ReleaseReservation(ctx context.Context, orderUUID string) error
+	// CommitReservation подтверждает резерв деталей оплаченного заказа
+	CommitReservation(ctx context.Context, orderUUID string) error
+	// RestockReservation возвращает на склад детали подтвержденного резерва
+	RestockReservation(ctx context.Context, orderUUID string) error
 }
 
 // PaymentClient представляет интерфейс для работы с payment service
 type PaymentClient interface {
-	// PayOrder проводит оплату заказа
-	PayOrder(ctx context.Context, userUUID, orderUUID string, paymentMethod paymentpb.PaymentMethod) (string, error)
+	// PayOrder проводит оплату заказа на сумму amount в минимальных единицах валюты currency.
+	// cardToken передается провайдеру оплаты картой.
+	// Возвращает UUID транзакции и ее статус: PENDING означает, что результат придет событием
+	PayOrder(ctx context.Context, userUUID, orderUUID string, paymentMethod paymentpb.PaymentMethod, amount int64, currency, cardToken string) (string, paymentpb.TransactionStatus, error)
+	// RefundPayment возвращает средства по транзакции оплаты заказа
+	RefundPayment(ctx context.Context, userUUID, orderUUID, transactionUUID string) (string, error)
+	// GetTransaction возвращает транзакцию по UUID из журнала payment service
+	GetTransaction(ctx context.Context, transactionUUID string) (*model.PaymentTransaction, error)
 }
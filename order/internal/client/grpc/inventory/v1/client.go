@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/radiophysiker/microservices-homework/order/internal/model"
 	grpcMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/grpc"
 	inventorypb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/inventory/v1"
@@ -36,3 +39,80 @@ func (c *Client) ListParts(ctx context.Context, partUUIDs []string) ([]*model.Pa
 
 	return model.ToServiceParts(resp.GetParts()), nil
 }
+
+// ReserveParts резервирует детали на складе под заказ
+func (c *Client) ReserveParts(ctx context.Context, orderUUID string, items []model.OrderItem) error {
+	ctx = grpcMiddleware.ForwardSessionUUIDToGRPC(ctx)
+
+	reservationItems := make([]*inventorypb.ReservationItem, 0, len(items))
+	for _, item := range items {
+		reservationItems = append(reservationItems, &inventorypb.ReservationItem{
+			PartUuid: item.PartUUID.String(),
+			Quantity: int64(item.Quantity),
+		})
+	}
+
+	_, err := c.inventoryClient.ReserveParts(ctx, &inventorypb.ReservePartsRequest{
+		OrderUuid: orderUUID,
+		Items:     reservationItems,
+	})
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return fmt.Errorf("%w: %s", model.ErrInsufficientStock, status.Convert(err).Message())
+		}
+
+		return fmt.Errorf("failed to reserve parts: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseReservation снимает резерв деталей заказа
+func (c *Client) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	ctx = grpcMiddleware.ForwardSessionUUIDToGRPC(ctx)
+
+	_, err := c.inventoryClient.ReleaseReservation(ctx, &inventorypb.ReleaseReservationRequest{
+		OrderUuid: orderUUID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return fmt.Errorf("%w: %s", model.ErrReservationNotFound, status.Convert(err).Message())
+		}
+
+		return fmt.Errorf("failed to release reservation: %w", err)
+	}
+
+	return nil
+}
+
+// CommitReservation подтверждает резерв деталей оплаченного заказа
+func (c *Client) CommitReservation(ctx context.Context, orderUUID string) error {
+	ctx = grpcMiddleware.ForwardSessionUUIDToGRPC(ctx)
+
+	_, err := c.inventoryClient.CommitReservation(ctx, &inventorypb.CommitReservationRequest{
+		OrderUuid: orderUUID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit reservation: %w", err)
+	}
+
+	return nil
+}
+
+// RestockReservation возвращает на склад детали подтвержденного резерва заказа
+func (c *Client) RestockReservation(ctx context.Context, orderUUID string) error {
+	ctx = grpcMiddleware.ForwardSessionUUIDToGRPC(ctx)
+
+	_, err := c.inventoryClient.RestockReservation(ctx, &inventorypb.RestockReservationRequest{
+		OrderUuid: orderUUID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return fmt.Errorf("%w: %s", model.ErrReservationNotFound, status.Convert(err).Message())
+		}
+
+		return fmt.Errorf("failed to restock reservation: %w", err)
+	}
+
+	return nil
+}
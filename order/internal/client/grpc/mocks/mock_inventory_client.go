@@ -38,6 +38,63 @@ func (_m *MockInventoryClient) EXPECT() *MockInventoryClient_Expecter {
 	return &MockInventoryClient_Expecter{mock: &_m.Mock}
 }
 
+// CommitReservation provides a mock function for the type MockInventoryClient
+func (_mock *MockInventoryClient) CommitReservation(ctx context.Context, orderUUID string) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryClient_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type MockInventoryClient_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *MockInventoryClient_Expecter) CommitReservation(ctx interface{}, orderUUID interface{}) *MockInventoryClient_CommitReservation_Call {
+	return &MockInventoryClient_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, orderUUID)}
+}
+
+func (_c *MockInventoryClient_CommitReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *MockInventoryClient_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_CommitReservation_Call) Return(err error) *MockInventoryClient_CommitReservation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryClient_CommitReservation_Call) RunAndReturn(run func(ctx context.Context, orderUUID string) error) *MockInventoryClient_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListParts provides a mock function for the type MockInventoryClient
 func (_mock *MockInventoryClient) ListParts(ctx context.Context, partUUIDs []string) ([]*model.Part, error) {
 	ret := _mock.Called(ctx, partUUIDs)
@@ -105,3 +162,180 @@ func (_c *MockInventoryClient_ListParts_Call) RunAndReturn(run func(ctx context.
 	_c.Call.Return(run)
 	return _c
 }
+
+// ReleaseReservation provides a mock function for the type MockInventoryClient
+func (_mock *MockInventoryClient) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryClient_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type MockInventoryClient_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *MockInventoryClient_Expecter) ReleaseReservation(ctx interface{}, orderUUID interface{}) *MockInventoryClient_ReleaseReservation_Call {
+	return &MockInventoryClient_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, orderUUID)}
+}
+
+func (_c *MockInventoryClient_ReleaseReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *MockInventoryClient_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_ReleaseReservation_Call) Return(err error) *MockInventoryClient_ReleaseReservation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryClient_ReleaseReservation_Call) RunAndReturn(run func(ctx context.Context, orderUUID string) error) *MockInventoryClient_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function for the type MockInventoryClient
+func (_mock *MockInventoryClient) ReserveParts(ctx context.Context, orderUUID string, items []model.OrderItem) error {
+	ret := _mock.Called(ctx, orderUUID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []model.OrderItem) error); ok {
+		r0 = returnFunc(ctx, orderUUID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryClient_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type MockInventoryClient_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - items []model.OrderItem
+func (_e *MockInventoryClient_Expecter) ReserveParts(ctx interface{}, orderUUID interface{}, items interface{}) *MockInventoryClient_ReserveParts_Call {
+	return &MockInventoryClient_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, orderUUID, items)}
+}
+
+func (_c *MockInventoryClient_ReserveParts_Call) Run(run func(ctx context.Context, orderUUID string, items []model.OrderItem)) *MockInventoryClient_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []model.OrderItem
+		if args[2] != nil {
+			arg2 = args[2].([]model.OrderItem)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_ReserveParts_Call) Return(err error) *MockInventoryClient_ReserveParts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryClient_ReserveParts_Call) RunAndReturn(run func(ctx context.Context, orderUUID string, items []model.OrderItem) error) *MockInventoryClient_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestockReservation provides a mock function for the type MockInventoryClient
+func (_mock *MockInventoryClient) RestockReservation(ctx context.Context, orderUUID string) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestockReservation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryClient_RestockReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestockReservation'
+type MockInventoryClient_RestockReservation_Call struct {
+	*mock.Call
+}
+
+// RestockReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *MockInventoryClient_Expecter) RestockReservation(ctx interface{}, orderUUID interface{}) *MockInventoryClient_RestockReservation_Call {
+	return &MockInventoryClient_RestockReservation_Call{Call: _e.mock.On("RestockReservation", ctx, orderUUID)}
+}
+
+func (_c *MockInventoryClient_RestockReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *MockInventoryClient_RestockReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_RestockReservation_Call) Return(err error) *MockInventoryClient_RestockReservation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryClient_RestockReservation_Call) RunAndReturn(run func(ctx context.Context, orderUUID string) error) *MockInventoryClient_RestockReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,6 +7,7 @@ package grpc
 import (
 	"context"
 
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
 	"github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,30 +39,104 @@ func (_m *MockPaymentClient) EXPECT() *MockPaymentClient_Expecter {
 	return &MockPaymentClient_Expecter{mock: &_m.Mock}
 }
 
+// GetTransaction provides a mock function for the type MockPaymentClient
+func (_mock *MockPaymentClient) GetTransaction(ctx context.Context, transactionUUID string) (*model.PaymentTransaction, error) {
+	ret := _mock.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 *model.PaymentTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.PaymentTransaction, error)); ok {
+		return returnFunc(ctx, transactionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.PaymentTransaction); ok {
+		r0 = returnFunc(ctx, transactionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PaymentTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentClient_GetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransaction'
+type MockPaymentClient_GetTransaction_Call struct {
+	*mock.Call
+}
+
+// GetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *MockPaymentClient_Expecter) GetTransaction(ctx interface{}, transactionUUID interface{}) *MockPaymentClient_GetTransaction_Call {
+	return &MockPaymentClient_GetTransaction_Call{Call: _e.mock.On("GetTransaction", ctx, transactionUUID)}
+}
+
+func (_c *MockPaymentClient_GetTransaction_Call) Run(run func(ctx context.Context, transactionUUID string)) *MockPaymentClient_GetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentClient_GetTransaction_Call) Return(paymentTransaction *model.PaymentTransaction, err error) *MockPaymentClient_GetTransaction_Call {
+	_c.Call.Return(paymentTransaction, err)
+	return _c
+}
+
+func (_c *MockPaymentClient_GetTransaction_Call) RunAndReturn(run func(ctx context.Context, transactionUUID string) (*model.PaymentTransaction, error)) *MockPaymentClient_GetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PayOrder provides a mock function for the type MockPaymentClient
-func (_mock *MockPaymentClient) PayOrder(ctx context.Context, userUUID string, orderUUID string, paymentMethod v1.PaymentMethod) (string, error) {
-	ret := _mock.Called(ctx, userUUID, orderUUID, paymentMethod)
+func (_mock *MockPaymentClient) PayOrder(ctx context.Context, userUUID string, orderUUID string, paymentMethod v1.PaymentMethod, amount int64, currency string, cardToken string) (string, v1.TransactionStatus, error) {
+	ret := _mock.Called(ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
 	}
 
 	var r0 string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, v1.PaymentMethod) (string, error)); ok {
-		return returnFunc(ctx, userUUID, orderUUID, paymentMethod)
+	var r1 v1.TransactionStatus
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, v1.PaymentMethod, int64, string, string) (string, v1.TransactionStatus, error)); ok {
+		return returnFunc(ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, v1.PaymentMethod) string); ok {
-		r0 = returnFunc(ctx, userUUID, orderUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, v1.PaymentMethod, int64, string, string) string); ok {
+		r0 = returnFunc(ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, v1.PaymentMethod) error); ok {
-		r1 = returnFunc(ctx, userUUID, orderUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, v1.PaymentMethod, int64, string, string) v1.TransactionStatus); ok {
+		r1 = returnFunc(ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(v1.TransactionStatus)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, v1.PaymentMethod, int64, string, string) error); ok {
+		r2 = returnFunc(ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockPaymentClient_PayOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PayOrder'
@@ -74,11 +149,14 @@ type MockPaymentClient_PayOrder_Call struct {
 //   - userUUID string
 //   - orderUUID string
 //   - paymentMethod v1.PaymentMethod
-func (_e *MockPaymentClient_Expecter) PayOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}) *MockPaymentClient_PayOrder_Call {
-	return &MockPaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, orderUUID, paymentMethod)}
+//   - amount int64
+//   - currency string
+//   - cardToken string
+func (_e *MockPaymentClient_Expecter) PayOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}, amount interface{}, currency interface{}, cardToken interface{}) *MockPaymentClient_PayOrder_Call {
+	return &MockPaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)}
 }
 
-func (_c *MockPaymentClient_PayOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod v1.PaymentMethod)) *MockPaymentClient_PayOrder_Call {
+func (_c *MockPaymentClient_PayOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod v1.PaymentMethod, amount int64, currency string, cardToken string)) *MockPaymentClient_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -96,6 +174,99 @@ func (_c *MockPaymentClient_PayOrder_Call) Run(run func(ctx context.Context, use
 		if args[3] != nil {
 			arg3 = args[3].(v1.PaymentMethod)
 		}
+		var arg4 int64
+		if args[4] != nil {
+			arg4 = args[4].(int64)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		var arg6 string
+		if args[6] != nil {
+			arg6 = args[6].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentClient_PayOrder_Call) Return(s string, transactionStatus v1.TransactionStatus, err error) *MockPaymentClient_PayOrder_Call {
+	_c.Call.Return(s, transactionStatus, err)
+	return _c
+}
+
+func (_c *MockPaymentClient_PayOrder_Call) RunAndReturn(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod v1.PaymentMethod, amount int64, currency string, cardToken string) (string, v1.TransactionStatus, error)) *MockPaymentClient_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function for the type MockPaymentClient
+func (_mock *MockPaymentClient) RefundPayment(ctx context.Context, userUUID string, orderUUID string, transactionUUID string) (string, error) {
+	ret := _mock.Called(ctx, userUUID, orderUUID, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return returnFunc(ctx, userUUID, orderUUID, transactionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = returnFunc(ctx, userUUID, orderUUID, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, userUUID, orderUUID, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentClient_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type MockPaymentClient_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - orderUUID string
+//   - transactionUUID string
+func (_e *MockPaymentClient_Expecter) RefundPayment(ctx interface{}, userUUID interface{}, orderUUID interface{}, transactionUUID interface{}) *MockPaymentClient_RefundPayment_Call {
+	return &MockPaymentClient_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, userUUID, orderUUID, transactionUUID)}
+}
+
+func (_c *MockPaymentClient_RefundPayment_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, transactionUUID string)) *MockPaymentClient_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
@@ -106,12 +277,12 @@ func (_c *MockPaymentClient_PayOrder_Call) Run(run func(ctx context.Context, use
 	return _c
 }
 
-func (_c *MockPaymentClient_PayOrder_Call) Return(s string, err error) *MockPaymentClient_PayOrder_Call {
+func (_c *MockPaymentClient_RefundPayment_Call) Return(s string, err error) *MockPaymentClient_RefundPayment_Call {
 	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockPaymentClient_PayOrder_Call) RunAndReturn(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod v1.PaymentMethod) (string, error)) *MockPaymentClient_PayOrder_Call {
+func (_c *MockPaymentClient_RefundPayment_Call) RunAndReturn(run func(ctx context.Context, userUUID string, orderUUID string, transactionUUID string) (string, error)) *MockPaymentClient_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4,6 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
 	grpcMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/grpc"
 	paymentpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
 )
@@ -20,18 +25,89 @@ func NewClient(paymentClient paymentpb.PaymentServiceClient) *Client {
 	}
 }
 
-// PayOrder проводит оплату заказа
-func (c *Client) PayOrder(ctx context.Context, userUUID, orderUUID string, paymentMethod paymentpb.PaymentMethod) (string, error) {
+// PayOrder проводит оплату заказа на сумму amount в минимальных единицах валюты currency
+func (c *Client) PayOrder(ctx context.Context, userUUID, orderUUID string, paymentMethod paymentpb.PaymentMethod, amount int64, currency, cardToken string) (string, paymentpb.TransactionStatus, error) {
 	ctx = grpcMiddleware.ForwardSessionUUIDToGRPC(ctx)
 
 	resp, err := c.paymentClient.PayOrder(ctx, &paymentpb.PayOrderRequest{
 		UserUuid:      userUUID,
 		OrderUuid:     orderUUID,
 		PaymentMethod: paymentMethod,
+		Amount:        amount,
+		Currency:      currency,
+		CardToken:     cardToken,
+	})
+	if err != nil {
+		return "", paymentpb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED, payOrderError(err)
+	}
+
+	return resp.GetTransactionUuid(), resp.GetStatus(), nil
+}
+
+// RefundPayment возвращает средства по транзакции оплаты заказа
+func (c *Client) RefundPayment(ctx context.Context, userUUID, orderUUID, transactionUUID string) (string, error) {
+	ctx = grpcMiddleware.ForwardSessionUUIDToGRPC(ctx)
+
+	resp, err := c.paymentClient.RefundPayment(ctx, &paymentpb.RefundPaymentRequest{
+		TransactionUuid: transactionUUID,
+		OrderUuid:       orderUUID,
+		UserUuid:        userUUID,
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to pay order: %w", err)
+		return "", fmt.Errorf("failed to refund payment: %w", err)
+	}
+
+	return resp.GetRefundUuid(), nil
+}
+
+// GetTransaction возвращает транзакцию по UUID из журнала payment service
+func (c *Client) GetTransaction(ctx context.Context, transactionUUID string) (*model.PaymentTransaction, error) {
+	ctx = grpcMiddleware.ForwardSessionUUIDToGRPC(ctx)
+
+	resp, err := c.paymentClient.GetTransaction(ctx, &paymentpb.GetTransactionRequest{
+		TransactionUuid: transactionUUID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("%w: %s", model.ErrPaymentTransactionNotFound, transactionUUID)
+		}
+
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return &model.PaymentTransaction{
+		TransactionUUID: resp.GetTransaction().GetTransactionUuid(),
+		OrderUUID:       resp.GetTransaction().GetOrderUuid(),
+		Status:          resp.GetTransaction().GetStatus(),
+	}, nil
+}
+
+// payOrderError преобразует gRPC статус ответа на оплату в ошибку модели
+func payOrderError(err error) error {
+	st := status.Convert(err)
+
+	switch st.Code() {
+	case codes.FailedPrecondition:
+		return paymentDeclinedError(st)
+	case codes.AlreadyExists:
+		return fmt.Errorf("%w: %s", model.ErrOrderAlreadyPaid, st.Message())
+	case codes.InvalidArgument:
+		return fmt.Errorf("%w: %s", model.ErrInvalidPaymentRequest, st.Message())
+	default:
+		return fmt.Errorf("failed to pay order: %w", err)
+	}
+}
+
+// paymentDeclinedError извлекает машиночитаемую причину отказа из ErrorInfo статуса
+func paymentDeclinedError(st *status.Status) error {
+	declined := &model.PaymentDeclinedError{Message: st.Message()}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			declined.Reason = info.GetReason()
+			break
+		}
 	}
 
-	return resp.GetTransactionUuid(), nil
+	return declined
 }
@@ -18,10 +18,18 @@ type config struct {
 	PaymentGRPC            PaymentGRPCConfig
 	IAMGRPC                IAMGRPCConfig
 	Kafka                  KafkaConfig
+	ConsumerRetry          ConsumerRetryConfig
+	OrderCreatedProducer   OrderCreatedProducerConfig
 	OrderPaidProducer      OrderPaidProducerConfig
+	OrderCancelledProducer OrderCancelledProducerConfig
 	OrderAssembledConsumer OrderAssembledConsumerConfig
+	PaymentConsumer        PaymentConsumerConfig
+	OutboxRelay            OutboxRelayConfig
+	OrderExpiry            OrderExpiryConfig
+	Idempotency            IdempotencyConfig
 	OrderGRPC              OrderGRPCConfig
 	OrderHTTP              OrderHTTPConfig
+	ServiceAuth            ServiceAuthConfig
 	Postgres               PostgresConfig
 	Migrations             MigrationsConfig
 }
@@ -72,6 +80,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	serviceAuthCfg, err := env.NewServiceAuthConfig()
+	if err != nil {
+		return err
+	}
+
 	postgresCfg, err := env.NewPostgresConfig()
 	if err != nil {
 		return err
@@ -87,16 +100,51 @@ func Load(path ...string) error {
 		return err
 	}
 
+	consumerRetryCfg, err := env.NewConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
+	orderCreatedProducerCfg, err := env.NewOrderCreatedProducerConfig()
+	if err != nil {
+		return err
+	}
+
 	orderPaidProducerCfg, err := env.NewOrderPaidProducerConfig()
 	if err != nil {
 		return err
 	}
 
+	orderCancelledProducerCfg, err := env.NewOrderCancelledProducerConfig()
+	if err != nil {
+		return err
+	}
+
 	orderAssembledConsumerCfg, err := env.NewOrderAssembledConsumerConfig()
 	if err != nil {
 		return err
 	}
 
+	paymentConsumerCfg, err := env.NewPaymentConsumerConfig()
+	if err != nil {
+		return err
+	}
+
+	outboxRelayCfg, err := env.NewOutboxRelayConfig()
+	if err != nil {
+		return err
+	}
+
+	orderExpiryCfg, err := env.NewOrderExpiryConfig()
+	if err != nil {
+		return err
+	}
+
+	idempotencyCfg, err := env.NewIdempotencyConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:                 loggerCfg,
 		Metrics:                metricsCfg,
@@ -105,10 +153,18 @@ func Load(path ...string) error {
 		PaymentGRPC:            paymentGRPCCfg,
 		IAMGRPC:                iamGRPCCfg,
 		Kafka:                  kafkaCfg,
+		ConsumerRetry:          consumerRetryCfg,
+		OrderCreatedProducer:   orderCreatedProducerCfg,
 		OrderPaidProducer:      orderPaidProducerCfg,
+		OrderCancelledProducer: orderCancelledProducerCfg,
 		OrderAssembledConsumer: orderAssembledConsumerCfg,
+		PaymentConsumer:        paymentConsumerCfg,
+		OutboxRelay:            outboxRelayCfg,
+		OrderExpiry:            orderExpiryCfg,
+		Idempotency:            idempotencyCfg,
 		OrderGRPC:              orderGRPCCfg,
 		OrderHTTP:              httpCfg,
+		ServiceAuth:            serviceAuthCfg,
 		Postgres:               postgresCfg,
 		Migrations:             migrationsCfg,
 	}
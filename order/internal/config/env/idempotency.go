@@ -0,0 +1,28 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type idempotencyEnvConfig struct {
+	KeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+}
+
+type idempotencyConfig struct {
+	raw idempotencyEnvConfig
+}
+
+func NewIdempotencyConfig() (*idempotencyConfig, error) {
+	var raw idempotencyEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &idempotencyConfig{raw: raw}, nil
+}
+
+func (cfg *idempotencyConfig) KeyTTL() time.Duration {
+	return cfg.raw.KeyTTL
+}
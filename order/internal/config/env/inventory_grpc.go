@@ -7,8 +7,9 @@ import (
 )
 
 type inventoryGRPCEnvConfig struct {
-	Host string `env:"INVENTORY_GRPC_HOST,required"`
-	Port string `env:"INVENTORY_GRPC_PORT,required"`
+	Host         string `env:"INVENTORY_GRPC_HOST,required"`
+	Port         string `env:"INVENTORY_GRPC_PORT,required"`
+	ServiceToken string `env:"INVENTORY_SERVICE_TOKEN" envDefault:""`
 }
 
 type inventoryGRPCConfig struct {
@@ -27,3 +28,9 @@ func NewInventoryGRPCConfig() (*inventoryGRPCConfig, error) {
 func (cfg *inventoryGRPCConfig) InventoryAddress() string {
 	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
 }
+
+// ServiceToken возвращает сервисный токен, которым order аутентифицируется в inventory
+// при вызовах без пользовательской сессии (истечение заказов, обработка событий оплаты)
+func (cfg *inventoryGRPCConfig) ServiceToken() string {
+	return cfg.raw.ServiceToken
+}
@@ -0,0 +1,36 @@
+//nolint:dupl // Файл похож на order_paid_producer.go, но это разные конфигурации для разных топиков
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type OrderCancelledProducerEnvConfig struct {
+	Topic string `env:"ORDER_CANCELLED_TOPIC_NAME,required"`
+}
+
+type orderCancelledProducerConfig struct {
+	raw OrderCancelledProducerEnvConfig
+}
+
+func NewOrderCancelledProducerConfig() (*orderCancelledProducerConfig, error) {
+	var raw OrderCancelledProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCancelledProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCancelledProducerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *orderCancelledProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
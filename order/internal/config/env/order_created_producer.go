@@ -0,0 +1,36 @@
+//nolint:dupl // Файл похож на order_paid_producer.go, но это разные конфигурации для разных топиков
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type OrderCreatedProducerEnvConfig struct {
+	Topic string `env:"ORDER_CREATED_TOPIC_NAME,required"`
+}
+
+type orderCreatedProducerConfig struct {
+	raw OrderCreatedProducerEnvConfig
+}
+
+func NewOrderCreatedProducerConfig() (*orderCreatedProducerConfig, error) {
+	var raw OrderCreatedProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCreatedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCreatedProducerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *orderCreatedProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
@@ -0,0 +1,38 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderExpiryEnvConfig struct {
+	TTL       time.Duration `env:"ORDER_EXPIRY_TTL" envDefault:"30m"`
+	Interval  time.Duration `env:"ORDER_EXPIRY_INTERVAL" envDefault:"1m"`
+	BatchSize int           `env:"ORDER_EXPIRY_BATCH_SIZE" envDefault:"100"`
+}
+
+type orderExpiryConfig struct {
+	raw orderExpiryEnvConfig
+}
+
+func NewOrderExpiryConfig() (*orderExpiryConfig, error) {
+	var raw orderExpiryEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderExpiryConfig{raw: raw}, nil
+}
+
+func (cfg *orderExpiryConfig) TTL() time.Duration {
+	return cfg.raw.TTL
+}
+
+func (cfg *orderExpiryConfig) Interval() time.Duration {
+	return cfg.raw.Interval
+}
+
+func (cfg *orderExpiryConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
@@ -0,0 +1,33 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type outboxRelayEnvConfig struct {
+	Interval  time.Duration `env:"OUTBOX_RELAY_INTERVAL" envDefault:"1s"`
+	BatchSize int           `env:"OUTBOX_RELAY_BATCH_SIZE" envDefault:"100"`
+}
+
+type outboxRelayConfig struct {
+	raw outboxRelayEnvConfig
+}
+
+func NewOutboxRelayConfig() (*outboxRelayConfig, error) {
+	var raw outboxRelayEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &outboxRelayConfig{raw: raw}, nil
+}
+
+func (cfg *outboxRelayConfig) Interval() time.Duration {
+	return cfg.raw.Interval
+}
+
+func (cfg *outboxRelayConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
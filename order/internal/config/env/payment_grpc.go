@@ -7,8 +7,9 @@ import (
 )
 
 type paymentGRPCEnvConfig struct {
-	Host string `env:"PAYMENT_GRPC_HOST,required"`
-	Port string `env:"PAYMENT_GRPC_PORT,required"`
+	Host         string `env:"PAYMENT_GRPC_HOST,required"`
+	Port         string `env:"PAYMENT_GRPC_PORT,required"`
+	ServiceToken string `env:"PAYMENT_SERVICE_TOKEN" envDefault:""`
 }
 
 type paymentGRPCConfig struct {
@@ -27,3 +28,8 @@ func NewPaymentGRPCConfig() (*paymentGRPCConfig, error) {
 func (cfg *paymentGRPCConfig) PaymentAddress() string {
 	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
 }
+
+// ServiceToken возвращает сервисный токен, без которого payment отклоняет вызовы order
+func (cfg *paymentGRPCConfig) ServiceToken() string {
+	return cfg.raw.ServiceToken
+}
@@ -28,6 +28,7 @@ type TracingConfig interface {
 
 type InventoryGRPCConfig interface {
 	InventoryAddress() string
+	ServiceToken() string
 }
 
 type PostgresConfig interface {
@@ -40,6 +41,7 @@ type PostgresConfig interface {
 
 type PaymentGRPCConfig interface {
 	PaymentAddress() string
+	ServiceToken() string
 }
 
 type IAMGRPCConfig interface {
@@ -54,6 +56,10 @@ type OrderHTTPConfig interface {
 	Address() string
 }
 
+type ServiceAuthConfig interface {
+	Token() string
+}
+
 type MigrationsConfig interface {
 	Directory() string
 }
@@ -62,13 +68,51 @@ type KafkaConfig interface {
 	Brokers() []string
 }
 
+type ConsumerRetryConfig interface {
+	MaxAttempts() int
+	InitialBackoff() time.Duration
+	MaxBackoff() time.Duration
+}
+
+type OrderCreatedProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
+}
+
 type OrderPaidProducerConfig interface {
 	Topic() string
 	Config() *sarama.Config
 }
 
+type OrderCancelledProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
+}
+
 type OrderAssembledConsumerConfig interface {
 	Topic() string
 	GroupID() string
 	Config() *sarama.Config
 }
+
+type PaymentConsumerConfig interface {
+	CompletedTopic() string
+	FailedTopic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
+type OutboxRelayConfig interface {
+	Interval() time.Duration
+	BatchSize() int
+}
+
+type OrderExpiryConfig interface {
+	TTL() time.Duration
+	Interval() time.Duration
+	BatchSize() int
+}
+
+type IdempotencyConfig interface {
+	KeyTTL() time.Duration
+}
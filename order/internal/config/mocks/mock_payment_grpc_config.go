@@ -78,3 +78,47 @@ func (_c *MockPaymentGRPCConfig_PaymentAddress_Call) RunAndReturn(run func() str
 	_c.Call.Return(run)
 	return _c
 }
+
+// ServiceToken provides a mock function for the type MockPaymentGRPCConfig
+func (_mock *MockPaymentGRPCConfig) ServiceToken() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServiceToken")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockPaymentGRPCConfig_ServiceToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServiceToken'
+type MockPaymentGRPCConfig_ServiceToken_Call struct {
+	*mock.Call
+}
+
+// ServiceToken is a helper method to define mock.On call
+func (_e *MockPaymentGRPCConfig_Expecter) ServiceToken() *MockPaymentGRPCConfig_ServiceToken_Call {
+	return &MockPaymentGRPCConfig_ServiceToken_Call{Call: _e.mock.On("ServiceToken")}
+}
+
+func (_c *MockPaymentGRPCConfig_ServiceToken_Call) Run(run func()) *MockPaymentGRPCConfig_ServiceToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPaymentGRPCConfig_ServiceToken_Call) Return(s string) *MockPaymentGRPCConfig_ServiceToken_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockPaymentGRPCConfig_ServiceToken_Call) RunAndReturn(run func() string) *MockPaymentGRPCConfig_ServiceToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,57 @@
+package decoder
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/converter"
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+type PaymentCompletedDecoder struct{}
+
+func NewPaymentCompletedDecoder() *PaymentCompletedDecoder {
+	return &PaymentCompletedDecoder{}
+}
+
+func (d *PaymentCompletedDecoder) Decode(data []byte) (*model.PaymentCompleted, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty message data")
+	}
+
+	var pb eventspb.PaymentCompleted
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PaymentCompleted: %w", err)
+	}
+
+	eventUUID, err := uuid.Parse(pb.GetEventUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid event_uuid: %w", err)
+	}
+
+	transactionUUID, err := uuid.Parse(pb.GetTransactionUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction_uuid: %w", err)
+	}
+
+	orderUUID, err := uuid.Parse(pb.GetOrderUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid order_uuid: %w", err)
+	}
+
+	userUUID, err := uuid.Parse(pb.GetUserUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_uuid: %w", err)
+	}
+
+	return &model.PaymentCompleted{
+		EventUUID:       eventUUID,
+		TransactionUUID: transactionUUID,
+		OrderUUID:       orderUUID,
+		UserUUID:        userUUID,
+		PaymentMethod:   converter.PaymentMethodFromPaymentProtobuf(pb.GetPaymentMethod()),
+	}, nil
+}
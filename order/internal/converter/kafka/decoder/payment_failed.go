@@ -0,0 +1,56 @@
+package decoder
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+type PaymentFailedDecoder struct{}
+
+func NewPaymentFailedDecoder() *PaymentFailedDecoder {
+	return &PaymentFailedDecoder{}
+}
+
+func (d *PaymentFailedDecoder) Decode(data []byte) (*model.PaymentFailed, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty message data")
+	}
+
+	var pb eventspb.PaymentFailed
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PaymentFailed: %w", err)
+	}
+
+	eventUUID, err := uuid.Parse(pb.GetEventUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid event_uuid: %w", err)
+	}
+
+	transactionUUID, err := uuid.Parse(pb.GetTransactionUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction_uuid: %w", err)
+	}
+
+	orderUUID, err := uuid.Parse(pb.GetOrderUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid order_uuid: %w", err)
+	}
+
+	userUUID, err := uuid.Parse(pb.GetUserUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_uuid: %w", err)
+	}
+
+	return &model.PaymentFailed{
+		EventUUID:       eventUUID,
+		TransactionUUID: transactionUUID,
+		OrderUUID:       orderUUID,
+		UserUUID:        userUUID,
+		DeclineCode:     pb.GetDeclineCode(),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package encoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+func EncodeOrderCancelled(orderCancelled model.OrderCancelled) ([]byte, error) {
+	pb := &eventspb.OrderCancelled{
+		EventUuid: orderCancelled.EventUUID.String(),
+		OrderUuid: orderCancelled.OrderUUID.String(),
+		UserUuid:  orderCancelled.UserUUID.String(),
+	}
+
+	if orderCancelled.RefundUUID != nil {
+		pb.RefundUuid = orderCancelled.RefundUUID.String()
+	}
+
+	data, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OrderCancelled: %w", err)
+	}
+
+	return data, nil
+}
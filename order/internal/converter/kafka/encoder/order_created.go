@@ -0,0 +1,35 @@
+package encoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+func EncodeOrderCreated(orderCreated model.OrderCreated) ([]byte, error) {
+	items := make([]*eventspb.OrderCreatedItem, 0, len(orderCreated.Items))
+	for _, item := range orderCreated.Items {
+		items = append(items, &eventspb.OrderCreatedItem{
+			PartUuid: item.PartUUID.String(),
+			Quantity: int64(item.Quantity),
+		})
+	}
+
+	pb := &eventspb.OrderCreated{
+		EventUuid:  orderCreated.EventUUID.String(),
+		OrderUuid:  orderCreated.OrderUUID.String(),
+		UserUuid:   orderCreated.UserUUID.String(),
+		TotalPrice: orderCreated.TotalPrice,
+		Items:      items,
+	}
+
+	data, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OrderCreated: %w", err)
+	}
+
+	return data, nil
+}
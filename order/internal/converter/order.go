@@ -1,7 +1,10 @@
 package converter
 
 import (
+	"math"
+
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/radiophysiker/microservices-homework/order/internal/model"
 	orderv1 "github.com/radiophysiker/microservices-homework/shared/pkg/openapi/order/v1"
@@ -87,6 +90,12 @@ func toOpenAPIStatus(s model.Status) orderv1.OrderStatus {
 		return orderv1.OrderStatusASSEMBLED
 	case model.StatusCancelled:
 		return orderv1.OrderStatusCANCELLED
+	case model.StatusRefunded:
+		return orderv1.OrderStatusREFUNDED
+	case model.StatusPaymentProcessing:
+		return orderv1.OrderStatusPAYMENTPROCESSING
+	case model.StatusRefunding:
+		return orderv1.OrderStatusREFUNDING
 	default:
 		return orderv1.OrderStatusPENDINGPAYMENT
 	}
@@ -108,6 +117,27 @@ func PaymentMethodToProtobuf(pm model.PaymentMethod) paymentpb.PaymentMethod {
 	}
 }
 
+// PaymentMethodFromPaymentProtobuf конвертирует protobuf PaymentMethod payment service в model.PaymentMethod
+func PaymentMethodFromPaymentProtobuf(pm paymentpb.PaymentMethod) model.PaymentMethod {
+	switch pm {
+	case paymentpb.PaymentMethod_PAYMENT_METHOD_CARD:
+		return model.PaymentMethodCard
+	case paymentpb.PaymentMethod_PAYMENT_METHOD_SBP:
+		return model.PaymentMethodSBP
+	case paymentpb.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD:
+		return model.PaymentMethodCreditCard
+	case paymentpb.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY:
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnspecified
+	}
+}
+
+// PriceToMinorUnits конвертирует цену в рублях в копейки с округлением до ближайшей копейки
+func PriceToMinorUnits(price float64) int64 {
+	return int64(math.Round(price * 100))
+}
+
 // PaymentMethodToOpenAPI конвертирует PaymentMethod в OpenAPI OrderDtoPaymentMethod
 func PaymentMethodToOpenAPI(pm orderv1.PaymentMethod) orderv1.OrderDtoPaymentMethod {
 	switch pm {
@@ -167,11 +197,39 @@ func StatusToProtobuf(s model.Status) orderpb.OrderStatus {
 		return orderpb.OrderStatus_ORDER_STATUS_ASSEMBLED
 	case model.StatusCancelled:
 		return orderpb.OrderStatus_ORDER_STATUS_CANCELLED
+	case model.StatusRefunded:
+		return orderpb.OrderStatus_ORDER_STATUS_REFUNDED
+	case model.StatusPaymentProcessing:
+		return orderpb.OrderStatus_ORDER_STATUS_PAYMENT_PROCESSING
+	case model.StatusRefunding:
+		return orderpb.OrderStatus_ORDER_STATUS_REFUNDING
 	default:
 		return orderpb.OrderStatus_ORDER_STATUS_UNSPECIFIED
 	}
 }
 
+// StatusFromProtobuf конвертирует protobuf OrderStatus в model.Status
+func StatusFromProtobuf(s orderpb.OrderStatus) model.Status {
+	switch s {
+	case orderpb.OrderStatus_ORDER_STATUS_PENDING_PAYMENT:
+		return model.StatusPendingPayment
+	case orderpb.OrderStatus_ORDER_STATUS_PAID:
+		return model.StatusPaid
+	case orderpb.OrderStatus_ORDER_STATUS_ASSEMBLED:
+		return model.StatusAssembled
+	case orderpb.OrderStatus_ORDER_STATUS_CANCELLED:
+		return model.StatusCancelled
+	case orderpb.OrderStatus_ORDER_STATUS_REFUNDED:
+		return model.StatusRefunded
+	case orderpb.OrderStatus_ORDER_STATUS_PAYMENT_PROCESSING:
+		return model.StatusPaymentProcessing
+	case orderpb.OrderStatus_ORDER_STATUS_REFUNDING:
+		return model.StatusRefunding
+	default:
+		return model.StatusUnspecified
+	}
+}
+
 // ToProtoOrder конвертирует доменную модель в protobuf GetOrderResponse
 func ToProtoOrder(serviceOrder *model.Order) *orderpb.GetOrderResponse {
 	if serviceOrder == nil {
@@ -193,8 +251,17 @@ func ToProtoOrder(serviceOrder *model.Order) *orderpb.GetOrderResponse {
 	}
 
 	partUUIDStrings := make([]string, 0, len(serviceOrder.Items))
+	items := make([]*orderpb.OrderItem, 0, len(serviceOrder.Items))
+
 	for _, it := range serviceOrder.Items {
 		partUUIDStrings = append(partUUIDStrings, it.PartUUID.String())
+		items = append(items, &orderpb.OrderItem{
+			PartUuid:  it.PartUUID.String(),
+			Quantity:  int32(it.Quantity), //nolint:gosec // количество приходит в запросе как int32
+			UnitPrice: it.UnitPrice,
+			Name:      it.PartName,
+			Category:  it.Category,
+		})
 	}
 
 	return &orderpb.GetOrderResponse{
@@ -205,5 +272,46 @@ func ToProtoOrder(serviceOrder *model.Order) *orderpb.GetOrderResponse {
 		TransactionUuid: transactionUUID,
 		PaymentMethod:   paymentMethod,
 		Status:          StatusToProtobuf(serviceOrder.Status),
+		Items:           items,
+	}
+}
+
+// ToProtoListOrdersResponse конвертирует страницу заказов в protobuf ListOrdersResponse
+func ToProtoListOrdersResponse(page *model.OrderPage) *orderpb.ListOrdersResponse {
+	orders := make([]*orderpb.GetOrderResponse, 0, len(page.Orders))
+	for _, o := range page.Orders {
+		orders = append(orders, ToProtoOrder(o))
+	}
+
+	return &orderpb.ListOrdersResponse{
+		Orders:        orders,
+		NextPageToken: EncodePageToken(page.NextCursor),
 	}
 }
+
+// ToProtoOrderHistory конвертирует историю статусов заказа в protobuf GetOrderHistoryResponse
+func ToProtoOrderHistory(history []*model.StatusHistoryEntry) *orderpb.GetOrderHistoryResponse {
+	entries := make([]*orderpb.OrderStatusHistoryEntry, 0, len(history))
+
+	for _, h := range history {
+		entry := &orderpb.OrderStatusHistoryEntry{
+			ToStatus:  StatusToProtobuf(h.ToStatus),
+			Actor:     h.Actor,
+			ChangedAt: timestamppb.New(h.CreatedAt),
+		}
+
+		if h.FromStatus != nil {
+			fromStatus := StatusToProtobuf(*h.FromStatus)
+			entry.FromStatus = &fromStatus
+		}
+
+		if h.EventUUID != nil {
+			eventUUID := h.EventUUID.String()
+			entry.EventUuid = &eventUUID
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &orderpb.GetOrderHistoryResponse{Entries: entries}
+}
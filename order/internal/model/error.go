@@ -16,12 +16,69 @@ var (
 	ErrOrderCannotBeCancelled = errors.New("order cannot be cancelled")
 	// ErrPartNotFound - ошибка "деталь не найдена"
 	ErrPartNotFound = errors.New("part not found")
+	// ErrInsufficientStock - ошибка "недостаточно деталей на складе"
+	ErrInsufficientStock = errors.New("insufficient stock")
+	// ErrReservationNotFound - ошибка "резерв деталей заказа не найден"
+	ErrReservationNotFound = errors.New("reservation not found")
 	// ErrInventoryServiceUnavailable - ошибка "сервис инвентаря недоступен"
 	ErrInventoryServiceUnavailable = errors.New("inventory service unavailable")
 	// ErrPaymentServiceUnavailable - ошибка "сервис платежей недоступен"
 	ErrPaymentServiceUnavailable = errors.New("payment service unavailable")
+	// ErrPaymentDeclined - ошибка "payment service отклонил оплату"
+	ErrPaymentDeclined = errors.New("payment declined")
+	// ErrOrderAlreadyPaid - ошибка "заказ уже оплачен другой транзакцией в payment service"
+	ErrOrderAlreadyPaid = errors.New("order already paid")
+	// ErrInvalidPaymentRequest - ошибка "payment service отклонил параметры оплаты"
+	ErrInvalidPaymentRequest = errors.New("invalid payment request")
+	// ErrPaymentTransactionNotFound - ошибка "транзакция не найдена в payment service"
+	ErrPaymentTransactionNotFound = errors.New("payment transaction not found")
+	// ErrOrderAccessDenied - ошибка "заказ принадлежит другому пользователю"
+	ErrOrderAccessDenied = errors.New("access to order denied")
+	// ErrOrderVersionConflict - ошибка "заказ был изменен параллельно"
+	ErrOrderVersionConflict = errors.New("order was modified concurrently")
+	// ErrIdempotencyKeyReused - ошибка "ключ идемпотентности использован с другим запросом"
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused with different request")
+	// ErrIdempotencyRequestInProgress - ошибка "запрос с этим ключом идемпотентности еще выполняется"
+	ErrIdempotencyRequestInProgress = errors.New("request with this idempotency key is in progress")
 )
 
+// OrderVersionConflictError описывает попытку обновить заказ по устаревшей версии.
+// Сопоставляется с ErrOrderVersionConflict через errors.Is
+type OrderVersionConflictError struct {
+	OrderUUID       string
+	ExpectedVersion int64
+	ActualVersion   int64
+}
+
+// Error реализует интерфейс error
+func (e *OrderVersionConflictError) Error() string {
+	return fmt.Sprintf("%s: order %s expected version %d, actual %d",
+		ErrOrderVersionConflict, e.OrderUUID, e.ExpectedVersion, e.ActualVersion)
+}
+
+// Is позволяет сравнивать ошибку с ErrOrderVersionConflict через errors.Is
+func (e *OrderVersionConflictError) Is(target error) bool {
+	return target == ErrOrderVersionConflict
+}
+
+// PaymentDeclinedError описывает отказ payment service в оплате заказа.
+// Reason - машиночитаемая причина отказа, например DAILY_LIMIT_EXCEEDED.
+// Сопоставляется с ErrPaymentDeclined через errors.Is
+type PaymentDeclinedError struct {
+	Reason  string
+	Message string
+}
+
+// Error реализует интерфейс error
+func (e *PaymentDeclinedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPaymentDeclined, e.Message)
+}
+
+// Is позволяет сравнивать ошибку с ErrPaymentDeclined через errors.Is
+func (e *PaymentDeclinedError) Is(target error) bool {
+	return target == ErrPaymentDeclined
+}
+
 // NewOrderNotFoundError создает ошибку "заказ не найден"
 func NewOrderNotFoundError(orderUUID string) error {
 	return fmt.Errorf("%w: %s", ErrOrderNotFound, orderUUID)
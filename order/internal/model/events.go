@@ -4,6 +4,15 @@ import (
 	"github.com/google/uuid"
 )
 
+// OrderCreated представляет событие о создании заказа
+type OrderCreated struct {
+	EventUUID  uuid.UUID
+	OrderUUID  uuid.UUID
+	UserUUID   uuid.UUID
+	TotalPrice float64
+	Items      []OrderItem
+}
+
 // OrderPaid представляет событие об оплате заказа
 type OrderPaid struct {
 	EventUUID       uuid.UUID
@@ -13,6 +22,33 @@ type OrderPaid struct {
 	TransactionUUID uuid.UUID
 }
 
+// OrderCancelled представляет событие об отмене заказа.
+// RefundUUID заполнен, если по заказу был выполнен возврат средств
+type OrderCancelled struct {
+	EventUUID  uuid.UUID
+	OrderUUID  uuid.UUID
+	UserUUID   uuid.UUID
+	RefundUUID *uuid.UUID
+}
+
+// PaymentCompleted представляет событие о подтверждении асинхронной оплаты заказа
+type PaymentCompleted struct {
+	EventUUID       uuid.UUID
+	TransactionUUID uuid.UUID
+	OrderUUID       uuid.UUID
+	UserUUID        uuid.UUID
+	PaymentMethod   PaymentMethod
+}
+
+// PaymentFailed представляет событие об отказе в асинхронной оплате заказа
+type PaymentFailed struct {
+	EventUUID       uuid.UUID
+	TransactionUUID uuid.UUID
+	OrderUUID       uuid.UUID
+	UserUUID        uuid.UUID
+	DeclineCode     string
+}
+
 // ShipAssembled представляет событие о завершении сборки корабля
 type ShipAssembled struct {
 	EventUUID    uuid.UUID
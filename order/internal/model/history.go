@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ActorSystem инициатор изменений, выполняемых самим сервисом
+	ActorSystem = "system"
+	// ActorShipAssembledConsumer инициатор изменений по событию ShipAssembled
+	ActorShipAssembledConsumer = "ship-assembled-consumer"
+	// ActorPaymentConsumer инициатор изменений по событиям PaymentCompleted и PaymentFailed
+	ActorPaymentConsumer = "payment-consumer"
+)
+
+// StatusChange описывает, кто и по какому событию меняет статус заказа
+type StatusChange struct {
+	Actor     string
+	EventUUID *uuid.UUID
+}
+
+// StatusHistoryEntry представляет запись истории статусов заказа
+type StatusHistoryEntry struct {
+	UUID       uuid.UUID
+	OrderUUID  uuid.UUID
+	FromStatus *Status
+	ToStatus   Status
+	Actor      string
+	EventUUID  *uuid.UUID
+	CreatedAt  time.Time
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+)
+
+// IdempotencyKey идентифицирует запрос клиента с ключом идемпотентности.
+// Scope ограничивает область действия ключа одним пользователем
+type IdempotencyKey struct {
+	Scope  string
+	Key    string
+	Method string
+}
+
+// IdempotencyRecord представляет сохраненный результат идемпотентного запроса.
+// Response равен nil, пока исходный запрос еще выполняется
+type IdempotencyRecord struct {
+	IdempotencyKey
+	RequestHash []byte
+	Response    []byte
+	CreatedAt   time.Time
+}
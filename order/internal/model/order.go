@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -24,8 +26,36 @@ const (
 	StatusPaid
 	StatusAssembled
 	StatusCancelled
+	StatusRefunded
+	StatusPaymentProcessing
+	StatusRefunding
 )
 
+// String возвращает строковое представление Status
+func (s Status) String() string {
+	switch s {
+	case StatusPendingPayment:
+		return "PENDING_PAYMENT"
+	case StatusPaid:
+		return "PAID"
+	case StatusAssembled:
+		return "ASSEMBLED"
+	case StatusCancelled:
+		return "CANCELLED"
+	case StatusRefunded:
+		return "REFUNDED"
+	case StatusPaymentProcessing:
+		return "PAYMENT_PROCESSING"
+	case StatusRefunding:
+		return "REFUNDING"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// OrderCurrency - валюта цен заказа; цены деталей в inventory указаны в рублях
+const OrderCurrency = "RUB"
+
 // Order представляет заказ в сервисном слое
 type Order struct {
 	OrderUUID       uuid.UUID
@@ -35,9 +65,42 @@ type Order struct {
 	TransactionUUID *uuid.UUID
 	PaymentMethod   *PaymentMethod
 	Status          Status
+	CreatedAt       time.Time
+	// Version увеличивается при каждом обновлении заказа и используется для оптимистичной блокировки
+	Version int64
 }
 
+// OrderItem представляет позицию заказа. UnitPrice, PartName и Category
+// фиксируются при создании заказа и не меняются вслед за инвентарем
 type OrderItem struct {
-	PartUUID uuid.UUID
-	Quantity int
+	PartUUID  uuid.UUID
+	Quantity  int
+	UnitPrice float64
+	PartName  string
+	Category  string
+}
+
+// OrderFilter задает условия выборки списка заказов.
+// Выборка по всем пользователям (AllUsers) нужна только внутренним вызовам других сервисов
+type OrderFilter struct {
+	UserUUID    uuid.UUID
+	AllUsers    bool
+	OrderUUIDs  []uuid.UUID
+	Statuses    []Status
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+}
+
+// OrderCursor указывает на последний заказ предыдущей страницы списка
+type OrderCursor struct {
+	CreatedAt time.Time
+	OrderUUID uuid.UUID
+}
+
+// OrderPage представляет страницу списка заказов
+type OrderPage struct {
+	Orders     []*Order
+	NextCursor *OrderCursor
 }
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// EventTypeOrderCreated - тип события "заказ создан" в outbox
+	EventTypeOrderCreated = "OrderCreated"
+	// EventTypeOrderPaid - тип события "заказ оплачен" в outbox
+	EventTypeOrderPaid = "OrderPaid"
+	// EventTypeOrderCancelled - тип события "заказ отменен" в outbox
+	EventTypeOrderCancelled = "OrderCancelled"
+)
+
+// OutboxMessage представляет событие, ожидающее публикации в Kafka
+type OutboxMessage struct {
+	UUID          uuid.UUID
+	AggregateUUID uuid.UUID
+	EventType     string
+	Key           []byte
+	Payload       []byte
+	// TraceContext - контекст трассировки запроса, в котором создано событие
+	TraceContext map[string]string
+	CreatedAt    time.Time
+}
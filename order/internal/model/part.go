@@ -1,14 +1,17 @@
 package model
 
 import (
+	"strings"
+
 	inventorypb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/inventory/v1"
 )
 
 // Part представляет деталь в сервисном слое order service
 type Part struct {
-	UUID  string
-	Name  string
-	Price float64
+	UUID     string
+	Name     string
+	Price    float64
+	Category string
 }
 
 // ToServicePart конвертирует protobuf Part в модель сервисного слоя
@@ -18,9 +21,10 @@ func ToServicePart(pbPart *inventorypb.Part) *Part {
 	}
 
 	return &Part{
-		UUID:  pbPart.GetUuid(),
-		Name:  pbPart.GetName(),
-		Price: pbPart.GetPrice(),
+		UUID:     pbPart.GetUuid(),
+		Name:     pbPart.GetName(),
+		Price:    pbPart.GetPrice(),
+		Category: strings.TrimPrefix(pbPart.GetCategory().String(), "CATEGORY_"),
 	}
 }
 
@@ -0,0 +1,13 @@
+package model
+
+import (
+	paymentpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+// PaymentTransaction описывает транзакцию payment service в объеме,
+// достаточном для проверки оплаты заказа
+type PaymentTransaction struct {
+	TransactionUUID string
+	OrderUUID       string
+	Status          paymentpb.TransactionStatus
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidStatusTransition - ошибка "недопустимый переход статуса заказа"
+var ErrInvalidStatusTransition = errors.New("invalid order status transition")
+
+// allowedTransitions описывает конечный автомат статусов заказа:
+// для каждого статуса перечислены статусы, в которые из него можно перейти
+var allowedTransitions = map[Status][]Status{
+	StatusPendingPayment:    {StatusPaymentProcessing, StatusPaid, StatusCancelled},
+	StatusPaymentProcessing: {StatusPaid, StatusPendingPayment},
+	StatusPaid:              {StatusAssembled, StatusRefunding},
+	StatusRefunding:         {StatusRefunded},
+	StatusAssembled:         {},
+	StatusCancelled:         {},
+	StatusRefunded:          {},
+}
+
+// StatusTransitionError описывает отклоненный переход статуса заказа.
+// Сопоставляется через errors.Is с ErrInvalidStatusTransition, а также
+// с ErrOrderCannotBePaid / ErrOrderCannotBeCancelled для соответствующего целевого статуса
+// (отмена оплаченного заказа переводит его через REFUNDING в REFUNDED)
+type StatusTransitionError struct {
+	From Status
+	To   Status
+}
+
+// Error реализует интерфейс error
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("%s: %s -> %s", ErrInvalidStatusTransition, e.From, e.To)
+}
+
+// Is позволяет сравнивать ошибку с sentinel-ошибками через errors.Is
+func (e *StatusTransitionError) Is(target error) bool {
+	switch target {
+	case ErrInvalidStatusTransition:
+		return true
+	case ErrOrderCannotBePaid:
+		return e.To == StatusPaid || e.To == StatusPaymentProcessing
+	case ErrOrderCannotBeCancelled:
+		return e.To == StatusCancelled || e.To == StatusRefunding || e.To == StatusRefunded
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo проверяет, разрешен ли переход из текущего статуса в to
+func (s Status) CanTransitionTo(to Status) bool {
+	for _, allowed := range allowedTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateTransition возвращает StatusTransitionError, если переход from -> to запрещен
+func ValidateTransition(from, to Status) error {
+	if !from.CanTransitionTo(to) {
+		return &StatusTransitionError{From: from, To: to}
+	}
+
+	return nil
+}
+
+// TransitionTo переводит заказ в статус to, если переход разрешен
+func (o *Order) TransitionTo(to Status) error {
+	if err := ValidateTransition(o.Status, to); err != nil {
+		return err
+	}
+
+	o.Status = to
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		from    Status
+		to      Status
+		allowed bool
+	}{
+		{from: StatusUnspecified, to: StatusUnspecified},
+		{from: StatusUnspecified, to: StatusPendingPayment},
+		{from: StatusUnspecified, to: StatusPaid},
+		{from: StatusUnspecified, to: StatusAssembled},
+		{from: StatusUnspecified, to: StatusCancelled},
+		{from: StatusUnspecified, to: StatusRefunded},
+		{from: StatusUnspecified, to: StatusPaymentProcessing},
+		{from: StatusUnspecified, to: StatusRefunding},
+
+		{from: StatusPendingPayment, to: StatusUnspecified},
+		{from: StatusPendingPayment, to: StatusPendingPayment},
+		{from: StatusPendingPayment, to: StatusPaid, allowed: true},
+		{from: StatusPendingPayment, to: StatusAssembled},
+		{from: StatusPendingPayment, to: StatusCancelled, allowed: true},
+		{from: StatusPendingPayment, to: StatusRefunded},
+		{from: StatusPendingPayment, to: StatusPaymentProcessing, allowed: true},
+		{from: StatusPendingPayment, to: StatusRefunding},
+
+		{from: StatusPaid, to: StatusUnspecified},
+		{from: StatusPaid, to: StatusPendingPayment},
+		{from: StatusPaid, to: StatusPaid},
+		{from: StatusPaid, to: StatusAssembled, allowed: true},
+		{from: StatusPaid, to: StatusCancelled},
+		{from: StatusPaid, to: StatusRefunded},
+		{from: StatusPaid, to: StatusPaymentProcessing},
+		{from: StatusPaid, to: StatusRefunding, allowed: true},
+
+		{from: StatusAssembled, to: StatusUnspecified},
+		{from: StatusAssembled, to: StatusPendingPayment},
+		{from: StatusAssembled, to: StatusPaid},
+		{from: StatusAssembled, to: StatusAssembled},
+		{from: StatusAssembled, to: StatusCancelled},
+		{from: StatusAssembled, to: StatusRefunded},
+		{from: StatusAssembled, to: StatusPaymentProcessing},
+		{from: StatusAssembled, to: StatusRefunding},
+
+		{from: StatusCancelled, to: StatusUnspecified},
+		{from: StatusCancelled, to: StatusPendingPayment},
+		{from: StatusCancelled, to: StatusPaid},
+		{from: StatusCancelled, to: StatusAssembled},
+		{from: StatusCancelled, to: StatusCancelled},
+		{from: StatusCancelled, to: StatusRefunded},
+		{from: StatusCancelled, to: StatusPaymentProcessing},
+		{from: StatusCancelled, to: StatusRefunding},
+
+		{from: StatusRefunded, to: StatusUnspecified},
+		{from: StatusRefunded, to: StatusPendingPayment},
+		{from: StatusRefunded, to: StatusPaid},
+		{from: StatusRefunded, to: StatusAssembled},
+		{from: StatusRefunded, to: StatusCancelled},
+		{from: StatusRefunded, to: StatusRefunded},
+		{from: StatusRefunded, to: StatusPaymentProcessing},
+		{from: StatusRefunded, to: StatusRefunding},
+
+		{from: StatusPaymentProcessing, to: StatusUnspecified},
+		{from: StatusPaymentProcessing, to: StatusPendingPayment, allowed: true},
+		{from: StatusPaymentProcessing, to: StatusPaid, allowed: true},
+		{from: StatusPaymentProcessing, to: StatusAssembled},
+		{from: StatusPaymentProcessing, to: StatusCancelled},
+		{from: StatusPaymentProcessing, to: StatusRefunded},
+		{from: StatusPaymentProcessing, to: StatusPaymentProcessing},
+		{from: StatusPaymentProcessing, to: StatusRefunding},
+
+		{from: StatusRefunding, to: StatusUnspecified},
+		{from: StatusRefunding, to: StatusPendingPayment},
+		{from: StatusRefunding, to: StatusPaid},
+		{from: StatusRefunding, to: StatusAssembled},
+		{from: StatusRefunding, to: StatusCancelled},
+		{from: StatusRefunding, to: StatusRefunded, allowed: true},
+		{from: StatusRefunding, to: StatusPaymentProcessing},
+		{from: StatusRefunding, to: StatusRefunding},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from.String()+"->"+tt.to.String(), func(t *testing.T) {
+			err := ValidateTransition(tt.from, tt.to)
+
+			require.Equal(t, tt.allowed, tt.from.CanTransitionTo(tt.to))
+
+			if tt.allowed {
+				require.NoError(t, err)
+				return
+			}
+
+			var transitionErr *StatusTransitionError
+
+			require.ErrorIs(t, err, ErrInvalidStatusTransition)
+			require.ErrorAs(t, err, &transitionErr)
+			require.Equal(t, tt.from, transitionErr.From)
+			require.Equal(t, tt.to, transitionErr.To)
+			require.Equal(t, tt.to == StatusPaid || tt.to == StatusPaymentProcessing, errors.Is(err, ErrOrderCannotBePaid))
+			require.Equal(t, tt.to == StatusCancelled || tt.to == StatusRefunding || tt.to == StatusRefunded, errors.Is(err, ErrOrderCannotBeCancelled))
+		})
+	}
+}
+
+func TestOrderTransitionTo(t *testing.T) {
+	order := &Order{Status: StatusPendingPayment}
+
+	require.NoError(t, order.TransitionTo(StatusPaid))
+	require.Equal(t, StatusPaid, order.Status)
+
+	err := order.TransitionTo(StatusCancelled)
+	require.ErrorIs(t, err, ErrOrderCannotBeCancelled)
+	require.Equal(t, StatusPaid, order.Status)
+
+	require.NoError(t, order.TransitionTo(StatusRefunding))
+	require.Equal(t, StatusRefunding, order.Status)
+
+	require.NoError(t, order.TransitionTo(StatusRefunded))
+	require.Equal(t, StatusRefunded, order.Status)
+}
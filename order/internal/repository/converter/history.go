@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	repoModel "github.com/radiophysiker/microservices-homework/order/internal/repository/model"
+)
+
+// ToServiceStatusHistoryEntry конвертирует модель repository в модель service
+func ToServiceStatusHistoryEntry(repoEntry *repoModel.StatusHistoryEntry) *model.StatusHistoryEntry {
+	if repoEntry == nil {
+		return nil
+	}
+
+	var fromStatus *model.Status
+
+	if repoEntry.FromStatus != nil {
+		s := toServiceStatus(StringToOrderStatus(*repoEntry.FromStatus))
+		fromStatus = &s
+	}
+
+	return &model.StatusHistoryEntry{
+		UUID:       repoEntry.UUID,
+		OrderUUID:  repoEntry.OrderUUID,
+		FromStatus: fromStatus,
+		ToStatus:   toServiceStatus(StringToOrderStatus(repoEntry.ToStatus)),
+		Actor:      repoEntry.Actor,
+		EventUUID:  repoEntry.EventUUID,
+		CreatedAt:  repoEntry.CreatedAt,
+	}
+}
@@ -23,8 +23,11 @@ func ToServiceOrder(repoOrder *repoModel.Order) *model.Order {
 	serviceItems := make([]model.OrderItem, 0, len(repoOrder.Items))
 	for _, it := range repoOrder.Items {
 		serviceItems = append(serviceItems, model.OrderItem{
-			PartUUID: it.PartUUID,
-			Quantity: it.Quantity,
+			PartUUID:  it.PartUUID,
+			Quantity:  it.Quantity,
+			UnitPrice: it.UnitPrice,
+			PartName:  it.PartName,
+			Category:  it.Category,
 		})
 	}
 
@@ -36,6 +39,8 @@ func ToServiceOrder(repoOrder *repoModel.Order) *model.Order {
 		TransactionUUID: repoOrder.TransactionUUID,
 		PaymentMethod:   paymentMethod,
 		Status:          toServiceStatus(repoOrder.Status),
+		CreatedAt:       repoOrder.CreatedAt,
+		Version:         repoOrder.Version,
 	}
 }
 
@@ -55,8 +60,11 @@ func ToRepoOrder(serviceOrder *model.Order) *repoModel.Order {
 	repoItems := make([]repoModel.OrderItem, 0, len(serviceOrder.Items))
 	for _, it := range serviceOrder.Items {
 		repoItems = append(repoItems, repoModel.OrderItem{
-			PartUUID: it.PartUUID,
-			Quantity: it.Quantity,
+			PartUUID:  it.PartUUID,
+			Quantity:  it.Quantity,
+			UnitPrice: it.UnitPrice,
+			PartName:  it.PartName,
+			Category:  it.Category,
 		})
 	}
 
@@ -68,6 +76,8 @@ func ToRepoOrder(serviceOrder *model.Order) *repoModel.Order {
 		TransactionUUID: serviceOrder.TransactionUUID,
 		PaymentMethod:   paymentMethod,
 		Status:          toRepoStatus(serviceOrder.Status),
+		CreatedAt:       serviceOrder.CreatedAt,
+		Version:         serviceOrder.Version,
 	}
 }
 
@@ -111,6 +121,12 @@ func toServiceStatus(s repoModel.Status) model.Status {
 		return model.StatusAssembled
 	case repoModel.StatusCancelled:
 		return model.StatusCancelled
+	case repoModel.StatusRefunded:
+		return model.StatusRefunded
+	case repoModel.StatusPaymentProcessing:
+		return model.StatusPaymentProcessing
+	case repoModel.StatusRefunding:
+		return model.StatusRefunding
 	default:
 		return model.StatusUnspecified
 	}
@@ -126,6 +142,12 @@ func toRepoStatus(s model.Status) repoModel.Status {
 		return repoModel.StatusAssembled
 	case model.StatusCancelled:
 		return repoModel.StatusCancelled
+	case model.StatusRefunded:
+		return repoModel.StatusRefunded
+	case model.StatusPaymentProcessing:
+		return repoModel.StatusPaymentProcessing
+	case model.StatusRefunding:
+		return repoModel.StatusRefunding
 	default:
 		return repoModel.StatusUnspecified
 	}
@@ -163,7 +185,23 @@ func StringToOrderStatus(s string) repoModel.Status {
 		return repoModel.StatusAssembled
 	case "CANCELLED":
 		return repoModel.StatusCancelled
+	case "REFUNDED":
+		return repoModel.StatusRefunded
+	case "PAYMENT_PROCESSING":
+		return repoModel.StatusPaymentProcessing
+	case "REFUNDING":
+		return repoModel.StatusRefunding
 	default:
 		return repoModel.StatusUnspecified
 	}
 }
+
+// ToRepoStatusStrings конвертирует статусы service слоя в строковые значения для БД
+func ToRepoStatusStrings(statuses []model.Status) []string {
+	result := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		result = append(result, toRepoStatus(s).String())
+	}
+
+	return result
+}
@@ -0,0 +1,40 @@
+package converter
+
+import (
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	repoModel "github.com/radiophysiker/microservices-homework/order/internal/repository/model"
+)
+
+// ToServiceOutboxMessage конвертирует модель repository в модель service
+func ToServiceOutboxMessage(repoMessage *repoModel.OutboxMessage) *model.OutboxMessage {
+	if repoMessage == nil {
+		return nil
+	}
+
+	return &model.OutboxMessage{
+		UUID:          repoMessage.UUID,
+		AggregateUUID: repoMessage.AggregateUUID,
+		EventType:     repoMessage.EventType,
+		Key:           repoMessage.Key,
+		Payload:       repoMessage.Payload,
+		TraceContext:  repoMessage.TraceContext,
+		CreatedAt:     repoMessage.CreatedAt,
+	}
+}
+
+// ToRepoOutboxMessage конвертирует модель service в модель repository
+func ToRepoOutboxMessage(serviceMessage *model.OutboxMessage) *repoModel.OutboxMessage {
+	if serviceMessage == nil {
+		return nil
+	}
+
+	return &repoModel.OutboxMessage{
+		UUID:          serviceMessage.UUID,
+		AggregateUUID: serviceMessage.AggregateUUID,
+		EventType:     serviceMessage.EventType,
+		Key:           serviceMessage.Key,
+		Payload:       serviceMessage.Payload,
+		TraceContext:  serviceMessage.TraceContext,
+		CreatedAt:     serviceMessage.CreatedAt,
+	}
+}
@@ -0,0 +1,45 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+)
+
+// Complete сохраняет ответ на запрос с ключом идемпотентности
+func (r *Repository) Complete(ctx context.Context, key model.IdempotencyKey, response []byte) error {
+	query, args, err := sq.Update("idempotency_keys").
+		Set("response", response).
+		Where(sq.Eq{"scope": key.Scope, "key": key.Key}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build complete idempotency key query: %w", err)
+	}
+
+	if _, err = r.pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// Release удаляет незавершенный резерв ключа, чтобы запрос можно было повторить
+func (r *Repository) Release(ctx context.Context, key model.IdempotencyKey) error {
+	query, args, err := sq.Delete("idempotency_keys").
+		Where(sq.Eq{"scope": key.Scope, "key": key.Key, "response": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build release idempotency key query: %w", err)
+	}
+
+	if _, err = r.pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+)
+
+// reserveAttempts - сколько раз Reserve пытается занять ключ, запись которого исчезает
+// между вставкой и чтением из-за параллельных запросов с тем же ключом
+const reserveAttempts = 3
+
+// Reserve резервирует ключ за запросом. Если ключ уже занят действующей записью,
+// возвращает ее; просроченная запись перезаписывается новым резервом.
+// Если ключ так и не удалось ни занять, ни прочитать, возвращает ErrIdempotencyRequestInProgress
+func (r *Repository) Reserve(ctx context.Context, record *model.IdempotencyRecord, expiredBefore time.Time) (*model.IdempotencyRecord, error) {
+	query, args, err := sq.Insert("idempotency_keys").
+		Columns("scope", "key", "method", "request_hash", "created_at").
+		Values(record.Scope, record.Key, record.Method, record.RequestHash, record.CreatedAt).
+		Suffix(`ON CONFLICT (scope, key) DO UPDATE
+			SET method = EXCLUDED.method,
+				request_hash = EXCLUDED.request_hash,
+				response = NULL,
+				created_at = EXCLUDED.created_at
+			WHERE idempotency_keys.created_at < ?
+			RETURNING scope`, expiredBefore).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build reserve idempotency key query: %w", err)
+	}
+
+	for range reserveAttempts {
+		var scope string
+
+		err = r.pool.QueryRow(ctx, query, args...).Scan(&scope)
+		if err == nil {
+			return nil, nil
+		}
+
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+		}
+
+		// Ключ занят действующей записью - возвращаем ее
+		existing, err := r.get(ctx, record.IdempotencyKey)
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Запись сняли или удалили между вставкой и чтением - ключ снова свободен
+			continue
+		}
+
+		return existing, err
+	}
+
+	return nil, model.ErrIdempotencyRequestInProgress
+}
+
+// get возвращает запись по ключу
+func (r *Repository) get(ctx context.Context, key model.IdempotencyKey) (*model.IdempotencyRecord, error) {
+	query, args, err := sq.Select("scope", "key", "method", "request_hash", "response", "created_at").
+		From("idempotency_keys").
+		Where(sq.Eq{"scope": key.Scope, "key": key.Key}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get idempotency key query: %w", err)
+	}
+
+	var record model.IdempotencyRecord
+
+	err = r.pool.QueryRow(ctx, query, args...).Scan(
+		&record.Scope,
+		&record.Key,
+		&record.Method,
+		&record.RequestHash,
+		&record.Response,
+		&record.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return &record, nil
+}
@@ -0,0 +1,234 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIdempotencyRepository creates a new instance of MockIdempotencyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotencyRepository is an autogenerated mock type for the IdempotencyRepository type
+type MockIdempotencyRepository struct {
+	mock.Mock
+}
+
+type MockIdempotencyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepository_Expecter {
+	return &MockIdempotencyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) Complete(ctx context.Context, key model.IdempotencyKey, response []byte) error {
+	ret := _mock.Called(ctx, key, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey, []byte) error); ok {
+		r0 = returnFunc(ctx, key, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyRepository_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockIdempotencyRepository_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.IdempotencyKey
+//   - response []byte
+func (_e *MockIdempotencyRepository_Expecter) Complete(ctx interface{}, key interface{}, response interface{}) *MockIdempotencyRepository_Complete_Call {
+	return &MockIdempotencyRepository_Complete_Call{Call: _e.mock.On("Complete", ctx, key, response)}
+}
+
+func (_c *MockIdempotencyRepository_Complete_Call) Run(run func(ctx context.Context, key model.IdempotencyKey, response []byte)) *MockIdempotencyRepository_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.IdempotencyKey
+		if args[1] != nil {
+			arg1 = args[1].(model.IdempotencyKey)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Complete_Call) Return(err error) *MockIdempotencyRepository_Complete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Complete_Call) RunAndReturn(run func(ctx context.Context, key model.IdempotencyKey, response []byte) error) *MockIdempotencyRepository_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) Release(ctx context.Context, key model.IdempotencyKey) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyRepository_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type MockIdempotencyRepository_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.IdempotencyKey
+func (_e *MockIdempotencyRepository_Expecter) Release(ctx interface{}, key interface{}) *MockIdempotencyRepository_Release_Call {
+	return &MockIdempotencyRepository_Release_Call{Call: _e.mock.On("Release", ctx, key)}
+}
+
+func (_c *MockIdempotencyRepository_Release_Call) Run(run func(ctx context.Context, key model.IdempotencyKey)) *MockIdempotencyRepository_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.IdempotencyKey
+		if args[1] != nil {
+			arg1 = args[1].(model.IdempotencyKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Release_Call) Return(err error) *MockIdempotencyRepository_Release_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Release_Call) RunAndReturn(run func(ctx context.Context, key model.IdempotencyKey) error) *MockIdempotencyRepository_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reserve provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) Reserve(ctx context.Context, record *model.IdempotencyRecord, expiredBefore time.Time) (*model.IdempotencyRecord, error) {
+	ret := _mock.Called(ctx, record, expiredBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 *model.IdempotencyRecord
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.IdempotencyRecord, time.Time) (*model.IdempotencyRecord, error)); ok {
+		return returnFunc(ctx, record, expiredBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.IdempotencyRecord, time.Time) *model.IdempotencyRecord); ok {
+		r0 = returnFunc(ctx, record, expiredBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IdempotencyRecord)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.IdempotencyRecord, time.Time) error); ok {
+		r1 = returnFunc(ctx, record, expiredBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdempotencyRepository_Reserve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reserve'
+type MockIdempotencyRepository_Reserve_Call struct {
+	*mock.Call
+}
+
+// Reserve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record *model.IdempotencyRecord
+//   - expiredBefore time.Time
+func (_e *MockIdempotencyRepository_Expecter) Reserve(ctx interface{}, record interface{}, expiredBefore interface{}) *MockIdempotencyRepository_Reserve_Call {
+	return &MockIdempotencyRepository_Reserve_Call{Call: _e.mock.On("Reserve", ctx, record, expiredBefore)}
+}
+
+func (_c *MockIdempotencyRepository_Reserve_Call) Run(run func(ctx context.Context, record *model.IdempotencyRecord, expiredBefore time.Time)) *MockIdempotencyRepository_Reserve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.IdempotencyRecord
+		if args[1] != nil {
+			arg1 = args[1].(*model.IdempotencyRecord)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Reserve_Call) Return(idempotencyRecord *model.IdempotencyRecord, err error) *MockIdempotencyRepository_Reserve_Call {
+	_c.Call.Return(idempotencyRecord, err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Reserve_Call) RunAndReturn(run func(ctx context.Context, record *model.IdempotencyRecord, expiredBefore time.Time) (*model.IdempotencyRecord, error)) *MockIdempotencyRepository_Reserve_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -6,8 +6,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/order/internal/repository"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,6 +40,92 @@ func (_m *MockOrderRepository) EXPECT() *MockOrderRepository_Expecter {
 	return &MockOrderRepository_Expecter{mock: &_m.Mock}
 }
 
+// CancelExpiredOrders provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) CancelExpiredOrders(ctx context.Context, createdBefore time.Time, limit int, change model.StatusChange, handler repository.ExpiredOrderHandler) ([]*model.Order, error) {
+	ret := _mock.Called(ctx, createdBefore, limit, change, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelExpiredOrders")
+	}
+
+	var r0 []*model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int, model.StatusChange, repository.ExpiredOrderHandler) ([]*model.Order, error)); ok {
+		return returnFunc(ctx, createdBefore, limit, change, handler)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int, model.StatusChange, repository.ExpiredOrderHandler) []*model.Order); ok {
+		r0 = returnFunc(ctx, createdBefore, limit, change, handler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int, model.StatusChange, repository.ExpiredOrderHandler) error); ok {
+		r1 = returnFunc(ctx, createdBefore, limit, change, handler)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_CancelExpiredOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExpiredOrders'
+type MockOrderRepository_CancelExpiredOrders_Call struct {
+	*mock.Call
+}
+
+// CancelExpiredOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+//   - change model.StatusChange
+//   - handler repository.ExpiredOrderHandler
+func (_e *MockOrderRepository_Expecter) CancelExpiredOrders(ctx interface{}, createdBefore interface{}, limit interface{}, change interface{}, handler interface{}) *MockOrderRepository_CancelExpiredOrders_Call {
+	return &MockOrderRepository_CancelExpiredOrders_Call{Call: _e.mock.On("CancelExpiredOrders", ctx, createdBefore, limit, change, handler)}
+}
+
+func (_c *MockOrderRepository_CancelExpiredOrders_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int, change model.StatusChange, handler repository.ExpiredOrderHandler)) *MockOrderRepository_CancelExpiredOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 model.StatusChange
+		if args[3] != nil {
+			arg3 = args[3].(model.StatusChange)
+		}
+		var arg4 repository.ExpiredOrderHandler
+		if args[4] != nil {
+			arg4 = args[4].(repository.ExpiredOrderHandler)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_CancelExpiredOrders_Call) Return(orders []*model.Order, err error) *MockOrderRepository_CancelExpiredOrders_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_CancelExpiredOrders_Call) RunAndReturn(run func(ctx context.Context, createdBefore time.Time, limit int, change model.StatusChange, handler repository.ExpiredOrderHandler) ([]*model.Order, error)) *MockOrderRepository_CancelExpiredOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateOrder provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) CreateOrder(ctx context.Context, order *model.Order) error {
 	ret := _mock.Called(ctx, order)
@@ -95,6 +183,69 @@ func (_c *MockOrderRepository_CreateOrder_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// CreateOrderWithOutbox provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) CreateOrderWithOutbox(ctx context.Context, order *model.Order, message *model.OutboxMessage) error {
+	ret := _mock.Called(ctx, order, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrderWithOutbox")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, *model.OutboxMessage) error); ok {
+		r0 = returnFunc(ctx, order, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderRepository_CreateOrderWithOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrderWithOutbox'
+type MockOrderRepository_CreateOrderWithOutbox_Call struct {
+	*mock.Call
+}
+
+// CreateOrderWithOutbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - message *model.OutboxMessage
+func (_e *MockOrderRepository_Expecter) CreateOrderWithOutbox(ctx interface{}, order interface{}, message interface{}) *MockOrderRepository_CreateOrderWithOutbox_Call {
+	return &MockOrderRepository_CreateOrderWithOutbox_Call{Call: _e.mock.On("CreateOrderWithOutbox", ctx, order, message)}
+}
+
+func (_c *MockOrderRepository_CreateOrderWithOutbox_Call) Run(run func(ctx context.Context, order *model.Order, message *model.OutboxMessage)) *MockOrderRepository_CreateOrderWithOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Order
+		if args[1] != nil {
+			arg1 = args[1].(*model.Order)
+		}
+		var arg2 *model.OutboxMessage
+		if args[2] != nil {
+			arg2 = args[2].(*model.OutboxMessage)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_CreateOrderWithOutbox_Call) Return(err error) *MockOrderRepository_CreateOrderWithOutbox_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderRepository_CreateOrderWithOutbox_Call) RunAndReturn(run func(ctx context.Context, order *model.Order, message *model.OutboxMessage) error) *MockOrderRepository_CreateOrderWithOutbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrder provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) GetOrder(ctx context.Context, orderUUID string) (*model.Order, error) {
 	ret := _mock.Called(ctx, orderUUID)
@@ -163,9 +314,157 @@ func (_c *MockOrderRepository_GetOrder_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// GetOrderHistory provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) GetOrderHistory(ctx context.Context, orderUUID string) ([]*model.StatusHistoryEntry, error) {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderHistory")
+	}
+
+	var r0 []*model.StatusHistoryEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*model.StatusHistoryEntry, error)); ok {
+		return returnFunc(ctx, orderUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*model.StatusHistoryEntry); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StatusHistoryEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_GetOrderHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderHistory'
+type MockOrderRepository_GetOrderHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *MockOrderRepository_Expecter) GetOrderHistory(ctx interface{}, orderUUID interface{}) *MockOrderRepository_GetOrderHistory_Call {
+	return &MockOrderRepository_GetOrderHistory_Call{Call: _e.mock.On("GetOrderHistory", ctx, orderUUID)}
+}
+
+func (_c *MockOrderRepository_GetOrderHistory_Call) Run(run func(ctx context.Context, orderUUID string)) *MockOrderRepository_GetOrderHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_GetOrderHistory_Call) Return(statusHistoryEntrys []*model.StatusHistoryEntry, err error) *MockOrderRepository_GetOrderHistory_Call {
+	_c.Call.Return(statusHistoryEntrys, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_GetOrderHistory_Call) RunAndReturn(run func(ctx context.Context, orderUUID string) ([]*model.StatusHistoryEntry, error)) *MockOrderRepository_GetOrderHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) ListOrders(ctx context.Context, filter model.OrderFilter, cursor *model.OrderCursor, limit int) ([]*model.Order, error) {
+	ret := _mock.Called(ctx, filter, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []*model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderFilter, *model.OrderCursor, int) ([]*model.Order, error)); ok {
+		return returnFunc(ctx, filter, cursor, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderFilter, *model.OrderCursor, int) []*model.Order); ok {
+		r0 = returnFunc(ctx, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.OrderFilter, *model.OrderCursor, int) error); ok {
+		r1 = returnFunc(ctx, filter, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type MockOrderRepository_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrderFilter
+//   - cursor *model.OrderCursor
+//   - limit int
+func (_e *MockOrderRepository_Expecter) ListOrders(ctx interface{}, filter interface{}, cursor interface{}, limit interface{}) *MockOrderRepository_ListOrders_Call {
+	return &MockOrderRepository_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter, cursor, limit)}
+}
+
+func (_c *MockOrderRepository_ListOrders_Call) Run(run func(ctx context.Context, filter model.OrderFilter, cursor *model.OrderCursor, limit int)) *MockOrderRepository_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderFilter)
+		}
+		var arg2 *model.OrderCursor
+		if args[2] != nil {
+			arg2 = args[2].(*model.OrderCursor)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_ListOrders_Call) Return(orders []*model.Order, err error) *MockOrderRepository_ListOrders_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_ListOrders_Call) RunAndReturn(run func(ctx context.Context, filter model.OrderFilter, cursor *model.OrderCursor, limit int) ([]*model.Order, error)) *MockOrderRepository_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateOrder provides a mock function for the type MockOrderRepository
-func (_mock *MockOrderRepository) UpdateOrder(ctx context.Context, order *model.Order) (*model.Order, error) {
-	ret := _mock.Called(ctx, order)
+func (_mock *MockOrderRepository) UpdateOrder(ctx context.Context, order *model.Order, change model.StatusChange) (*model.Order, error) {
+	ret := _mock.Called(ctx, order, change)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateOrder")
@@ -173,18 +472,18 @@ func (_mock *MockOrderRepository) UpdateOrder(ctx context.Context, order *model.
 
 	var r0 *model.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order) (*model.Order, error)); ok {
-		return returnFunc(ctx, order)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, model.StatusChange) (*model.Order, error)); ok {
+		return returnFunc(ctx, order, change)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order) *model.Order); ok {
-		r0 = returnFunc(ctx, order)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, model.StatusChange) *model.Order); ok {
+		r0 = returnFunc(ctx, order, change)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Order) error); ok {
-		r1 = returnFunc(ctx, order)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Order, model.StatusChange) error); ok {
+		r1 = returnFunc(ctx, order, change)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -199,11 +498,12 @@ type MockOrderRepository_UpdateOrder_Call struct {
 // UpdateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - order *model.Order
-func (_e *MockOrderRepository_Expecter) UpdateOrder(ctx interface{}, order interface{}) *MockOrderRepository_UpdateOrder_Call {
-	return &MockOrderRepository_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, order)}
+//   - change model.StatusChange
+func (_e *MockOrderRepository_Expecter) UpdateOrder(ctx interface{}, order interface{}, change interface{}) *MockOrderRepository_UpdateOrder_Call {
+	return &MockOrderRepository_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, order, change)}
 }
 
-func (_c *MockOrderRepository_UpdateOrder_Call) Run(run func(ctx context.Context, order *model.Order)) *MockOrderRepository_UpdateOrder_Call {
+func (_c *MockOrderRepository_UpdateOrder_Call) Run(run func(ctx context.Context, order *model.Order, change model.StatusChange)) *MockOrderRepository_UpdateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -213,9 +513,14 @@ func (_c *MockOrderRepository_UpdateOrder_Call) Run(run func(ctx context.Context
 		if args[1] != nil {
 			arg1 = args[1].(*model.Order)
 		}
+		var arg2 model.StatusChange
+		if args[2] != nil {
+			arg2 = args[2].(model.StatusChange)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -226,7 +531,87 @@ func (_c *MockOrderRepository_UpdateOrder_Call) Return(order1 *model.Order, err
 	return _c
 }
 
-func (_c *MockOrderRepository_UpdateOrder_Call) RunAndReturn(run func(ctx context.Context, order *model.Order) (*model.Order, error)) *MockOrderRepository_UpdateOrder_Call {
+func (_c *MockOrderRepository_UpdateOrder_Call) RunAndReturn(run func(ctx context.Context, order *model.Order, change model.StatusChange) (*model.Order, error)) *MockOrderRepository_UpdateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrderWithOutbox provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) UpdateOrderWithOutbox(ctx context.Context, order *model.Order, change model.StatusChange, message *model.OutboxMessage) (*model.Order, error) {
+	ret := _mock.Called(ctx, order, change, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderWithOutbox")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, model.StatusChange, *model.OutboxMessage) (*model.Order, error)); ok {
+		return returnFunc(ctx, order, change, message)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, model.StatusChange, *model.OutboxMessage) *model.Order); ok {
+		r0 = returnFunc(ctx, order, change, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Order, model.StatusChange, *model.OutboxMessage) error); ok {
+		r1 = returnFunc(ctx, order, change, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_UpdateOrderWithOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrderWithOutbox'
+type MockOrderRepository_UpdateOrderWithOutbox_Call struct {
+	*mock.Call
+}
+
+// UpdateOrderWithOutbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - change model.StatusChange
+//   - message *model.OutboxMessage
+func (_e *MockOrderRepository_Expecter) UpdateOrderWithOutbox(ctx interface{}, order interface{}, change interface{}, message interface{}) *MockOrderRepository_UpdateOrderWithOutbox_Call {
+	return &MockOrderRepository_UpdateOrderWithOutbox_Call{Call: _e.mock.On("UpdateOrderWithOutbox", ctx, order, change, message)}
+}
+
+func (_c *MockOrderRepository_UpdateOrderWithOutbox_Call) Run(run func(ctx context.Context, order *model.Order, change model.StatusChange, message *model.OutboxMessage)) *MockOrderRepository_UpdateOrderWithOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Order
+		if args[1] != nil {
+			arg1 = args[1].(*model.Order)
+		}
+		var arg2 model.StatusChange
+		if args[2] != nil {
+			arg2 = args[2].(model.StatusChange)
+		}
+		var arg3 *model.OutboxMessage
+		if args[3] != nil {
+			arg3 = args[3].(*model.OutboxMessage)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_UpdateOrderWithOutbox_Call) Return(order1 *model.Order, err error) *MockOrderRepository_UpdateOrderWithOutbox_Call {
+	_c.Call.Return(order1, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_UpdateOrderWithOutbox_Call) RunAndReturn(run func(ctx context.Context, order *model.Order, change model.StatusChange, message *model.OutboxMessage) (*model.Order, error)) *MockOrderRepository_UpdateOrderWithOutbox_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,111 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package repository
+
+import (
+	"context"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/repository"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOutboxRepository creates a new instance of MockOutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutboxRepository {
+	mock := &MockOutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOutboxRepository is an autogenerated mock type for the OutboxRepository type
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+type MockOutboxRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutboxRepository) EXPECT() *MockOutboxRepository_Expecter {
+	return &MockOutboxRepository_Expecter{mock: &_m.Mock}
+}
+
+// ProcessPending provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) ProcessPending(ctx context.Context, limit int, handler repository.OutboxHandler) (int, error) {
+	ret := _mock.Called(ctx, limit, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessPending")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, repository.OutboxHandler) (int, error)); ok {
+		return returnFunc(ctx, limit, handler)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, repository.OutboxHandler) int); ok {
+		r0 = returnFunc(ctx, limit, handler)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, repository.OutboxHandler) error); ok {
+		r1 = returnFunc(ctx, limit, handler)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRepository_ProcessPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessPending'
+type MockOutboxRepository_ProcessPending_Call struct {
+	*mock.Call
+}
+
+// ProcessPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - handler repository.OutboxHandler
+func (_e *MockOutboxRepository_Expecter) ProcessPending(ctx interface{}, limit interface{}, handler interface{}) *MockOutboxRepository_ProcessPending_Call {
+	return &MockOutboxRepository_ProcessPending_Call{Call: _e.mock.On("ProcessPending", ctx, limit, handler)}
+}
+
+func (_c *MockOutboxRepository_ProcessPending_Call) Run(run func(ctx context.Context, limit int, handler repository.OutboxHandler)) *MockOutboxRepository_ProcessPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 repository.OutboxHandler
+		if args[2] != nil {
+			arg2 = args[2].(repository.OutboxHandler)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_ProcessPending_Call) Return(n int, err error) *MockOutboxRepository_ProcessPending_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_ProcessPending_Call) RunAndReturn(run func(ctx context.Context, limit int, handler repository.OutboxHandler) (int, error)) *MockOutboxRepository_ProcessPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
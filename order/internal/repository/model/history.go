@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusHistoryEntry представляет запись таблицы order_status_history
+type StatusHistoryEntry struct {
+	UUID       uuid.UUID
+	OrderUUID  uuid.UUID
+	FromStatus *string
+	ToStatus   string
+	Actor      string
+	EventUUID  *uuid.UUID
+	CreatedAt  time.Time
+}
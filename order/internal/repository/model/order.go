@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -40,6 +42,9 @@ const (
 	StatusPaid
 	StatusAssembled
 	StatusCancelled
+	StatusRefunded
+	StatusPaymentProcessing
+	StatusRefunding
 )
 
 // String возвращает строковое представление Status
@@ -53,6 +58,12 @@ func (s Status) String() string {
 		return "ASSEMBLED"
 	case StatusCancelled:
 		return "CANCELLED"
+	case StatusRefunded:
+		return "REFUNDED"
+	case StatusPaymentProcessing:
+		return "PAYMENT_PROCESSING"
+	case StatusRefunding:
+		return "REFUNDING"
 	default:
 		return "UNSPECIFIED"
 	}
@@ -67,10 +78,15 @@ type Order struct {
 	TransactionUUID *uuid.UUID
 	PaymentMethod   *PaymentMethod
 	Status          Status
+	CreatedAt       time.Time
+	Version         int64
 }
 
 // OrderItem представляет позицию заказа в repository слое
 type OrderItem struct {
-	PartUUID uuid.UUID
-	Quantity int
+	PartUUID  uuid.UUID
+	Quantity  int
+	UnitPrice float64
+	PartName  string
+	Category  string
 }
@@ -15,6 +15,21 @@ import (
 
 // CreateOrder создает новый заказ
 func (r *Repository) CreateOrder(ctx context.Context, order *model.Order) error {
+	return r.createOrder(ctx, order, nil)
+}
+
+// CreateOrderWithOutbox создает новый заказ и сохраняет событие в outbox в одной транзакции
+func (r *Repository) CreateOrderWithOutbox(ctx context.Context, order *model.Order, message *model.OutboxMessage) error {
+	if message == nil {
+		return model.NewInvalidOrderDataError("outbox message is nil")
+	}
+
+	return r.createOrder(ctx, order, message)
+}
+
+// createOrder создает заказ с позициями, записывает начальный статус в историю
+// и, если передано, добавляет событие в outbox
+func (r *Repository) createOrder(ctx context.Context, order *model.Order, message *model.OutboxMessage) error {
 	repoOrder := converter.ToRepoOrder(order)
 
 	var paymentMethodStr *string
@@ -25,7 +40,7 @@ func (r *Repository) CreateOrder(ctx context.Context, order *model.Order) error
 	}
 
 	builder := sq.Insert("orders").
-		Columns("uuid", "user_uuid", "total_price", "transaction_uuid", "payment_method", "status", "updated_at").
+		Columns("uuid", "user_uuid", "total_price", "transaction_uuid", "payment_method", "status", "updated_at", "version").
 		Values(
 			repoOrder.OrderUUID,
 			repoOrder.UserUUID,
@@ -34,6 +49,7 @@ func (r *Repository) CreateOrder(ctx context.Context, order *model.Order) error
 			paymentMethodStr,
 			repoOrder.Status.String(),
 			time.Now(),
+			1,
 		).PlaceholderFormat(sq.Dollar)
 
 	sql, args, err := builder.ToSql()
@@ -61,11 +77,11 @@ func (r *Repository) CreateOrder(ctx context.Context, order *model.Order) error
 
 	if len(repoOrder.Items) > 0 {
 		itemsInsert := sq.Insert("order_items").
-			Columns("order_uuid", "part_uuid", "quantity").
+			Columns("order_uuid", "part_uuid", "quantity", "unit_price", "part_name", "category").
 			PlaceholderFormat(sq.Dollar)
 
 		for _, it := range repoOrder.Items {
-			itemsInsert = itemsInsert.Values(repoOrder.OrderUUID, it.PartUUID, it.Quantity)
+			itemsInsert = itemsInsert.Values(repoOrder.OrderUUID, it.PartUUID, it.Quantity, it.UnitPrice, it.PartName, it.Category)
 		}
 
 		itemSQL, itemArgs, buildErr := itemsInsert.ToSql()
@@ -78,6 +94,19 @@ func (r *Repository) CreateOrder(ctx context.Context, order *model.Order) error
 		}
 	}
 
+	entry := newStatusHistoryEntry(repoOrder.OrderUUID, nil, repoOrder.Status.String(), model.StatusChange{
+		Actor: repoOrder.UserUUID.String(),
+	})
+	if err = r.insertStatusHistory(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	if message != nil {
+		if err = r.insertOutboxMessage(ctx, tx, converter.ToRepoOutboxMessage(message)); err != nil {
+			return err
+		}
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit tx: %w", err)
 	}
@@ -0,0 +1,154 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/order/internal/repository"
+	"github.com/radiophysiker/microservices-homework/order/internal/repository/converter"
+	repoModel "github.com/radiophysiker/microservices-homework/order/internal/repository/model"
+)
+
+// CancelExpiredOrders блокирует до limit неоплаченных заказов, созданных раньше createdBefore,
+// и в одной транзакции сохраняет их новый статус, историю и событие из handler.
+// Строки выбираются через FOR UPDATE SKIP LOCKED, поэтому несколько реплик order service
+// не обрабатывают один заказ одновременно, а заказ, который прямо сейчас оплачивается,
+// пропускается до следующего запуска
+func (r *Repository) CancelExpiredOrders(
+	ctx context.Context,
+	createdBefore time.Time,
+	limit int,
+	change model.StatusChange,
+	handler repository.ExpiredOrderHandler,
+) ([]*model.Order, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer r.rollbackTx(ctx, tx)
+
+	repoOrders, err := r.lockExpired(ctx, tx, createdBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled := make([]*model.Order, 0, len(repoOrders))
+
+	for _, repoOrder := range repoOrders {
+		order := converter.ToServiceOrder(repoOrder)
+
+		message, err := handler(order)
+		if errors.Is(err, repository.ErrSkipExpiredOrder) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to handle expired order %s: %w", order.OrderUUID, err)
+		}
+
+		if err := r.saveExpiredOrder(ctx, tx, repoOrder.Status.String(), order, change, message); err != nil {
+			return nil, err
+		}
+
+		order.Version++
+		cancelled = append(cancelled, order)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return cancelled, nil
+}
+
+// lockExpired выбирает и блокирует неоплаченные заказы, созданные раньше createdBefore
+func (r *Repository) lockExpired(ctx context.Context, tx pgx.Tx, createdBefore time.Time, limit int) ([]*repoModel.Order, error) {
+	query, args, err := sq.
+		Select("uuid", "user_uuid", "total_price", "created_at", "version").
+		From("orders").
+		Where(sq.Eq{"status": repoModel.StatusPendingPayment.String()}).
+		Where(sq.Lt{"created_at": createdBefore}).
+		OrderBy("created_at").
+		Limit(uint64(limit)). //nolint:gosec // limit задается конфигурацией и всегда положителен
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select expired orders query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select expired orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := make([]*repoModel.Order, 0, limit)
+
+	for rows.Next() {
+		order := repoModel.Order{Status: repoModel.StatusPendingPayment}
+		if err := rows.Scan(
+			&order.OrderUUID,
+			&order.UserUUID,
+			&order.TotalPrice,
+			&order.CreatedAt,
+			&order.Version,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan expired order: %w", err)
+		}
+
+		orders = append(orders, &order)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate expired orders: %w", rows.Err())
+	}
+
+	return orders, nil
+}
+
+// saveExpiredOrder сохраняет новый статус заблокированного заказа, запись истории и событие
+func (r *Repository) saveExpiredOrder(
+	ctx context.Context,
+	tx pgx.Tx,
+	prevStatus string,
+	order *model.Order,
+	change model.StatusChange,
+	message *model.OutboxMessage,
+) error {
+	newStatus := converter.ToRepoOrder(order).Status.String()
+
+	query, args, err := sq.Update("orders").
+		Set("status", newStatus).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"uuid": order.OrderUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update expired order query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update expired order: %w", err)
+	}
+
+	entry := newStatusHistoryEntry(order.OrderUUID, &prevStatus, newStatus, change)
+	if err := r.insertStatusHistory(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	if message != nil {
+		if err := r.insertOutboxMessage(ctx, tx, converter.ToRepoOutboxMessage(message)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
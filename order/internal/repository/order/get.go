@@ -35,6 +35,8 @@ func (r *Repository) GetOrder(ctx context.Context, orderUUID string) (*model.Ord
 			"transaction_uuid",
 			"payment_method",
 			"status",
+			"created_at",
+			"version",
 		).
 		From("orders").
 		Where(sq.Eq{"uuid": orderUUID}).
@@ -52,6 +54,8 @@ func (r *Repository) GetOrder(ctx context.Context, orderUUID string) (*model.Ord
 		&repoOrder.TransactionUUID,
 		&paymentMethodStr,
 		&statusStr,
+		&repoOrder.CreatedAt,
+		&repoOrder.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -67,7 +71,7 @@ func (r *Repository) GetOrder(ctx context.Context, orderUUID string) (*model.Ord
 
 	repoOrder.Status = converter.StringToOrderStatus(statusStr)
 
-	itemsSQL, itemsArgs, buildItemsErr := sq.Select("part_uuid", "quantity").
+	itemsSQL, itemsArgs, buildItemsErr := sq.Select("part_uuid", "quantity", "unit_price", "part_name", "category").
 		From("order_items").
 		Where(sq.Eq{"order_uuid": repoOrder.OrderUUID}).
 		PlaceholderFormat(sq.Dollar).
@@ -84,7 +88,7 @@ func (r *Repository) GetOrder(ctx context.Context, orderUUID string) (*model.Ord
 
 	for rows.Next() {
 		var it repoModel.OrderItem
-		if err = rows.Scan(&it.PartUUID, &it.Quantity); err != nil {
+		if err = rows.Scan(&it.PartUUID, &it.Quantity, &it.UnitPrice, &it.PartName, &it.Category); err != nil {
 			return nil, fmt.Errorf("failed to scan order item: %w", err)
 		}
 
@@ -0,0 +1,96 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/order/internal/repository/converter"
+	repoModel "github.com/radiophysiker/microservices-homework/order/internal/repository/model"
+)
+
+// GetOrderHistory возвращает историю статусов заказа в хронологическом порядке
+func (r *Repository) GetOrderHistory(ctx context.Context, orderUUID string) ([]*model.StatusHistoryEntry, error) {
+	if _, err := uuid.Parse(orderUUID); err != nil {
+		return nil, model.NewInvalidOrderDataError(orderUUID)
+	}
+
+	sql, args, err := sq.
+		Select("uuid", "order_uuid", "from_status", "to_status", "actor", "event_uuid", "created_at").
+		From("order_status_history").
+		Where(sq.Eq{"order_uuid": orderUUID}).
+		OrderBy("created_at", "uuid").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get order history query: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*model.StatusHistoryEntry, 0)
+
+	for rows.Next() {
+		var entry repoModel.StatusHistoryEntry
+
+		if err = rows.Scan(
+			&entry.UUID,
+			&entry.OrderUUID,
+			&entry.FromStatus,
+			&entry.ToStatus,
+			&entry.Actor,
+			&entry.EventUUID,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan order history entry: %w", err)
+		}
+
+		entries = append(entries, converter.ToServiceStatusHistoryEntry(&entry))
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate order history: %w", rows.Err())
+	}
+
+	return entries, nil
+}
+
+// insertStatusHistory добавляет запись о смене статуса заказа в рамках транзакции
+func (r *Repository) insertStatusHistory(ctx context.Context, tx pgx.Tx, entry *repoModel.StatusHistoryEntry) error {
+	query, args, err := sq.Insert("order_status_history").
+		Columns("uuid", "order_uuid", "from_status", "to_status", "actor", "event_uuid", "created_at").
+		Values(entry.UUID, entry.OrderUUID, entry.FromStatus, entry.ToStatus, entry.Actor, entry.EventUUID, entry.CreatedAt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert order status history query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert order status history: %w", err)
+	}
+
+	return nil
+}
+
+// newStatusHistoryEntry формирует запись истории для перехода fromStatus -> toStatus
+func newStatusHistoryEntry(orderUUID uuid.UUID, fromStatus *string, toStatus string, change model.StatusChange) *repoModel.StatusHistoryEntry {
+	return &repoModel.StatusHistoryEntry{
+		UUID:       uuid.New(),
+		OrderUUID:  orderUUID,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		Actor:      change.Actor,
+		EventUUID:  change.EventUUID,
+		CreatedAt:  time.Now(),
+	}
+}
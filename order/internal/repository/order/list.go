@@ -0,0 +1,171 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/order/internal/repository/converter"
+	repoModel "github.com/radiophysiker/microservices-homework/order/internal/repository/model"
+)
+
+// ListOrders возвращает до limit заказов, подходящих под фильтр, начиная после курсора.
+// Заказы упорядочены по убыванию (created_at, uuid)
+func (r *Repository) ListOrders(ctx context.Context, filter model.OrderFilter, cursor *model.OrderCursor, limit int) ([]*model.Order, error) {
+	builder := sq.
+		Select(
+			"uuid",
+			"user_uuid",
+			"total_price",
+			"transaction_uuid",
+			"payment_method",
+			"status",
+			"created_at",
+			"version",
+		).
+		From("orders").
+		OrderBy("created_at DESC", "uuid DESC").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar)
+
+	if !filter.AllUsers {
+		builder = builder.Where(sq.Eq{"user_uuid": filter.UserUUID})
+	}
+
+	if len(filter.OrderUUIDs) > 0 {
+		builder = builder.Where(sq.Eq{"uuid": filter.OrderUUIDs})
+	}
+
+	if len(filter.Statuses) > 0 {
+		builder = builder.Where(sq.Eq{"status": converter.ToRepoStatusStrings(filter.Statuses)})
+	}
+
+	if filter.CreatedFrom != nil {
+		builder = builder.Where(sq.GtOrEq{"created_at": *filter.CreatedFrom})
+	}
+
+	if filter.CreatedTo != nil {
+		builder = builder.Where(sq.Lt{"created_at": *filter.CreatedTo})
+	}
+
+	if filter.UpdatedFrom != nil {
+		builder = builder.Where(sq.GtOrEq{"updated_at": *filter.UpdatedFrom})
+	}
+
+	if filter.UpdatedTo != nil {
+		builder = builder.Where(sq.Lt{"updated_at": *filter.UpdatedTo})
+	}
+
+	if cursor != nil {
+		builder = builder.Where(sq.Expr("(created_at, uuid) < (?, ?)", cursor.CreatedAt, cursor.OrderUUID))
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build list orders query: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		repoOrders []*repoModel.Order
+		orderUUIDs []uuid.UUID
+	)
+
+	for rows.Next() {
+		var (
+			repoOrder        repoModel.Order
+			paymentMethodStr *string
+			statusStr        string
+		)
+
+		if err = rows.Scan(
+			&repoOrder.OrderUUID,
+			&repoOrder.UserUUID,
+			&repoOrder.TotalPrice,
+			&repoOrder.TransactionUUID,
+			&paymentMethodStr,
+			&statusStr,
+			&repoOrder.CreatedAt,
+			&repoOrder.Version,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan order: %w", err)
+		}
+
+		if paymentMethodStr != nil {
+			repoOrder.PaymentMethod = converter.StringToPaymentMethod(*paymentMethodStr)
+		}
+
+		repoOrder.Status = converter.StringToOrderStatus(statusStr)
+
+		repoOrders = append(repoOrders, &repoOrder)
+		orderUUIDs = append(orderUUIDs, repoOrder.OrderUUID)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate orders: %w", rows.Err())
+	}
+
+	if len(repoOrders) == 0 {
+		return []*model.Order{}, nil
+	}
+
+	items, err := r.listOrderItems(ctx, orderUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*model.Order, 0, len(repoOrders))
+	for _, repoOrder := range repoOrders {
+		repoOrder.Items = items[repoOrder.OrderUUID]
+		orders = append(orders, converter.ToServiceOrder(repoOrder))
+	}
+
+	return orders, nil
+}
+
+// listOrderItems загружает позиции сразу для нескольких заказов
+func (r *Repository) listOrderItems(ctx context.Context, orderUUIDs []uuid.UUID) (map[uuid.UUID][]repoModel.OrderItem, error) {
+	sql, args, err := sq.Select("order_uuid", "part_uuid", "quantity", "unit_price", "part_name", "category").
+		From("order_items").
+		Where(sq.Eq{"order_uuid": orderUUIDs}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build list order items query: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list order items: %w", err)
+	}
+	defer rows.Close()
+
+	items := make(map[uuid.UUID][]repoModel.OrderItem, len(orderUUIDs))
+
+	for rows.Next() {
+		var (
+			orderUUID uuid.UUID
+			it        repoModel.OrderItem
+		)
+
+		if err = rows.Scan(&orderUUID, &it.PartUUID, &it.Quantity, &it.UnitPrice, &it.PartName, &it.Category); err != nil {
+			return nil, fmt.Errorf("failed to scan order item: %w", err)
+		}
+
+		items[orderUUID] = append(items[orderUUID], it)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate order items: %w", rows.Err())
+	}
+
+	return items, nil
+}
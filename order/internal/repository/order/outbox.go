@@ -0,0 +1,43 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	repoModel "github.com/radiophysiker/microservices-homework/order/internal/repository/model"
+)
+
+// insertOutboxMessage сохраняет событие в таблицу outbox в рамках транзакции заказа
+func (r *Repository) insertOutboxMessage(ctx context.Context, tx pgx.Tx, message *repoModel.OutboxMessage) error {
+	createdAt := message.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	query, args, err := sq.Insert("outbox").
+		Columns("uuid", "aggregate_uuid", "event_type", "event_key", "payload", "trace_context", "created_at").
+		Values(
+			message.UUID,
+			message.AggregateUUID,
+			message.EventType,
+			message.Key,
+			message.Payload,
+			message.TraceContext,
+			createdAt,
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert outbox message query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert outbox message: %w", err)
+	}
+
+	return nil
+}
@@ -17,8 +17,24 @@ import (
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
 )
 
-// UpdateOrder обновляет заказ и возвращает актуальное состояние
-func (r *Repository) UpdateOrder(ctx context.Context, order *model.Order) (*model.Order, error) {
+// UpdateOrder обновляет заказ и возвращает актуальное состояние.
+// Смена статуса фиксируется в истории с указанным инициатором
+func (r *Repository) UpdateOrder(ctx context.Context, order *model.Order, change model.StatusChange) (*model.Order, error) {
+	return r.updateOrder(ctx, order, change, nil)
+}
+
+// UpdateOrderWithOutbox обновляет заказ и сохраняет событие в outbox в одной транзакции
+func (r *Repository) UpdateOrderWithOutbox(ctx context.Context, order *model.Order, change model.StatusChange, message *model.OutboxMessage) (*model.Order, error) {
+	if message == nil {
+		return nil, model.NewInvalidOrderDataError("outbox message is nil")
+	}
+
+	return r.updateOrder(ctx, order, change, message)
+}
+
+// updateOrder обновляет заказ, записывает смену статуса в историю
+// и, если передано, добавляет событие в outbox
+func (r *Repository) updateOrder(ctx context.Context, order *model.Order, change model.StatusChange, message *model.OutboxMessage) (*model.Order, error) {
 	repoOrder := converter.ToRepoOrder(order)
 
 	tx, err := r.pool.Begin(ctx)
@@ -27,6 +43,19 @@ func (r *Repository) UpdateOrder(ctx context.Context, order *model.Order) (*mode
 	}
 	defer r.rollbackTx(ctx, tx)
 
+	prevStatus, version, err := r.lockOrder(ctx, tx, repoOrder.OrderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != repoOrder.Version {
+		return nil, &model.OrderVersionConflictError{
+			OrderUUID:       repoOrder.OrderUUID.String(),
+			ExpectedVersion: repoOrder.Version,
+			ActualVersion:   version,
+		}
+	}
+
 	if err := r.updateOrderTable(ctx, tx, repoOrder); err != nil {
 		return nil, err
 	}
@@ -35,6 +64,19 @@ func (r *Repository) UpdateOrder(ctx context.Context, order *model.Order) (*mode
 		return nil, err
 	}
 
+	if newStatus := repoOrder.Status.String(); newStatus != prevStatus {
+		entry := newStatusHistoryEntry(repoOrder.OrderUUID, &prevStatus, newStatus, change)
+		if err := r.insertStatusHistory(ctx, tx, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	if message != nil {
+		if err := r.insertOutboxMessage(ctx, tx, converter.ToRepoOutboxMessage(message)); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -47,6 +89,34 @@ func (r *Repository) UpdateOrder(ctx context.Context, order *model.Order) (*mode
 	return updated, nil
 }
 
+// lockOrder блокирует строку заказа до конца транзакции и возвращает текущие статус и версию
+func (r *Repository) lockOrder(ctx context.Context, tx pgx.Tx, orderUUID uuid.UUID) (string, int64, error) {
+	query, args, err := sq.Select("status", "version").
+		From("orders").
+		Where(sq.Eq{"uuid": orderUUID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to build lock order query: %w", err)
+	}
+
+	var (
+		status  string
+		version int64
+	)
+
+	if err = tx.QueryRow(ctx, query, args...).Scan(&status, &version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", 0, model.ErrOrderNotFound
+		}
+
+		return "", 0, fmt.Errorf("failed to lock order: %w", err)
+	}
+
+	return status, version, nil
+}
+
 // updateOrderTable обновляет данные в таблице orders
 func (r *Repository) updateOrderTable(ctx context.Context, tx pgx.Tx, repoOrder *repoModel.Order) error {
 	var paymentMethodStr *string
@@ -63,7 +133,8 @@ func (r *Repository) updateOrderTable(ctx context.Context, tx pgx.Tx, repoOrder
 		Set("payment_method", paymentMethodStr).
 		Set("status", repoOrder.Status.String()).
 		Set("updated_at", time.Now()).
-		Where(sq.Eq{"uuid": repoOrder.OrderUUID}).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"uuid": repoOrder.OrderUUID, "version": repoOrder.Version}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -91,7 +162,7 @@ func (r *Repository) upsertOrderItems(ctx context.Context, tx pgx.Tx, repoOrder
 
 	// 1. UPSERT существующих и новых items
 	builder := sq.Insert("order_items").
-		Columns("order_uuid", "part_uuid", "quantity").
+		Columns("order_uuid", "part_uuid", "quantity", "unit_price", "part_name", "category").
 		PlaceholderFormat(sq.Dollar).
 		Suffix("ON CONFLICT (order_uuid, part_uuid) DO UPDATE SET quantity = EXCLUDED.quantity")
 
@@ -102,6 +173,9 @@ func (r *Repository) upsertOrderItems(ctx context.Context, tx pgx.Tx, repoOrder
 			repoOrder.OrderUUID,
 			item.PartUUID,
 			item.Quantity,
+			item.UnitPrice,
+			item.PartName,
+			item.Category,
 		)
 
 		partUUIDs = append(partUUIDs, item.PartUUID)
@@ -9,6 +9,8 @@ import (
 
 // TestUpdateOrder проверяет обновление заказа
 func (s *RepositoryTestSuite) TestUpdateOrder() {
+	change := model.StatusChange{Actor: s.testUserUUID.String()}
+
 	tests := []struct {
 		name      string
 		order     *model.Order
@@ -89,12 +91,12 @@ func (s *RepositoryTestSuite) TestUpdateOrder() {
 			}
 
 			if tt.errType != nil {
-				s.repo.On("UpdateOrder", s.ctx, tt.order).Return((*model.Order)(nil), tt.errType).Once()
+				s.repo.On("UpdateOrder", s.ctx, tt.order, change).Return((*model.Order)(nil), tt.errType).Once()
 			} else {
-				s.repo.On("UpdateOrder", s.ctx, tt.order).Return(tt.order, nil).Once()
+				s.repo.On("UpdateOrder", s.ctx, tt.order, change).Return(tt.order, nil).Once()
 			}
 
-			updated, err := s.repo.UpdateOrder(s.ctx, tt.order)
+			updated, err := s.repo.UpdateOrder(s.ctx, tt.order, change)
 
 			if tt.errType != nil {
 				require.Error(s.T(), err)
@@ -0,0 +1,135 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/repository"
+	"github.com/radiophysiker/microservices-homework/order/internal/repository/converter"
+	repoModel "github.com/radiophysiker/microservices-homework/order/internal/repository/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// ProcessPending блокирует до limit неотправленных событий, передает их в handler
+// и помечает успешно обработанные как отправленные.
+// Строки выбираются через FOR UPDATE SKIP LOCKED, поэтому несколько реплик
+// order service не публикуют одно и то же событие одновременно.
+// Обработка останавливается на первой ошибке, чтобы сохранить порядок событий.
+func (r *Repository) ProcessPending(ctx context.Context, limit int, handler repository.OutboxHandler) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer r.rollbackTx(ctx, tx)
+
+	messages, err := r.lockPending(ctx, tx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := make([]uuid.UUID, 0, len(messages))
+
+	var handlerErr error
+
+	for _, message := range messages {
+		if handlerErr = handler(ctx, converter.ToServiceOutboxMessage(message)); handlerErr != nil {
+			handlerErr = fmt.Errorf("failed to handle outbox message %s: %w", message.UUID, handlerErr)
+			break
+		}
+
+		sent = append(sent, message.UUID)
+	}
+
+	if err := r.markSent(ctx, tx, sent); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(sent), handlerErr
+}
+
+// lockPending выбирает и блокирует неотправленные события в порядке их создания
+func (r *Repository) lockPending(ctx context.Context, tx pgx.Tx, limit int) ([]*repoModel.OutboxMessage, error) {
+	query, args, err := sq.
+		Select("uuid", "aggregate_uuid", "event_type", "event_key", "payload", "trace_context", "created_at").
+		From("outbox").
+		Where(sq.Eq{"sent_at": nil}).
+		OrderBy("created_at").
+		Limit(uint64(limit)). //nolint:gosec // limit задается конфигурацией и всегда положителен
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select pending outbox query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select pending outbox messages: %w", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*repoModel.OutboxMessage, 0, limit)
+
+	for rows.Next() {
+		var message repoModel.OutboxMessage
+		if err := rows.Scan(
+			&message.UUID,
+			&message.AggregateUUID,
+			&message.EventType,
+			&message.Key,
+			&message.Payload,
+			&message.TraceContext,
+			&message.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox message: %w", err)
+		}
+
+		messages = append(messages, &message)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate outbox messages: %w", rows.Err())
+	}
+
+	return messages, nil
+}
+
+// markSent помечает события как отправленные
+func (r *Repository) markSent(ctx context.Context, tx pgx.Tx, uuids []uuid.UUID) error {
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	query, args, err := sq.Update("outbox").
+		Set("sent_at", time.Now()).
+		Where(sq.Eq{"uuid": uuids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build mark outbox sent query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to mark outbox messages as sent: %w", err)
+	}
+
+	return nil
+}
+
+// rollbackTx откатывает транзакцию, если она не была закоммичена
+func (r *Repository) rollbackTx(ctx context.Context, tx pgx.Tx) {
+	if err := tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+		logger.Error(ctx, "failed to rollback transaction", zap.Error(err))
+	}
+}
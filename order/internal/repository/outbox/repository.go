@@ -0,0 +1,17 @@
+package outbox
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Repository реализует интерфейс OutboxRepository
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRepository создает новый экземпляр Repository
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		pool: pool,
+	}
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/radiophysiker/microservices-homework/order/internal/model"
 )
@@ -10,8 +12,51 @@ import (
 type OrderRepository interface {
 	// CreateOrder создает новый заказ
 	CreateOrder(ctx context.Context, order *model.Order) error
+	// CreateOrderWithOutbox создает новый заказ и сохраняет событие в outbox в одной транзакции
+	CreateOrderWithOutbox(ctx context.Context, order *model.Order, message *model.OutboxMessage) error
 	// GetOrder возвращает заказ по UUID
 	GetOrder(ctx context.Context, orderUUID string) (*model.Order, error)
-	// UpdateOrder обновляет заказ и возвращает актуальное состояние
-	UpdateOrder(ctx context.Context, order *model.Order) (*model.Order, error)
+	// ListOrders возвращает до limit заказов по фильтру, начиная после курсора
+	ListOrders(ctx context.Context, filter model.OrderFilter, cursor *model.OrderCursor, limit int) ([]*model.Order, error)
+	// UpdateOrder обновляет заказ и возвращает актуальное состояние.
+	// Смена статуса записывается в историю с инициатором из change.
+	// Если версия заказа изменилась с момента чтения, возвращает OrderVersionConflictError
+	UpdateOrder(ctx context.Context, order *model.Order, change model.StatusChange) (*model.Order, error)
+	// UpdateOrderWithOutbox обновляет заказ и сохраняет событие в outbox в одной транзакции
+	UpdateOrderWithOutbox(ctx context.Context, order *model.Order, change model.StatusChange, message *model.OutboxMessage) (*model.Order, error)
+	// GetOrderHistory возвращает историю статусов заказа в хронологическом порядке
+	GetOrderHistory(ctx context.Context, orderUUID string) ([]*model.StatusHistoryEntry, error)
+	// CancelExpiredOrders блокирует до limit неоплаченных заказов, созданных раньше createdBefore,
+	// передает каждый в handler и сохраняет результат вместе с событием в outbox.
+	// Возвращает отмененные заказы, пропущенные handler заказы в результат не попадают
+	CancelExpiredOrders(ctx context.Context, createdBefore time.Time, limit int, change model.StatusChange, handler ExpiredOrderHandler) ([]*model.Order, error)
+}
+
+// ExpiredOrderHandler переводит просроченный заказ в новый статус и возвращает событие для outbox.
+// Ошибка ErrSkipExpiredOrder оставляет заказ без изменений до следующего запуска
+type ExpiredOrderHandler func(order *model.Order) (*model.OutboxMessage, error)
+
+// ErrSkipExpiredOrder сообщает CancelExpiredOrders, что заказ нужно пропустить, не прерывая остальные
+var ErrSkipExpiredOrder = errors.New("expired order skipped")
+
+// OutboxHandler обрабатывает одно событие из outbox
+type OutboxHandler func(ctx context.Context, message *model.OutboxMessage) error
+
+// OutboxRepository представляет интерфейс для работы с outbox в repository слое
+type OutboxRepository interface {
+	// ProcessPending блокирует до limit неотправленных событий, передает их в handler
+	// и помечает успешно обработанные как отправленные. Возвращает число отправленных событий
+	ProcessPending(ctx context.Context, limit int, handler OutboxHandler) (int, error)
+}
+
+// IdempotencyRepository представляет интерфейс для хранения результатов идемпотентных запросов
+type IdempotencyRepository interface {
+	// Reserve резервирует ключ за запросом. Записи, созданные раньше expiredBefore, перезаписываются.
+	// Возвращает nil, если ключ зарезервирован, иначе существующую запись.
+	// Если запись исчезает быстрее, чем ее удается прочитать, возвращает ErrIdempotencyRequestInProgress
+	Reserve(ctx context.Context, record *model.IdempotencyRecord, expiredBefore time.Time) (*model.IdempotencyRecord, error)
+	// Complete сохраняет ответ на запрос
+	Complete(ctx context.Context, key model.IdempotencyKey, response []byte) error
+	// Release снимает резерв с ключа, чтобы запрос можно было повторить
+	Release(ctx context.Context, key model.IdempotencyKey) error
 }
@@ -2,6 +2,7 @@ package order_consumer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"go.uber.org/zap"
@@ -11,6 +12,9 @@ import (
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
 )
 
+// maxConflictAttempts число попыток обновить заказ при конфликте версий
+const maxConflictAttempts = 3
+
 func (s *Service) ShipAssembledHandler(ctx context.Context, msg kafka.Message) error {
 	event, err := s.orderAssembledDecoder.Decode(msg.Value)
 	if err != nil {
@@ -34,32 +38,74 @@ func (s *Service) ShipAssembledHandler(ctx context.Context, msg kafka.Message) e
 		zap.Int64("build_time_sec", event.BuildTimeSec),
 	)
 
+	for attempt := 1; ; attempt++ {
+		updated, err := s.markAssembled(ctx, event)
+		if errors.Is(err, model.ErrOrderVersionConflict) && attempt < maxConflictAttempts {
+			logger.Info(ctx, "Order version conflict, retrying",
+				zap.String("order_uuid", event.OrderUUID.String()),
+				zap.Int("attempt", attempt),
+			)
+
+			continue
+		}
+
+		if err != nil {
+			logger.Error(ctx, "Failed to update order status to ASSEMBLED",
+				zap.Error(err),
+				zap.String("order_uuid", event.OrderUUID.String()),
+			)
+
+			return err
+		}
+
+		if updated != nil {
+			logger.Info(ctx, "Order status updated to ASSEMBLED",
+				zap.String("order_uuid", updated.OrderUUID.String()),
+				zap.String("event_uuid", event.EventUUID.String()),
+			)
+		}
+
+		return nil
+	}
+}
+
+// markAssembled переводит заказ в статус ASSEMBLED. Возвращает nil без ошибки,
+// если событие пропущено: заказ уже собран или переход недопустим
+func (s *Service) markAssembled(ctx context.Context, event *model.ShipAssembled) (*model.Order, error) {
 	order, err := s.orderRepository.GetOrder(ctx, event.OrderUUID.String())
 	if err != nil {
-		logger.Error(ctx, "Failed to get order",
-			zap.Error(err),
-			zap.String("order_uuid", event.OrderUUID.String()),
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// Повторная доставка события для уже собранного заказа ничего не меняет
+	if order.Status == model.StatusAssembled {
+		logger.Info(ctx, "Order already ASSEMBLED, skipping event",
+			zap.String("order_uuid", order.OrderUUID.String()),
+			zap.String("event_uuid", event.EventUUID.String()),
 		)
 
-		return fmt.Errorf("failed to get order: %w", err)
+		return nil, nil
 	}
 
-	order.Status = model.StatusAssembled
-
-	updated, err := s.orderRepository.UpdateOrder(ctx, order)
-	if err != nil {
-		logger.Error(ctx, "Failed to update order status to ASSEMBLED",
+	// Недопустимый переход не исправится повторной обработкой, поэтому событие
+	// пропускается с предупреждением, а не возвращается как ошибка
+	if err = order.TransitionTo(model.StatusAssembled); err != nil {
+		logger.Warn(ctx, "Rejected ShipAssembled event",
 			zap.Error(err),
-			zap.String("order_uuid", event.OrderUUID.String()),
+			zap.String("order_uuid", order.OrderUUID.String()),
+			zap.String("event_uuid", event.EventUUID.String()),
 		)
 
-		return fmt.Errorf("failed to update order status: %w", err)
+		return nil, nil
 	}
 
-	logger.Info(ctx, "Order status updated to ASSEMBLED",
-		zap.String("order_uuid", updated.OrderUUID.String()),
-		zap.String("event_uuid", event.EventUUID.String()),
-	)
+	updated, err := s.orderRepository.UpdateOrder(ctx, order, model.StatusChange{
+		Actor:     model.ActorShipAssembledConsumer,
+		EventUUID: &event.EventUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update order status: %w", err)
+	}
 
-	return nil
+	return updated, nil
 }
@@ -0,0 +1,53 @@
+package payment_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/converter/kafka/decoder"
+	"github.com/radiophysiker/microservices-homework/order/internal/service"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// Service обрабатывает события с результатом асинхронной оплаты.
+// Оба топика читаются одной consumer group, обработчик выбирается по топику сообщения
+type Service struct {
+	paymentConsumer         kafka.Consumer
+	completedTopic          string
+	failedTopic             string
+	paymentCompletedDecoder *decoder.PaymentCompletedDecoder
+	paymentFailedDecoder    *decoder.PaymentFailedDecoder
+	orderService            service.OrderService
+}
+
+func NewService(
+	paymentConsumer kafka.Consumer,
+	completedTopic string,
+	failedTopic string,
+	paymentCompletedDecoder *decoder.PaymentCompletedDecoder,
+	paymentFailedDecoder *decoder.PaymentFailedDecoder,
+	orderService service.OrderService,
+) *Service {
+	return &Service{
+		paymentConsumer:         paymentConsumer,
+		completedTopic:          completedTopic,
+		failedTopic:             failedTopic,
+		paymentCompletedDecoder: paymentCompletedDecoder,
+		paymentFailedDecoder:    paymentFailedDecoder,
+		orderService:            orderService,
+	}
+}
+
+func (s *Service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting payment result consumer service")
+
+	err := s.paymentConsumer.Consume(ctx, s.PaymentHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from payment topics error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package payment_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// PaymentHandler передает событие PaymentCompleted или PaymentFailed в сервис заказов
+func (s *Service) PaymentHandler(ctx context.Context, msg kafka.Message) error {
+	switch msg.Topic {
+	case s.completedTopic:
+		return s.handlePaymentCompleted(ctx, msg)
+	case s.failedTopic:
+		return s.handlePaymentFailed(ctx, msg)
+	default:
+		logger.Warn(ctx, "Message from unexpected topic, skipping",
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+		)
+
+		return nil
+	}
+}
+
+func (s *Service) handlePaymentCompleted(ctx context.Context, msg kafka.Message) error {
+	event, err := s.paymentCompletedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode PaymentCompleted event",
+			zap.Error(err),
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+		)
+
+		return err
+	}
+
+	logger.Info(ctx, "PaymentCompleted message received",
+		zap.String("topic", msg.Topic),
+		zap.Int32("partition", msg.Partition),
+		zap.Int64("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID.String()),
+		zap.String("order_uuid", event.OrderUUID.String()),
+		zap.String("transaction_uuid", event.TransactionUUID.String()),
+	)
+
+	updated, err := s.orderService.CompletePayment(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to update order status to PAID",
+			zap.Error(err),
+			zap.String("order_uuid", event.OrderUUID.String()),
+		)
+
+		return err
+	}
+
+	if updated != nil {
+		logger.Info(ctx, "Order status updated to PAID",
+			zap.String("order_uuid", updated.OrderUUID.String()),
+			zap.String("event_uuid", event.EventUUID.String()),
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) handlePaymentFailed(ctx context.Context, msg kafka.Message) error {
+	event, err := s.paymentFailedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode PaymentFailed event",
+			zap.Error(err),
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+		)
+
+		return err
+	}
+
+	logger.Info(ctx, "PaymentFailed message received",
+		zap.String("topic", msg.Topic),
+		zap.Int32("partition", msg.Partition),
+		zap.Int64("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID.String()),
+		zap.String("order_uuid", event.OrderUUID.String()),
+		zap.String("transaction_uuid", event.TransactionUUID.String()),
+		zap.String("decline_code", event.DeclineCode),
+	)
+
+	updated, err := s.orderService.FailPayment(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to return order to PENDING_PAYMENT",
+			zap.Error(err),
+			zap.String("order_uuid", event.OrderUUID.String()),
+		)
+
+		return err
+	}
+
+	if updated != nil {
+		logger.Info(ctx, "Order returned to PENDING_PAYMENT",
+			zap.String("order_uuid", updated.OrderUUID.String()),
+			zap.String("event_uuid", event.EventUUID.String()),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/order/internal/repository"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// Service реализует интерфейс IdempotencyService
+type Service struct {
+	idempotencyRepository repository.IdempotencyRepository
+	ttl                   time.Duration
+}
+
+// NewService создает новый экземпляр Service.
+// ttl задает, сколько хранится результат запроса с ключом идемпотентности
+func NewService(idempotencyRepository repository.IdempotencyRepository, ttl time.Duration) *Service {
+	return &Service{
+		idempotencyRepository: idempotencyRepository,
+		ttl:                   ttl,
+	}
+}
+
+// Execute выполняет fn не более одного раза для ключа идемпотентности.
+// Повторный запрос с тем же ключом и тем же requestHash получает сохраненный ответ,
+// с другим requestHash - ErrIdempotencyKeyReused. Если fn вернула ошибку,
+// резерв снимается и запрос можно повторить с тем же ключом
+func (s *Service) Execute(
+	ctx context.Context,
+	key model.IdempotencyKey,
+	requestHash []byte,
+	fn func(ctx context.Context) ([]byte, error),
+) ([]byte, error) {
+	now := time.Now()
+
+	existing, err := s.idempotencyRepository.Reserve(ctx, &model.IdempotencyRecord{
+		IdempotencyKey: key,
+		RequestHash:    requestHash,
+		CreatedAt:      now,
+	}, now.Add(-s.ttl))
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	if existing != nil {
+		return replay(existing, key, requestHash)
+	}
+
+	response, err := fn(ctx)
+	if err != nil {
+		if releaseErr := s.idempotencyRepository.Release(ctx, key); releaseErr != nil {
+			logger.Error(ctx, "failed to release idempotency key",
+				zap.Error(releaseErr),
+				zap.String("method", key.Method),
+			)
+		}
+
+		return nil, err
+	}
+
+	if err = s.idempotencyRepository.Complete(ctx, key, response); err != nil {
+		// Запрос уже выполнен, поэтому клиент получает ответ, даже если его не удалось сохранить
+		logger.Error(ctx, "failed to store idempotent response",
+			zap.Error(err),
+			zap.String("method", key.Method),
+		)
+	}
+
+	return response, nil
+}
+
+// replay возвращает сохраненный ответ, если запрос совпадает с исходным
+func replay(existing *model.IdempotencyRecord, key model.IdempotencyKey, requestHash []byte) ([]byte, error) {
+	if existing.Method != key.Method || !bytes.Equal(existing.RequestHash, requestHash) {
+		return nil, model.ErrIdempotencyKeyReused
+	}
+
+	if existing.Response == nil {
+		return nil, model.ErrIdempotencyRequestInProgress
+	}
+
+	return existing.Response, nil
+}
@@ -0,0 +1,138 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	repomocks "github.com/radiophysiker/microservices-homework/order/internal/repository/mocks"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// ServiceTestSuite содержит общее окружение для тестов сервиса идемпотентности
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx     context.Context
+	repo    *repomocks.MockIdempotencyRepository
+	service *Service
+	key     model.IdempotencyKey
+	hash    []byte
+}
+
+// SetupTest запускается перед каждым тестом
+func (s *ServiceTestSuite) SetupTest() {
+	logger.SetNopLogger()
+
+	s.ctx = context.Background()
+	s.repo = repomocks.NewMockIdempotencyRepository(s.T())
+	s.service = NewService(s.repo, time.Hour)
+	s.key = model.IdempotencyKey{Scope: "user:1", Key: "key-1", Method: "/order.v1.OrderService/CreateOrder"}
+	s.hash = []byte("hash")
+}
+
+// handlerCounter возвращает обработчик, считающий вызовы
+func handlerCounter(calls *int, resp []byte, err error) func(ctx context.Context) ([]byte, error) {
+	return func(_ context.Context) ([]byte, error) {
+		*calls++
+		return resp, err
+	}
+}
+
+func (s *ServiceTestSuite) TestFirstRequestExecutesAndStoresResponse() {
+	s.repo.EXPECT().Reserve(s.ctx, mock.MatchedBy(func(r *model.IdempotencyRecord) bool {
+		return r.IdempotencyKey == s.key && string(r.RequestHash) == "hash"
+	}), mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+	s.repo.EXPECT().Complete(s.ctx, s.key, []byte("resp")).Return(nil).Once()
+
+	var calls int
+
+	resp, err := s.service.Execute(s.ctx, s.key, s.hash, handlerCounter(&calls, []byte("resp"), nil))
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []byte("resp"), resp)
+	require.Equal(s.T(), 1, calls)
+}
+
+func (s *ServiceTestSuite) TestRepeatedRequestReturnsStoredResponse() {
+	existing := &model.IdempotencyRecord{IdempotencyKey: s.key, RequestHash: s.hash, Response: []byte("stored")}
+	s.repo.EXPECT().Reserve(s.ctx, mock.Anything, mock.Anything).Return(existing, nil).Once()
+
+	var calls int
+
+	resp, err := s.service.Execute(s.ctx, s.key, s.hash, handlerCounter(&calls, []byte("new"), nil))
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []byte("stored"), resp)
+	require.Zero(s.T(), calls)
+}
+
+func (s *ServiceTestSuite) TestReusedKeyWithDifferentPayloadIsRejected() {
+	existing := &model.IdempotencyRecord{IdempotencyKey: s.key, RequestHash: []byte("other"), Response: []byte("stored")}
+	s.repo.EXPECT().Reserve(s.ctx, mock.Anything, mock.Anything).Return(existing, nil).Once()
+
+	var calls int
+
+	_, err := s.service.Execute(s.ctx, s.key, s.hash, handlerCounter(&calls, nil, nil))
+	require.ErrorIs(s.T(), err, model.ErrIdempotencyKeyReused)
+	require.Zero(s.T(), calls)
+}
+
+func (s *ServiceTestSuite) TestReusedKeyForAnotherMethodIsRejected() {
+	existing := &model.IdempotencyRecord{
+		IdempotencyKey: model.IdempotencyKey{Scope: s.key.Scope, Key: s.key.Key, Method: "/order.v1.OrderService/PayOrder"},
+		RequestHash:    s.hash,
+		Response:       []byte("stored"),
+	}
+	s.repo.EXPECT().Reserve(s.ctx, mock.Anything, mock.Anything).Return(existing, nil).Once()
+
+	_, err := s.service.Execute(s.ctx, s.key, s.hash, handlerCounter(new(int), nil, nil))
+	require.ErrorIs(s.T(), err, model.ErrIdempotencyKeyReused)
+}
+
+func (s *ServiceTestSuite) TestRequestInProgress() {
+	existing := &model.IdempotencyRecord{IdempotencyKey: s.key, RequestHash: s.hash}
+	s.repo.EXPECT().Reserve(s.ctx, mock.Anything, mock.Anything).Return(existing, nil).Once()
+
+	_, err := s.service.Execute(s.ctx, s.key, s.hash, handlerCounter(new(int), nil, nil))
+	require.ErrorIs(s.T(), err, model.ErrIdempotencyRequestInProgress)
+}
+
+func (s *ServiceTestSuite) TestHandlerErrorReleasesKey() {
+	handlerErr := errors.New("payment failed")
+
+	s.repo.EXPECT().Reserve(s.ctx, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.repo.EXPECT().Release(s.ctx, s.key).Return(nil).Once()
+
+	_, err := s.service.Execute(s.ctx, s.key, s.hash, handlerCounter(new(int), nil, handlerErr))
+	require.ErrorIs(s.T(), err, handlerErr)
+}
+
+func (s *ServiceTestSuite) TestReserveError() {
+	s.repo.EXPECT().Reserve(s.ctx, mock.Anything, mock.Anything).Return(nil, errors.New("database error")).Once()
+
+	var calls int
+
+	_, err := s.service.Execute(s.ctx, s.key, s.hash, handlerCounter(&calls, nil, nil))
+	require.Error(s.T(), err)
+	require.Contains(s.T(), err.Error(), "failed to reserve idempotency key")
+	require.Zero(s.T(), calls)
+}
+
+func (s *ServiceTestSuite) TestReserveRaceReportedAsInProgress() {
+	s.repo.EXPECT().Reserve(s.ctx, mock.Anything, mock.Anything).Return(nil, model.ErrIdempotencyRequestInProgress).Once()
+
+	var calls int
+
+	_, err := s.service.Execute(s.ctx, s.key, s.hash, handlerCounter(&calls, nil, nil))
+	require.ErrorIs(s.T(), err, model.ErrIdempotencyRequestInProgress)
+	require.Zero(s.T(), calls)
+}
+
+// TestServiceSuite запускает все тесты suite
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
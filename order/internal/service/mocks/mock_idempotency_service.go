@@ -0,0 +1,119 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package service
+
+import (
+	"context"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIdempotencyService creates a new instance of MockIdempotencyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyService {
+	mock := &MockIdempotencyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotencyService is an autogenerated mock type for the IdempotencyService type
+type MockIdempotencyService struct {
+	mock.Mock
+}
+
+type MockIdempotencyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotencyService) EXPECT() *MockIdempotencyService_Expecter {
+	return &MockIdempotencyService_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockIdempotencyService
+func (_mock *MockIdempotencyService) Execute(ctx context.Context, key model.IdempotencyKey, requestHash []byte, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	ret := _mock.Called(ctx, key, requestHash, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey, []byte, func(ctx context.Context) ([]byte, error)) ([]byte, error)); ok {
+		return returnFunc(ctx, key, requestHash, fn)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey, []byte, func(ctx context.Context) ([]byte, error)) []byte); ok {
+		r0 = returnFunc(ctx, key, requestHash, fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.IdempotencyKey, []byte, func(ctx context.Context) ([]byte, error)) error); ok {
+		r1 = returnFunc(ctx, key, requestHash, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdempotencyService_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockIdempotencyService_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.IdempotencyKey
+//   - requestHash []byte
+//   - fn func(ctx context.Context) ([]byte, error)
+func (_e *MockIdempotencyService_Expecter) Execute(ctx interface{}, key interface{}, requestHash interface{}, fn interface{}) *MockIdempotencyService_Execute_Call {
+	return &MockIdempotencyService_Execute_Call{Call: _e.mock.On("Execute", ctx, key, requestHash, fn)}
+}
+
+func (_c *MockIdempotencyService_Execute_Call) Run(run func(ctx context.Context, key model.IdempotencyKey, requestHash []byte, fn func(ctx context.Context) ([]byte, error))) *MockIdempotencyService_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.IdempotencyKey
+		if args[1] != nil {
+			arg1 = args[1].(model.IdempotencyKey)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		var arg3 func(ctx context.Context) ([]byte, error)
+		if args[3] != nil {
+			arg3 = args[3].(func(ctx context.Context) ([]byte, error))
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyService_Execute_Call) Return(bytes []byte, err error) *MockIdempotencyService_Execute_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockIdempotencyService_Execute_Call) RunAndReturn(run func(ctx context.Context, key model.IdempotencyKey, requestHash []byte, fn func(ctx context.Context) ([]byte, error)) ([]byte, error)) *MockIdempotencyService_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
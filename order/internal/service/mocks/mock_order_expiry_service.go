@@ -0,0 +1,140 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package service
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOrderExpiryService creates a new instance of MockOrderExpiryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrderExpiryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrderExpiryService {
+	mock := &MockOrderExpiryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOrderExpiryService is an autogenerated mock type for the OrderExpiryService type
+type MockOrderExpiryService struct {
+	mock.Mock
+}
+
+type MockOrderExpiryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrderExpiryService) EXPECT() *MockOrderExpiryService_Expecter {
+	return &MockOrderExpiryService_Expecter{mock: &_m.Mock}
+}
+
+// Run provides a mock function for the type MockOrderExpiryService
+func (_mock *MockOrderExpiryService) Run(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderExpiryService_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type MockOrderExpiryService_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockOrderExpiryService_Expecter) Run(ctx interface{}) *MockOrderExpiryService_Run_Call {
+	return &MockOrderExpiryService_Run_Call{Call: _e.mock.On("Run", ctx)}
+}
+
+func (_c *MockOrderExpiryService_Run_Call) Run(run func(ctx context.Context)) *MockOrderExpiryService_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderExpiryService_Run_Call) Return(err error) *MockOrderExpiryService_Run_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderExpiryService_Run_Call) RunAndReturn(run func(ctx context.Context) error) *MockOrderExpiryService_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function for the type MockOrderExpiryService
+func (_mock *MockOrderExpiryService) Stop(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderExpiryService_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockOrderExpiryService_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockOrderExpiryService_Expecter) Stop(ctx interface{}) *MockOrderExpiryService_Stop_Call {
+	return &MockOrderExpiryService_Stop_Call{Call: _e.mock.On("Stop", ctx)}
+}
+
+func (_c *MockOrderExpiryService_Stop_Call) Run(run func(ctx context.Context)) *MockOrderExpiryService_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderExpiryService_Stop_Call) Return(err error) *MockOrderExpiryService_Stop_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderExpiryService_Stop_Call) RunAndReturn(run func(ctx context.Context) error) *MockOrderExpiryService_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -6,6 +6,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/radiophysiker/microservices-homework/order/internal/model"
@@ -40,8 +41,8 @@ func (_m *MockOrderService) EXPECT() *MockOrderService_Expecter {
 }
 
 // CancelOrder provides a mock function for the type MockOrderService
-func (_mock *MockOrderService) CancelOrder(ctx context.Context, orderUUID uuid.UUID) (*model.Order, error) {
-	ret := _mock.Called(ctx, orderUUID)
+func (_mock *MockOrderService) CancelOrder(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID) (*model.Order, error) {
+	ret := _mock.Called(ctx, userUUID, orderUUID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CancelOrder")
@@ -49,18 +50,18 @@ func (_mock *MockOrderService) CancelOrder(ctx context.Context, orderUUID uuid.U
 
 	var r0 *model.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Order, error)); ok {
-		return returnFunc(ctx, orderUUID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*model.Order, error)); ok {
+		return returnFunc(ctx, userUUID, orderUUID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Order); ok {
-		r0 = returnFunc(ctx, orderUUID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *model.Order); ok {
+		r0 = returnFunc(ctx, userUUID, orderUUID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, orderUUID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userUUID, orderUUID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,12 +75,13 @@ type MockOrderService_CancelOrder_Call struct {
 
 // CancelOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userUUID uuid.UUID
 //   - orderUUID uuid.UUID
-func (_e *MockOrderService_Expecter) CancelOrder(ctx interface{}, orderUUID interface{}) *MockOrderService_CancelOrder_Call {
-	return &MockOrderService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, orderUUID)}
+func (_e *MockOrderService_Expecter) CancelOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}) *MockOrderService_CancelOrder_Call {
+	return &MockOrderService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, userUUID, orderUUID)}
 }
 
-func (_c *MockOrderService_CancelOrder_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID)) *MockOrderService_CancelOrder_Call {
+func (_c *MockOrderService_CancelOrder_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID)) *MockOrderService_CancelOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,9 +91,14 @@ func (_c *MockOrderService_CancelOrder_Call) Run(run func(ctx context.Context, o
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -102,14 +109,82 @@ func (_c *MockOrderService_CancelOrder_Call) Return(order *model.Order, err erro
 	return _c
 }
 
-func (_c *MockOrderService_CancelOrder_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID) (*model.Order, error)) *MockOrderService_CancelOrder_Call {
+func (_c *MockOrderService_CancelOrder_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID) (*model.Order, error)) *MockOrderService_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompletePayment provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) CompletePayment(ctx context.Context, event *model.PaymentCompleted) (*model.Order, error) {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompletePayment")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.PaymentCompleted) (*model.Order, error)); ok {
+		return returnFunc(ctx, event)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.PaymentCompleted) *model.Order); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.PaymentCompleted) error); ok {
+		r1 = returnFunc(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_CompletePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompletePayment'
+type MockOrderService_CompletePayment_Call struct {
+	*mock.Call
+}
+
+// CompletePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *model.PaymentCompleted
+func (_e *MockOrderService_Expecter) CompletePayment(ctx interface{}, event interface{}) *MockOrderService_CompletePayment_Call {
+	return &MockOrderService_CompletePayment_Call{Call: _e.mock.On("CompletePayment", ctx, event)}
+}
+
+func (_c *MockOrderService_CompletePayment_Call) Run(run func(ctx context.Context, event *model.PaymentCompleted)) *MockOrderService_CompletePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.PaymentCompleted
+		if args[1] != nil {
+			arg1 = args[1].(*model.PaymentCompleted)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_CompletePayment_Call) Return(order *model.Order, err error) *MockOrderService_CompletePayment_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockOrderService_CompletePayment_Call) RunAndReturn(run func(ctx context.Context, event *model.PaymentCompleted) (*model.Order, error)) *MockOrderService_CompletePayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // CreateOrder provides a mock function for the type MockOrderService
-func (_mock *MockOrderService) CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID) (*model.Order, error) {
-	ret := _mock.Called(ctx, userUUID, partUUIDs)
+func (_mock *MockOrderService) CreateOrder(ctx context.Context, userUUID uuid.UUID, items []model.OrderItem) (*model.Order, error) {
+	ret := _mock.Called(ctx, userUUID, items)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
@@ -117,18 +192,18 @@ func (_mock *MockOrderService) CreateOrder(ctx context.Context, userUUID uuid.UU
 
 	var r0 *model.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (*model.Order, error)); ok {
-		return returnFunc(ctx, userUUID, partUUIDs)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []model.OrderItem) (*model.Order, error)); ok {
+		return returnFunc(ctx, userUUID, items)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) *model.Order); ok {
-		r0 = returnFunc(ctx, userUUID, partUUIDs)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []model.OrderItem) *model.Order); ok {
+		r0 = returnFunc(ctx, userUUID, items)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, userUUID, partUUIDs)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []model.OrderItem) error); ok {
+		r1 = returnFunc(ctx, userUUID, items)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -143,12 +218,12 @@ type MockOrderService_CreateOrder_Call struct {
 // CreateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userUUID uuid.UUID
-//   - partUUIDs []uuid.UUID
-func (_e *MockOrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, partUUIDs interface{}) *MockOrderService_CreateOrder_Call {
-	return &MockOrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, partUUIDs)}
+//   - items []model.OrderItem
+func (_e *MockOrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, items interface{}) *MockOrderService_CreateOrder_Call {
+	return &MockOrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, items)}
 }
 
-func (_c *MockOrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID)) *MockOrderService_CreateOrder_Call {
+func (_c *MockOrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, items []model.OrderItem)) *MockOrderService_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -158,9 +233,9 @@ func (_c *MockOrderService_CreateOrder_Call) Run(run func(ctx context.Context, u
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 []uuid.UUID
+		var arg2 []model.OrderItem
 		if args[2] != nil {
-			arg2 = args[2].([]uuid.UUID)
+			arg2 = args[2].([]model.OrderItem)
 		}
 		run(
 			arg0,
@@ -176,14 +251,154 @@ func (_c *MockOrderService_CreateOrder_Call) Return(order *model.Order, err erro
 	return _c
 }
 
-func (_c *MockOrderService_CreateOrder_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID) (*model.Order, error)) *MockOrderService_CreateOrder_Call {
+func (_c *MockOrderService_CreateOrder_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, items []model.OrderItem) (*model.Order, error)) *MockOrderService_CreateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpirePendingOrders provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) ExpirePendingOrders(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	ret := _mock.Called(ctx, createdBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpirePendingOrders")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return returnFunc(ctx, createdBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = returnFunc(ctx, createdBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, createdBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_ExpirePendingOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpirePendingOrders'
+type MockOrderService_ExpirePendingOrders_Call struct {
+	*mock.Call
+}
+
+// ExpirePendingOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+func (_e *MockOrderService_Expecter) ExpirePendingOrders(ctx interface{}, createdBefore interface{}, limit interface{}) *MockOrderService_ExpirePendingOrders_Call {
+	return &MockOrderService_ExpirePendingOrders_Call{Call: _e.mock.On("ExpirePendingOrders", ctx, createdBefore, limit)}
+}
+
+func (_c *MockOrderService_ExpirePendingOrders_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int)) *MockOrderService_ExpirePendingOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_ExpirePendingOrders_Call) Return(n int, err error) *MockOrderService_ExpirePendingOrders_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockOrderService_ExpirePendingOrders_Call) RunAndReturn(run func(ctx context.Context, createdBefore time.Time, limit int) (int, error)) *MockOrderService_ExpirePendingOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailPayment provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) FailPayment(ctx context.Context, event *model.PaymentFailed) (*model.Order, error) {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailPayment")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.PaymentFailed) (*model.Order, error)); ok {
+		return returnFunc(ctx, event)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.PaymentFailed) *model.Order); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.PaymentFailed) error); ok {
+		r1 = returnFunc(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_FailPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailPayment'
+type MockOrderService_FailPayment_Call struct {
+	*mock.Call
+}
+
+// FailPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *model.PaymentFailed
+func (_e *MockOrderService_Expecter) FailPayment(ctx interface{}, event interface{}) *MockOrderService_FailPayment_Call {
+	return &MockOrderService_FailPayment_Call{Call: _e.mock.On("FailPayment", ctx, event)}
+}
+
+func (_c *MockOrderService_FailPayment_Call) Run(run func(ctx context.Context, event *model.PaymentFailed)) *MockOrderService_FailPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.PaymentFailed
+		if args[1] != nil {
+			arg1 = args[1].(*model.PaymentFailed)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_FailPayment_Call) Return(order *model.Order, err error) *MockOrderService_FailPayment_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockOrderService_FailPayment_Call) RunAndReturn(run func(ctx context.Context, event *model.PaymentFailed) (*model.Order, error)) *MockOrderService_FailPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetOrder provides a mock function for the type MockOrderService
-func (_mock *MockOrderService) GetOrder(ctx context.Context, orderUUID uuid.UUID) (*model.Order, error) {
-	ret := _mock.Called(ctx, orderUUID)
+func (_mock *MockOrderService) GetOrder(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID) (*model.Order, error) {
+	ret := _mock.Called(ctx, userUUID, orderUUID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetOrder")
@@ -191,18 +406,18 @@ func (_mock *MockOrderService) GetOrder(ctx context.Context, orderUUID uuid.UUID
 
 	var r0 *model.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Order, error)); ok {
-		return returnFunc(ctx, orderUUID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*model.Order, error)); ok {
+		return returnFunc(ctx, userUUID, orderUUID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Order); ok {
-		r0 = returnFunc(ctx, orderUUID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *model.Order); ok {
+		r0 = returnFunc(ctx, userUUID, orderUUID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, orderUUID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userUUID, orderUUID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,12 +431,13 @@ type MockOrderService_GetOrder_Call struct {
 
 // GetOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userUUID uuid.UUID
 //   - orderUUID uuid.UUID
-func (_e *MockOrderService_Expecter) GetOrder(ctx interface{}, orderUUID interface{}) *MockOrderService_GetOrder_Call {
-	return &MockOrderService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, orderUUID)}
+func (_e *MockOrderService_Expecter) GetOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}) *MockOrderService_GetOrder_Call {
+	return &MockOrderService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, userUUID, orderUUID)}
 }
 
-func (_c *MockOrderService_GetOrder_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID)) *MockOrderService_GetOrder_Call {
+func (_c *MockOrderService_GetOrder_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID)) *MockOrderService_GetOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -231,9 +447,14 @@ func (_c *MockOrderService_GetOrder_Call) Run(run func(ctx context.Context, orde
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -244,14 +465,168 @@ func (_c *MockOrderService_GetOrder_Call) Return(order *model.Order, err error)
 	return _c
 }
 
-func (_c *MockOrderService_GetOrder_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID) (*model.Order, error)) *MockOrderService_GetOrder_Call {
+func (_c *MockOrderService_GetOrder_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID) (*model.Order, error)) *MockOrderService_GetOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderHistory provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) GetOrderHistory(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID) ([]*model.StatusHistoryEntry, error) {
+	ret := _mock.Called(ctx, userUUID, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderHistory")
+	}
+
+	var r0 []*model.StatusHistoryEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]*model.StatusHistoryEntry, error)); ok {
+		return returnFunc(ctx, userUUID, orderUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []*model.StatusHistoryEntry); ok {
+		r0 = returnFunc(ctx, userUUID, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StatusHistoryEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userUUID, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_GetOrderHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderHistory'
+type MockOrderService_GetOrderHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - orderUUID uuid.UUID
+func (_e *MockOrderService_Expecter) GetOrderHistory(ctx interface{}, userUUID interface{}, orderUUID interface{}) *MockOrderService_GetOrderHistory_Call {
+	return &MockOrderService_GetOrderHistory_Call{Call: _e.mock.On("GetOrderHistory", ctx, userUUID, orderUUID)}
+}
+
+func (_c *MockOrderService_GetOrderHistory_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID)) *MockOrderService_GetOrderHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_GetOrderHistory_Call) Return(statusHistoryEntrys []*model.StatusHistoryEntry, err error) *MockOrderService_GetOrderHistory_Call {
+	_c.Call.Return(statusHistoryEntrys, err)
+	return _c
+}
+
+func (_c *MockOrderService_GetOrderHistory_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID) ([]*model.StatusHistoryEntry, error)) *MockOrderService_GetOrderHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) ListOrders(ctx context.Context, filter model.OrderFilter, cursor *model.OrderCursor, pageSize int) (*model.OrderPage, error) {
+	ret := _mock.Called(ctx, filter, cursor, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 *model.OrderPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderFilter, *model.OrderCursor, int) (*model.OrderPage, error)); ok {
+		return returnFunc(ctx, filter, cursor, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderFilter, *model.OrderCursor, int) *model.OrderPage); ok {
+		r0 = returnFunc(ctx, filter, cursor, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.OrderFilter, *model.OrderCursor, int) error); ok {
+		r1 = returnFunc(ctx, filter, cursor, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type MockOrderService_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrderFilter
+//   - cursor *model.OrderCursor
+//   - pageSize int
+func (_e *MockOrderService_Expecter) ListOrders(ctx interface{}, filter interface{}, cursor interface{}, pageSize interface{}) *MockOrderService_ListOrders_Call {
+	return &MockOrderService_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter, cursor, pageSize)}
+}
+
+func (_c *MockOrderService_ListOrders_Call) Run(run func(ctx context.Context, filter model.OrderFilter, cursor *model.OrderCursor, pageSize int)) *MockOrderService_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderFilter)
+		}
+		var arg2 *model.OrderCursor
+		if args[2] != nil {
+			arg2 = args[2].(*model.OrderCursor)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_ListOrders_Call) Return(orderPage *model.OrderPage, err error) *MockOrderService_ListOrders_Call {
+	_c.Call.Return(orderPage, err)
+	return _c
+}
+
+func (_c *MockOrderService_ListOrders_Call) RunAndReturn(run func(ctx context.Context, filter model.OrderFilter, cursor *model.OrderCursor, pageSize int) (*model.OrderPage, error)) *MockOrderService_ListOrders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // PayOrder provides a mock function for the type MockOrderService
-func (_mock *MockOrderService) PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMethod model.PaymentMethod) (*model.Order, error) {
-	ret := _mock.Called(ctx, orderUUID, paymentMethod)
+func (_mock *MockOrderService) PayOrder(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod, cardToken string) (*model.Order, error) {
+	ret := _mock.Called(ctx, userUUID, orderUUID, paymentMethod, cardToken)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -259,18 +634,18 @@ func (_mock *MockOrderService) PayOrder(ctx context.Context, orderUUID uuid.UUID
 
 	var r0 *model.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.PaymentMethod) (*model.Order, error)); ok {
-		return returnFunc(ctx, orderUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod, string) (*model.Order, error)); ok {
+		return returnFunc(ctx, userUUID, orderUUID, paymentMethod, cardToken)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.PaymentMethod) *model.Order); ok {
-		r0 = returnFunc(ctx, orderUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod, string) *model.Order); ok {
+		r0 = returnFunc(ctx, userUUID, orderUUID, paymentMethod, cardToken)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.PaymentMethod) error); ok {
-		r1 = returnFunc(ctx, orderUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod, string) error); ok {
+		r1 = returnFunc(ctx, userUUID, orderUUID, paymentMethod, cardToken)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -284,13 +659,15 @@ type MockOrderService_PayOrder_Call struct {
 
 // PayOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userUUID uuid.UUID
 //   - orderUUID uuid.UUID
 //   - paymentMethod model.PaymentMethod
-func (_e *MockOrderService_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, paymentMethod interface{}) *MockOrderService_PayOrder_Call {
-	return &MockOrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, paymentMethod)}
+//   - cardToken string
+func (_e *MockOrderService_Expecter) PayOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}, cardToken interface{}) *MockOrderService_PayOrder_Call {
+	return &MockOrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, orderUUID, paymentMethod, cardToken)}
 }
 
-func (_c *MockOrderService_PayOrder_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID, paymentMethod model.PaymentMethod)) *MockOrderService_PayOrder_Call {
+func (_c *MockOrderService_PayOrder_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod, cardToken string)) *MockOrderService_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -300,14 +677,24 @@ func (_c *MockOrderService_PayOrder_Call) Run(run func(ctx context.Context, orde
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 model.PaymentMethod
+		var arg2 uuid.UUID
 		if args[2] != nil {
-			arg2 = args[2].(model.PaymentMethod)
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 model.PaymentMethod
+		if args[3] != nil {
+			arg3 = args[3].(model.PaymentMethod)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -318,7 +705,7 @@ func (_c *MockOrderService_PayOrder_Call) Return(order *model.Order, err error)
 	return _c
 }
 
-func (_c *MockOrderService_PayOrder_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID, paymentMethod model.PaymentMethod) (*model.Order, error)) *MockOrderService_PayOrder_Call {
+func (_c *MockOrderService_PayOrder_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod, cardToken string) (*model.Order, error)) *MockOrderService_PayOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,22 @@
+package order
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+)
+
+// userStatusChange описывает изменение статуса, инициированное пользователем
+func userStatusChange(userUUID uuid.UUID) model.StatusChange {
+	return model.StatusChange{Actor: userUUID.String()}
+}
+
+// systemStatusChange описывает изменение статуса, выполняемое самим сервисом
+func systemStatusChange() model.StatusChange {
+	return model.StatusChange{Actor: model.ActorSystem}
+}
+
+// paymentStatusChange описывает изменение статуса по событию с результатом асинхронной оплаты
+func paymentStatusChange(eventUUID uuid.UUID) model.StatusChange {
+	return model.StatusChange{Actor: model.ActorPaymentConsumer, EventUUID: &eventUUID}
+}
@@ -2,30 +2,37 @@ package order
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/google/uuid"
 
 	"github.com/radiophysiker/microservices-homework/order/internal/model"
 )
 
-// CancelOrder отменяет заказ
-func (s *Service) CancelOrder(ctx context.Context, orderUUID uuid.UUID) (*model.Order, error) {
-	order, err := s.orderRepository.GetOrder(ctx, orderUUID.String())
+// CancelOrder отменяет заказ пользователя
+func (s *Service) CancelOrder(ctx context.Context, userUUID, orderUUID uuid.UUID) (*model.Order, error) {
+	order, err := s.getOwnedOrder(ctx, userUUID, orderUUID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get order: %w", err)
+		return nil, err
 	}
 
-	if order.Status == model.StatusPaid {
-		return nil, model.ErrOrderCannotBeCancelled
+	// Оплаченный, но еще не собранный заказ отменяется с возвратом средств.
+	// Заказ в REFUNDING уже закреплен за возвратом, повторная отмена его продолжает
+	if order.Status == model.StatusPaid || order.Status == model.StatusRefunding {
+		return s.refundOrder(ctx, userUUID, order)
 	}
 
-	order.Status = model.StatusCancelled
+	cancelled, err := s.updateWithRetry(ctx, order, userStatusChange(userUUID), func(order *model.Order) (*model.OutboxMessage, error) {
+		if err := order.TransitionTo(model.StatusCancelled); err != nil {
+			return nil, err
+		}
 
-	updated, err := s.orderRepository.UpdateOrder(ctx, order)
+		return newOrderCancelledOutboxMessage(ctx, order, nil)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update order: %w", err)
+		return nil, err
 	}
 
-	return updated, nil
+	s.releaseReservation(ctx, order.OrderUUID)
+
+	return cancelled, nil
 }
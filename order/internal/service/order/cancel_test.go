@@ -1,6 +1,7 @@
 package order
 
 import (
+	"context"
 	"errors"
 
 	"github.com/google/uuid"
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	clientmocks "github.com/radiophysiker/microservices-homework/order/internal/client/grpc/mocks"
 	"github.com/radiophysiker/microservices-homework/order/internal/model"
 	repomocks "github.com/radiophysiker/microservices-homework/order/internal/repository/mocks"
 )
@@ -16,47 +18,248 @@ func (s *ServiceTestSuite) TestCancelOrder() {
 	tests := []struct {
 		name      string
 		orderUUID uuid.UUID
-		setupMock func(*repomocks.MockOrderRepository)
+		setupMock func(*repomocks.MockOrderRepository, *clientmocks.MockInventoryClient, *clientmocks.MockPaymentClient)
 		wantOrder *model.Order
 		checkErr  func(err error)
 	}{
 		{
 			name:      "success",
 			orderUUID: uuid.New(),
-			setupMock: func(repo *repomocks.MockOrderRepository) {
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
 				order := &model.Order{
 					OrderUUID: uuid.New(),
-					UserUUID:  uuid.New(),
+					UserUUID:  s.userUUID,
 					Status:    model.StatusPendingPayment,
 				}
 				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
-				repo.EXPECT().UpdateOrder(s.ctx, mock.AnythingOfType("*model.Order")).Return(&model.Order{Status: model.StatusCancelled}, nil).Once()
+				change := model.StatusChange{Actor: s.userUUID.String()}
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), change, mock.MatchedBy(func(msg *model.OutboxMessage) bool {
+					return msg.EventType == model.EventTypeOrderCancelled && msg.AggregateUUID == order.OrderUUID && len(msg.Payload) > 0
+				})).Return(&model.Order{Status: model.StatusCancelled}, nil).Once()
+				inv.EXPECT().ReleaseReservation(s.ctx, order.OrderUUID.String()).Return(nil).Once()
 			},
 			wantOrder: &model.Order{
 				Status: model.StatusCancelled,
 			},
 		},
 		{
-			name:      "order_already_paid_cannot_be_cancelled",
+			name:      "release_reservation_error_ignored",
 			orderUUID: uuid.New(),
-			setupMock: func(repo *repomocks.MockOrderRepository) {
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
 				order := &model.Order{
 					OrderUUID: uuid.New(),
-					UserUUID:  uuid.New(),
-					Status:    model.StatusPaid,
+					UserUUID:  s.userUUID,
+					Status:    model.StatusPendingPayment,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return(&model.Order{Status: model.StatusCancelled}, nil).Once()
+				inv.EXPECT().ReleaseReservation(s.ctx, order.OrderUUID.String()).Return(errors.New("inventory service down")).Once()
+			},
+			wantOrder: &model.Order{
+				Status: model.StatusCancelled,
+			},
+		},
+		{
+			name:      "paid_order_refunded",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				transactionUUID := uuid.New()
+				order := &model.Order{
+					OrderUUID:       uuid.New(),
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaid,
+					TransactionUUID: &transactionUUID,
+				}
+				refunding := &model.Order{
+					OrderUUID:       order.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusRefunding,
+					TransactionUUID: &transactionUUID,
+					Version:         1,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				// Заказ закрепляется за возвратом до обращения к payment service
+				repo.EXPECT().UpdateOrder(s.ctx, mock.MatchedBy(func(o *model.Order) bool {
+					return o.Status == model.StatusRefunding
+				}), mock.AnythingOfType("model.StatusChange")).Return(refunding, nil).Once()
+				pay.EXPECT().RefundPayment(s.ctx, s.userUUID.String(), order.OrderUUID.String(), transactionUUID.String()).Return(uuid.NewString(), nil).Once()
+				inv.EXPECT().RestockReservation(s.ctx, order.OrderUUID.String()).Return(nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.MatchedBy(func(o *model.Order) bool {
+					return o.Status == model.StatusRefunded && o.Version == 1
+				}), mock.AnythingOfType("model.StatusChange"), mock.MatchedBy(func(msg *model.OutboxMessage) bool {
+					return msg.EventType == model.EventTypeOrderCancelled && msg.AggregateUUID == order.OrderUUID && len(msg.Payload) > 0
+				})).Return(&model.Order{Status: model.StatusRefunded}, nil).Once()
+			},
+			wantOrder: &model.Order{
+				Status: model.StatusRefunded,
+			},
+		},
+		{
+			name:      "assembled_before_claim_not_refunded",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				transactionUUID := uuid.New()
+				order := &model.Order{
+					OrderUUID:       uuid.New(),
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaid,
+					TransactionUUID: &transactionUUID,
+					Version:         1,
+				}
+				assembled := &model.Order{
+					OrderUUID:       order.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusAssembled,
+					TransactionUUID: &transactionUUID,
+					Version:         2,
+				}
+				conflict := &model.OrderVersionConflictError{OrderUUID: order.OrderUUID.String(), ExpectedVersion: 1, ActualVersion: 2}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				repo.EXPECT().UpdateOrder(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange")).Return((*model.Order)(nil), conflict).Once()
+				repo.EXPECT().GetOrder(s.ctx, order.OrderUUID.String()).Return(assembled, nil).Once()
+			},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderCannotBeCancelled)
+			},
+		},
+		{
+			name:      "refunding_order_refund_resumed",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				transactionUUID := uuid.New()
+				order := &model.Order{
+					OrderUUID:       uuid.New(),
+					UserUUID:        s.userUUID,
+					Status:          model.StatusRefunding,
+					TransactionUUID: &transactionUUID,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().RefundPayment(s.ctx, s.userUUID.String(), order.OrderUUID.String(), transactionUUID.String()).Return(uuid.NewString(), nil).Once()
+				inv.EXPECT().RestockReservation(s.ctx, order.OrderUUID.String()).Return(nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.MatchedBy(func(o *model.Order) bool {
+					return o.Status == model.StatusRefunded
+				}), mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return(&model.Order{Status: model.StatusRefunded}, nil).Once()
+			},
+			wantOrder: &model.Order{
+				Status: model.StatusRefunded,
+			},
+		},
+		{
+			name:      "refund_without_reservation",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				transactionUUID := uuid.New()
+				order := &model.Order{
+					OrderUUID:       uuid.New(),
+					UserUUID:        s.userUUID,
+					Status:          model.StatusRefunding,
+					TransactionUUID: &transactionUUID,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().RefundPayment(s.ctx, s.userUUID.String(), order.OrderUUID.String(), transactionUUID.String()).Return(uuid.NewString(), nil).Once()
+				inv.EXPECT().RestockReservation(s.ctx, order.OrderUUID.String()).Return(model.ErrReservationNotFound).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).
+					Return(&model.Order{Status: model.StatusRefunded}, nil).Once()
+			},
+			wantOrder: &model.Order{
+				Status: model.StatusRefunded,
+			},
+		},
+		{
+			name:      "restock_error_keeps_order_refunding",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				transactionUUID := uuid.New()
+				order := &model.Order{
+					OrderUUID:       uuid.New(),
+					UserUUID:        s.userUUID,
+					Status:          model.StatusRefunding,
+					TransactionUUID: &transactionUUID,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().RefundPayment(s.ctx, s.userUUID.String(), order.OrderUUID.String(), transactionUUID.String()).Return(uuid.NewString(), nil).Once()
+				inv.EXPECT().RestockReservation(s.ctx, order.OrderUUID.String()).Return(errors.New("inventory service down")).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInventoryServiceUnavailable)
+			},
+		},
+		{
+			name:      "refund_error",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				transactionUUID := uuid.New()
+				order := &model.Order{
+					OrderUUID:       uuid.New(),
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaid,
+					TransactionUUID: &transactionUUID,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				repo.EXPECT().UpdateOrder(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange")).
+					Return(&model.Order{OrderUUID: order.OrderUUID, UserUUID: s.userUUID, Status: model.StatusRefunding, TransactionUUID: &transactionUUID}, nil).Once()
+				pay.EXPECT().RefundPayment(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("", errors.New("refund failed")).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrPaymentServiceUnavailable)
+				assert.Contains(s.T(), err.Error(), "refund failed")
+			},
+		},
+		{
+			name:      "order_refunded_cannot_be_cancelled",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID: uuid.New(),
+					UserUUID:  s.userUUID,
+					Status:    model.StatusRefunded,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderCannotBeCancelled)
+			},
+		},
+		{
+			name:      "order_assembled_cannot_be_cancelled",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID: uuid.New(),
+					UserUUID:  s.userUUID,
+					Status:    model.StatusAssembled,
 				}
 				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
 			},
 			wantOrder: nil,
 			checkErr: func(err error) {
-				assert.Error(s.T(), err)
 				assert.ErrorIs(s.T(), err, model.ErrOrderCannotBeCancelled)
+				assert.ErrorIs(s.T(), err, model.ErrInvalidStatusTransition)
+			},
+		},
+		{
+			name:      "another_user_order",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID: uuid.New(),
+					UserUUID:  uuid.New(),
+					Status:    model.StatusPendingPayment,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderAccessDenied)
 			},
 		},
 		{
 			name:      "get_order_error",
 			orderUUID: uuid.New(),
-			setupMock: func(repo *repomocks.MockOrderRepository) {
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
 				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return((*model.Order)(nil), errors.New("order not found")).Once()
 			},
 			wantOrder: nil,
@@ -69,14 +272,14 @@ func (s *ServiceTestSuite) TestCancelOrder() {
 		{
 			name:      "update_order_error",
 			orderUUID: uuid.New(),
-			setupMock: func(repo *repomocks.MockOrderRepository) {
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
 				order := &model.Order{
 					OrderUUID: uuid.New(),
-					UserUUID:  uuid.New(),
+					UserUUID:  s.userUUID,
 					Status:    model.StatusPendingPayment,
 				}
 				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
-				repo.EXPECT().UpdateOrder(s.ctx, mock.AnythingOfType("*model.Order")).Return((*model.Order)(nil), errors.New("database error")).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return((*model.Order)(nil), errors.New("database error")).Once()
 			},
 			wantOrder: nil,
 			checkErr: func(err error) {
@@ -85,13 +288,81 @@ func (s *ServiceTestSuite) TestCancelOrder() {
 				assert.Contains(s.T(), err.Error(), "database error")
 			},
 		},
+		{
+			name:      "version_conflict_retried",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID: uuid.New(),
+					UserUUID:  s.userUUID,
+					Status:    model.StatusPendingPayment,
+					Version:   1,
+				}
+				fresh := &model.Order{
+					OrderUUID: order.OrderUUID,
+					UserUUID:  order.UserUUID,
+					Status:    model.StatusPendingPayment,
+					Version:   2,
+				}
+				conflict := &model.OrderVersionConflictError{OrderUUID: order.OrderUUID.String(), ExpectedVersion: 1, ActualVersion: 2}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return((*model.Order)(nil), conflict).Once()
+				repo.EXPECT().GetOrder(s.ctx, order.OrderUUID.String()).Return(fresh, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, fresh, mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return(&model.Order{Status: model.StatusCancelled, Version: 3}, nil).Once()
+				inv.EXPECT().ReleaseReservation(s.ctx, order.OrderUUID.String()).Return(nil).Once()
+			},
+			wantOrder: &model.Order{
+				Status: model.StatusCancelled,
+			},
+		},
+		{
+			name:      "version_conflict_attempts_exhausted",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				orderUUID := uuid.New()
+				conflict := &model.OrderVersionConflictError{OrderUUID: orderUUID.String()}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).RunAndReturn(func(_ context.Context, _ string) (*model.Order, error) {
+					return &model.Order{OrderUUID: orderUUID, UserUUID: s.userUUID, Status: model.StatusPendingPayment}, nil
+				}).Times(maxConflictAttempts)
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return((*model.Order)(nil), conflict).Times(maxConflictAttempts)
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderVersionConflict)
+			},
+		},
+		{
+			name:      "version_conflict_status_changed",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID: uuid.New(),
+					UserUUID:  s.userUUID,
+					Status:    model.StatusPendingPayment,
+				}
+				paid := &model.Order{
+					OrderUUID: order.OrderUUID,
+					UserUUID:  order.UserUUID,
+					Status:    model.StatusPaid,
+					Version:   2,
+				}
+				conflict := &model.OrderVersionConflictError{OrderUUID: order.OrderUUID.String(), ExpectedVersion: 1, ActualVersion: 2}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return((*model.Order)(nil), conflict).Once()
+				repo.EXPECT().GetOrder(s.ctx, order.OrderUUID.String()).Return(paid, nil).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderCannotBeCancelled)
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
-			tt.setupMock(s.repo)
+			tt.setupMock(s.repo, s.inventoryClient, s.paymentClient)
 
-			got, err := s.service.CancelOrder(s.ctx, tt.orderUUID)
+			got, err := s.service.CancelOrder(s.ctx, s.userUUID, tt.orderUUID)
 
 			if tt.checkErr != nil {
 				tt.checkErr(err)
@@ -2,22 +2,29 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 
+	"github.com/radiophysiker/microservices-homework/order/internal/converter/kafka/encoder"
 	"github.com/radiophysiker/microservices-homework/order/internal/model"
 )
 
 // CreateOrder создает новый заказ
-func (s *Service) CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID) (*model.Order, error) {
-	if len(partUUIDs) == 0 {
-		return nil, model.NewInvalidOrderDataError("part UUIDs cannot be empty")
+func (s *Service) CreateOrder(ctx context.Context, userUUID uuid.UUID, items []model.OrderItem) (*model.Order, error) {
+	if len(items) == 0 {
+		return nil, model.NewInvalidOrderDataError("order items cannot be empty")
 	}
 
-	partUUIDStrings := make([]string, len(partUUIDs))
-	for i, partUUID := range partUUIDs {
-		partUUIDStrings[i] = partUUID.String()
+	orderItems, err := mergeOrderItems(items)
+	if err != nil {
+		return nil, err
+	}
+
+	partUUIDStrings := make([]string, len(orderItems))
+	for i, item := range orderItems {
+		partUUIDStrings[i] = item.PartUUID.String()
 	}
 
 	parts, err := s.inventoryClient.ListParts(ctx, partUUIDStrings)
@@ -25,37 +32,63 @@ func (s *Service) CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs
 		return nil, fmt.Errorf("%w: %w", model.ErrInventoryServiceUnavailable, err)
 	}
 
-	if len(parts) != len(partUUIDs) {
-		return nil, model.NewInvalidOrderDataError("some parts not found")
-	}
-
-	var totalPrice float64
-
-	items := make([]model.OrderItem, 0, len(parts))
+	partsByUUID := make(map[uuid.UUID]*model.Part, len(parts))
 
 	for _, part := range parts {
-		totalPrice += part.Price
-
 		parsedPartUUID, parseErr := uuid.Parse(part.UUID)
 		if parseErr != nil {
 			return nil, model.NewInvalidOrderDataError("invalid part UUID: " + part.UUID)
 		}
 
-		items = append(items, model.OrderItem{
-			PartUUID: parsedPartUUID,
-			Quantity: 1,
-		})
+		partsByUUID[parsedPartUUID] = part
+	}
+
+	var totalPrice float64
+
+	for i := range orderItems {
+		part, ok := partsByUUID[orderItems[i].PartUUID]
+		if !ok {
+			return nil, model.NewInvalidOrderDataError("part not found: " + orderItems[i].PartUUID.String())
+		}
+
+		// Сохраняем цену и описание детали на момент оформления заказа
+		orderItems[i].UnitPrice = part.Price
+		orderItems[i].PartName = part.Name
+		orderItems[i].Category = part.Category
+
+		totalPrice += part.Price * float64(orderItems[i].Quantity)
 	}
 
 	order := &model.Order{
 		OrderUUID:  uuid.New(),
 		UserUUID:   userUUID,
-		Items:      items,
+		Items:      orderItems,
 		TotalPrice: totalPrice,
 		Status:     model.StatusPendingPayment,
 	}
 
-	if err := s.orderRepository.CreateOrder(ctx, order); err != nil {
+	// Резервируем детали до сохранения заказа, чтобы не принять заказ без остатка на складе
+	if err := s.inventoryClient.ReserveParts(ctx, order.OrderUUID.String(), order.Items); err != nil {
+		// Ответ мог потеряться уже после того, как склад принял резерв (например, по таймауту),
+		// поэтому резерв снимается по ключу заказа при любой ошибке. Контекст запроса к этому
+		// моменту может быть отменен, а резерв нужно снять независимо от него
+		s.releaseReservation(context.WithoutCancel(ctx), order.OrderUUID)
+
+		if errors.Is(err, model.ErrInsufficientStock) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%w: %w", model.ErrInventoryServiceUnavailable, err)
+	}
+
+	message, err := newOrderCreatedOutboxMessage(ctx, order)
+	if err != nil {
+		s.releaseReservation(ctx, order.OrderUUID)
+		return nil, err
+	}
+
+	if err := s.orderRepository.CreateOrderWithOutbox(ctx, order, message); err != nil {
+		s.releaseReservation(ctx, order.OrderUUID)
 		return nil, fmt.Errorf("failed to create order: %w", err)
 	}
 
@@ -65,3 +98,51 @@ func (s *Service) CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs
 
 	return order, nil
 }
+
+// newOrderCreatedOutboxMessage формирует outbox-сообщение с событием OrderCreated
+func newOrderCreatedOutboxMessage(ctx context.Context, order *model.Order) (*model.OutboxMessage, error) {
+	orderCreatedEvent := model.OrderCreated{
+		EventUUID:  uuid.New(),
+		OrderUUID:  order.OrderUUID,
+		UserUUID:   order.UserUUID,
+		TotalPrice: order.TotalPrice,
+		Items:      order.Items,
+	}
+
+	payload, err := encoder.EncodeOrderCreated(orderCreatedEvent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode OrderCreated: %w", err)
+	}
+
+	return &model.OutboxMessage{
+		UUID:          orderCreatedEvent.EventUUID,
+		AggregateUUID: orderCreatedEvent.OrderUUID,
+		EventType:     model.EventTypeOrderCreated,
+		Key:           []byte(orderCreatedEvent.OrderUUID.String()),
+		Payload:       payload,
+		TraceContext:  outboxTraceContext(ctx),
+	}, nil
+}
+
+// mergeOrderItems объединяет позиции с одинаковой деталью, суммируя количество.
+// Порядок позиций сохраняется по первому вхождению детали
+func mergeOrderItems(items []model.OrderItem) ([]model.OrderItem, error) {
+	merged := make([]model.OrderItem, 0, len(items))
+	indexByPart := make(map[uuid.UUID]int, len(items))
+
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, model.NewInvalidOrderDataError("quantity must be positive for part " + item.PartUUID.String())
+		}
+
+		if idx, ok := indexByPart[item.PartUUID]; ok {
+			merged[idx].Quantity += item.Quantity
+			continue
+		}
+
+		indexByPart[item.PartUUID] = len(merged)
+		merged = append(merged, item)
+	}
+
+	return merged, nil
+}
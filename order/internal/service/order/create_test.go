@@ -14,31 +14,97 @@ import (
 )
 
 func (s *ServiceTestSuite) TestCreateOrder() {
+	partA := uuid.New()
+	partB := uuid.New()
+
 	tests := []struct {
 		name      string
 		userUUID  uuid.UUID
-		partUUIDs []uuid.UUID
+		items     []model.OrderItem
 		setupMock func(*repomocks.MockOrderRepository, *clientmocks.MockInventoryClient, *clientmocks.MockPaymentClient)
 		wantOrder *model.Order
 		checkErr  func(err error)
 	}{
 		{
-			name:      "success",
-			userUUID:  uuid.New(),
-			partUUIDs: []uuid.UUID{uuid.New(), uuid.New()},
+			name:     "success",
+			userUUID: uuid.New(),
+			items:    []model.OrderItem{{PartUUID: partA, Quantity: 2}, {PartUUID: partB, Quantity: 1}},
 			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
-				parts := []*model.Part{{UUID: uuid.New().String(), Price: 10}, {UUID: uuid.New().String(), Price: 25}}
-				inv.EXPECT().ListParts(s.ctx, mock.AnythingOfType("[]string")).Return(parts, nil).Once()
-				repo.EXPECT().CreateOrder(s.ctx, mock.AnythingOfType("*model.Order")).Return(nil).Once()
+				parts := []*model.Part{
+					{UUID: partA.String(), Name: "Main engine", Price: 10, Category: "ENGINE"},
+					{UUID: partB.String(), Name: "Porthole", Price: 25, Category: "PORTHOLE"},
+				}
+				inv.EXPECT().ListParts(s.ctx, []string{partA.String(), partB.String()}).Return(parts, nil).Once()
+				inv.EXPECT().ReserveParts(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("[]model.OrderItem")).Return(nil).Once()
+				repo.EXPECT().CreateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.MatchedBy(func(msg *model.OutboxMessage) bool {
+					return msg.EventType == model.EventTypeOrderCreated && msg.AggregateUUID != uuid.Nil && len(msg.Payload) > 0
+				})).Return(nil).Once()
+			},
+			wantOrder: &model.Order{
+				Items: []model.OrderItem{
+					{PartUUID: partA, Quantity: 2, UnitPrice: 10, PartName: "Main engine", Category: "ENGINE"},
+					{PartUUID: partB, Quantity: 1, UnitPrice: 25, PartName: "Porthole", Category: "PORTHOLE"},
+				},
+				TotalPrice: 45,
+				Status:     model.StatusPendingPayment,
+			},
+		},
+		{
+			name:     "duplicate_parts_merged",
+			userUUID: uuid.New(),
+			items:    []model.OrderItem{{PartUUID: partA, Quantity: 1}, {PartUUID: partB, Quantity: 3}, {PartUUID: partA, Quantity: 2}},
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				parts := []*model.Part{{UUID: partB.String(), Price: 5}, {UUID: partA.String(), Price: 10}}
+				inv.EXPECT().ListParts(s.ctx, []string{partA.String(), partB.String()}).Return(parts, nil).Once()
+				inv.EXPECT().ReserveParts(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("[]model.OrderItem")).Return(nil).Once()
+				repo.EXPECT().CreateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("*model.OutboxMessage")).Return(nil).Once()
 			},
 			wantOrder: &model.Order{
-				Status: model.StatusPendingPayment,
+				Items:      []model.OrderItem{{PartUUID: partA, Quantity: 3, UnitPrice: 10}, {PartUUID: partB, Quantity: 3, UnitPrice: 5}},
+				TotalPrice: 45,
+				Status:     model.StatusPendingPayment,
+			},
+		},
+		{
+			name:     "part_not_found",
+			userUUID: uuid.New(),
+			items:    []model.OrderItem{{PartUUID: partA, Quantity: 1}, {PartUUID: partB, Quantity: 1}},
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				parts := []*model.Part{{UUID: partA.String(), Price: 10}}
+				inv.EXPECT().ListParts(s.ctx, mock.AnythingOfType("[]string")).Return(parts, nil).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInvalidOrderData)
+				assert.Contains(s.T(), err.Error(), partB.String())
+			},
+		},
+		{
+			name:     "invalid_quantity",
+			userUUID: uuid.New(),
+			items:    []model.OrderItem{{PartUUID: partA, Quantity: 0}},
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInvalidOrderData)
 			},
 		},
 		{
-			name:      "inventory_error",
-			userUUID:  uuid.New(),
-			partUUIDs: []uuid.UUID{uuid.New()},
+			name:     "empty_items",
+			userUUID: uuid.New(),
+			items:    nil,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInvalidOrderData)
+			},
+		},
+		{
+			name:     "inventory_error",
+			userUUID: uuid.New(),
+			items:    []model.OrderItem{{PartUUID: partA, Quantity: 1}},
 			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
 				inv.EXPECT().ListParts(s.ctx, mock.AnythingOfType("[]string")).Return(nil, errors.New("inventory service down")).Once()
 			},
@@ -50,13 +116,54 @@ func (s *ServiceTestSuite) TestCreateOrder() {
 			},
 		},
 		{
-			name:      "repository_error",
-			userUUID:  uuid.New(),
-			partUUIDs: []uuid.UUID{uuid.New()},
+			name:     "insufficient_stock",
+			userUUID: uuid.New(),
+			items:    []model.OrderItem{{PartUUID: partA, Quantity: 100}},
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				parts := []*model.Part{{UUID: partA.String(), Price: 10}}
+				inv.EXPECT().ListParts(s.ctx, mock.AnythingOfType("[]string")).Return(parts, nil).Once()
+				inv.EXPECT().
+					ReserveParts(s.ctx, mock.AnythingOfType("string"), []model.OrderItem{{PartUUID: partA, Quantity: 100, UnitPrice: 10}}).
+					Return(model.ErrInsufficientStock).
+					Once()
+				// Склад откатил резерв сам, поэтому снимать нечего
+				inv.EXPECT().ReleaseReservation(mock.Anything, mock.AnythingOfType("string")).Return(model.ErrReservationNotFound).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInsufficientStock)
+			},
+		},
+		{
+			name:     "reserve_error",
+			userUUID: uuid.New(),
+			items:    []model.OrderItem{{PartUUID: partA, Quantity: 1}},
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				parts := []*model.Part{{UUID: partA.String(), Price: 10}}
+				inv.EXPECT().ListParts(s.ctx, mock.AnythingOfType("[]string")).Return(parts, nil).Once()
+				inv.EXPECT().
+					ReserveParts(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("[]model.OrderItem")).
+					Return(errors.New("inventory service down")).
+					Once()
+				// Склад мог принять резерв до ошибки, поэтому резерв снимается по ключу заказа
+				inv.EXPECT().ReleaseReservation(mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInventoryServiceUnavailable)
+			},
+		},
+		{
+			name:     "repository_error",
+			userUUID: uuid.New(),
+			items:    []model.OrderItem{{PartUUID: partA, Quantity: 1}},
 			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
-				parts := []*model.Part{{UUID: uuid.New().String(), Price: 10}}
+				parts := []*model.Part{{UUID: partA.String(), Price: 10}}
 				inv.EXPECT().ListParts(s.ctx, mock.AnythingOfType("[]string")).Return(parts, nil).Once()
-				repo.EXPECT().CreateOrder(s.ctx, mock.AnythingOfType("*model.Order")).Return(errors.New("database error")).Once()
+				inv.EXPECT().ReserveParts(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("[]model.OrderItem")).Return(nil).Once()
+				repo.EXPECT().CreateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("*model.OutboxMessage")).Return(errors.New("database error")).Once()
+				// Резерв снимается, так как заказ не был сохранен
+				inv.EXPECT().ReleaseReservation(s.ctx, mock.AnythingOfType("string")).Return(nil).Once()
 			},
 			wantOrder: nil,
 			checkErr: func(err error) {
@@ -71,7 +178,7 @@ func (s *ServiceTestSuite) TestCreateOrder() {
 		s.Run(tt.name, func() {
 			tt.setupMock(s.repo, s.inventoryClient, s.paymentClient)
 
-			got, err := s.service.CreateOrder(s.ctx, tt.userUUID, tt.partUUIDs)
+			got, err := s.service.CreateOrder(s.ctx, tt.userUUID, tt.items)
 
 			if tt.checkErr != nil {
 				tt.checkErr(err)
@@ -84,6 +191,8 @@ func (s *ServiceTestSuite) TestCreateOrder() {
 				require.NotNil(s.T(), got)
 				require.Equal(s.T(), tt.wantOrder.Status, got.Status)
 				require.Equal(s.T(), tt.userUUID, got.UserUUID)
+				require.Equal(s.T(), tt.wantOrder.Items, got.Items)
+				require.InDelta(s.T(), tt.wantOrder.TotalPrice, got.TotalPrice, 1e-9)
 			}
 		})
 	}
@@ -0,0 +1,42 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/order/internal/repository"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// ExpirePendingOrders отменяет до limit неоплаченных заказов, созданных раньше createdBefore.
+// Резерв деталей снимается, пока строка заказа заблокирована, и только после этого отмена
+// фиксируется от имени системы вместе с событием OrderCancelled в outbox.
+// Если снять резерв не удалось, заказ остается неоплаченным и будет обработан следующим запуском
+func (s *Service) ExpirePendingOrders(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	expired, err := s.orderRepository.CancelExpiredOrders(ctx, createdBefore, limit, systemStatusChange(), func(order *model.Order) (*model.OutboxMessage, error) {
+		if err := order.TransitionTo(model.StatusCancelled); err != nil {
+			return nil, err
+		}
+
+		// Снятие резерва идемпотентно, поэтому повтор после неудачного коммита безопасен
+		if err := s.inventoryClient.ReleaseReservation(ctx, order.OrderUUID.String()); err != nil {
+			logger.Warn(ctx, "Failed to release parts reservation of expired order, will retry",
+				zap.Error(err),
+				zap.String("order_uuid", order.OrderUUID.String()),
+			)
+
+			return nil, fmt.Errorf("%w: %w", repository.ErrSkipExpiredOrder, err)
+		}
+
+		return newOrderCancelledOutboxMessage(ctx, order, nil)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel expired orders: %w", err)
+	}
+
+	return len(expired), nil
+}
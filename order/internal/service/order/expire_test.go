@@ -0,0 +1,126 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientmocks "github.com/radiophysiker/microservices-homework/order/internal/client/grpc/mocks"
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/order/internal/repository"
+	repomocks "github.com/radiophysiker/microservices-homework/order/internal/repository/mocks"
+)
+
+// cancelExpiredWith возвращает реализацию CancelExpiredOrders, передающую заказы в handler
+// и проверяющую сформированные события. Пропущенные handler заказы не попадают в результат
+func (s *ServiceTestSuite) cancelExpiredWith(orders ...*model.Order) func(context.Context, time.Time, int, model.StatusChange, repository.ExpiredOrderHandler) ([]*model.Order, error) {
+	return func(_ context.Context, _ time.Time, _ int, change model.StatusChange, handler repository.ExpiredOrderHandler) ([]*model.Order, error) {
+		s.Require().Equal(model.ActorSystem, change.Actor)
+
+		cancelled := make([]*model.Order, 0, len(orders))
+
+		for _, order := range orders {
+			message, err := handler(order)
+			if errors.Is(err, repository.ErrSkipExpiredOrder) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			s.Require().Equal(model.EventTypeOrderCancelled, message.EventType)
+			s.Require().Equal(order.OrderUUID, message.AggregateUUID)
+
+			cancelled = append(cancelled, order)
+		}
+
+		return cancelled, nil
+	}
+}
+
+func (s *ServiceTestSuite) TestExpirePendingOrders() {
+	createdBefore := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		setupMock func(*repomocks.MockOrderRepository, *clientmocks.MockInventoryClient)
+		wantCount int
+		checkErr  func(err error)
+	}{
+		{
+			name: "success",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				first := &model.Order{OrderUUID: uuid.New(), UserUUID: uuid.New(), Status: model.StatusPendingPayment}
+				second := &model.Order{OrderUUID: uuid.New(), UserUUID: uuid.New(), Status: model.StatusPendingPayment}
+				repo.EXPECT().CancelExpiredOrders(s.ctx, createdBefore, 10, mock.AnythingOfType("model.StatusChange"), mock.Anything).
+					RunAndReturn(s.cancelExpiredWith(first, second)).Once()
+				inv.EXPECT().ReleaseReservation(s.ctx, first.OrderUUID.String()).Return(nil).Once()
+				inv.EXPECT().ReleaseReservation(s.ctx, second.OrderUUID.String()).Return(nil).Once()
+			},
+			wantCount: 2,
+		},
+		{
+			name: "nothing_expired",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				repo.EXPECT().CancelExpiredOrders(s.ctx, createdBefore, 10, mock.AnythingOfType("model.StatusChange"), mock.Anything).
+					RunAndReturn(s.cancelExpiredWith()).Once()
+			},
+			wantCount: 0,
+		},
+		{
+			name: "release_error_keeps_order_pending",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				failed := &model.Order{OrderUUID: uuid.New(), UserUUID: uuid.New(), Status: model.StatusPendingPayment}
+				released := &model.Order{OrderUUID: uuid.New(), UserUUID: uuid.New(), Status: model.StatusPendingPayment}
+				repo.EXPECT().CancelExpiredOrders(s.ctx, createdBefore, 10, mock.AnythingOfType("model.StatusChange"), mock.Anything).
+					RunAndReturn(s.cancelExpiredWith(failed, released)).Once()
+				inv.EXPECT().ReleaseReservation(s.ctx, failed.OrderUUID.String()).Return(errors.New("inventory service down")).Once()
+				inv.EXPECT().ReleaseReservation(s.ctx, released.OrderUUID.String()).Return(nil).Once()
+			},
+			wantCount: 1,
+		},
+		{
+			name: "paid_order_not_expired",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{OrderUUID: uuid.New(), UserUUID: uuid.New(), Status: model.StatusPaid}
+				repo.EXPECT().CancelExpiredOrders(s.ctx, createdBefore, 10, mock.AnythingOfType("model.StatusChange"), mock.Anything).
+					RunAndReturn(s.cancelExpiredWith(order)).Once()
+			},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInvalidStatusTransition)
+			},
+		},
+		{
+			name: "repository_error",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				repo.EXPECT().CancelExpiredOrders(s.ctx, createdBefore, 10, mock.AnythingOfType("model.StatusChange"), mock.Anything).
+					Return(nil, errors.New("database error")).Once()
+			},
+			checkErr: func(err error) {
+				assert.ErrorContains(s.T(), err, "failed to cancel expired orders")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock(s.repo, s.inventoryClient)
+
+			count, err := s.service.ExpirePendingOrders(s.ctx, createdBefore, 10)
+
+			if tt.checkErr != nil {
+				tt.checkErr(err)
+				return
+			}
+
+			require.NoError(s.T(), err)
+			require.Equal(s.T(), tt.wantCount, count)
+		})
+	}
+}
@@ -9,12 +9,21 @@ import (
 	"github.com/radiophysiker/microservices-homework/order/internal/model"
 )
 
-// GetOrder возвращает заказ по UUID
-func (s *Service) GetOrder(ctx context.Context, orderUUID uuid.UUID) (*model.Order, error) {
+// GetOrder возвращает заказ пользователя по UUID
+func (s *Service) GetOrder(ctx context.Context, userUUID, orderUUID uuid.UUID) (*model.Order, error) {
+	return s.getOwnedOrder(ctx, userUUID, orderUUID)
+}
+
+// getOwnedOrder возвращает заказ, если он принадлежит пользователю
+func (s *Service) getOwnedOrder(ctx context.Context, userUUID, orderUUID uuid.UUID) (*model.Order, error) {
 	order, err := s.orderRepository.GetOrder(ctx, orderUUID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get order: %w", err)
 	}
 
+	if order.UserUUID != userUUID {
+		return nil, fmt.Errorf("%w: %s", model.ErrOrderAccessDenied, orderUUID)
+	}
+
 	return order, nil
 }
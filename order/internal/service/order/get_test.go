@@ -26,7 +26,7 @@ func (s *ServiceTestSuite) TestGetOrder() {
 			setupMock: func(repo *repomocks.MockOrderRepository) {
 				want := &model.Order{
 					OrderUUID: uuid.New(),
-					UserUUID:  uuid.New(),
+					UserUUID:  s.userUUID,
 					Status:    model.StatusPendingPayment,
 				}
 				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(want, nil).Once()
@@ -35,6 +35,22 @@ func (s *ServiceTestSuite) TestGetOrder() {
 				Status: model.StatusPendingPayment,
 			},
 		},
+		{
+			name:      "another_user_order",
+			orderUUID: uuid.New(),
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				order := &model.Order{
+					OrderUUID: uuid.New(),
+					UserUUID:  uuid.New(),
+					Status:    model.StatusPendingPayment,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderAccessDenied)
+			},
+		},
 		{
 			name:      "repository_error",
 			orderUUID: uuid.New(),
@@ -54,7 +70,7 @@ func (s *ServiceTestSuite) TestGetOrder() {
 		s.Run(tt.name, func() {
 			tt.setupMock(s.repo)
 
-			got, err := s.service.GetOrder(s.ctx, tt.orderUUID)
+			got, err := s.service.GetOrder(s.ctx, s.userUUID, tt.orderUUID)
 
 			if tt.checkErr != nil {
 				tt.checkErr(err)
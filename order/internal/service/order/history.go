@@ -0,0 +1,25 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+)
+
+// GetOrderHistory возвращает историю статусов заказа пользователя
+func (s *Service) GetOrderHistory(ctx context.Context, userUUID, orderUUID uuid.UUID) ([]*model.StatusHistoryEntry, error) {
+	// Проверяем существование и владельца заказа, чтобы отличить "нет заказа" от пустой истории
+	if _, err := s.getOwnedOrder(ctx, userUUID, orderUUID); err != nil {
+		return nil, err
+	}
+
+	history, err := s.orderRepository.GetOrderHistory(ctx, orderUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order history: %w", err)
+	}
+
+	return history, nil
+}
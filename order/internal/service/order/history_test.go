@@ -0,0 +1,84 @@
+package order
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	repomocks "github.com/radiophysiker/microservices-homework/order/internal/repository/mocks"
+)
+
+func (s *ServiceTestSuite) TestGetOrderHistory() {
+	orderUUID := uuid.New()
+	pending := model.StatusPendingPayment
+	history := []*model.StatusHistoryEntry{
+		{OrderUUID: orderUUID, ToStatus: model.StatusPendingPayment, Actor: "user"},
+		{OrderUUID: orderUUID, FromStatus: &pending, ToStatus: model.StatusPaid, Actor: "user"},
+	}
+
+	tests := []struct {
+		name        string
+		setupMock   func(*repomocks.MockOrderRepository)
+		wantHistory []*model.StatusHistoryEntry
+		checkErr    func(err error)
+	}{
+		{
+			name: "success",
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				repo.EXPECT().GetOrder(s.ctx, orderUUID.String()).Return(&model.Order{OrderUUID: orderUUID, UserUUID: s.userUUID}, nil).Once()
+				repo.EXPECT().GetOrderHistory(s.ctx, orderUUID.String()).Return(history, nil).Once()
+			},
+			wantHistory: history,
+		},
+		{
+			name: "order_not_found",
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				repo.EXPECT().GetOrder(s.ctx, orderUUID.String()).Return(nil, model.ErrOrderNotFound).Once()
+			},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderNotFound)
+			},
+		},
+		{
+			name: "another_user_order",
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				repo.EXPECT().GetOrder(s.ctx, orderUUID.String()).Return(&model.Order{OrderUUID: orderUUID, UserUUID: uuid.New()}, nil).Once()
+			},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderAccessDenied)
+			},
+		},
+		{
+			name: "repository_error",
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				repo.EXPECT().GetOrder(s.ctx, orderUUID.String()).Return(&model.Order{OrderUUID: orderUUID, UserUUID: s.userUUID}, nil).Once()
+				repo.EXPECT().GetOrderHistory(s.ctx, orderUUID.String()).Return(nil, errors.New("database error")).Once()
+			},
+			checkErr: func(err error) {
+				assert.Error(s.T(), err)
+				assert.Contains(s.T(), err.Error(), "failed to get order history")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock(s.repo)
+
+			got, err := s.service.GetOrderHistory(s.ctx, s.userUUID, orderUUID)
+
+			if tt.checkErr != nil {
+				tt.checkErr(err)
+				require.Nil(s.T(), got)
+
+				return
+			}
+
+			require.NoError(s.T(), err)
+			require.Equal(s.T(), tt.wantHistory, got)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+)
+
+const (
+	// defaultListPageSize размер страницы списка заказов по умолчанию
+	defaultListPageSize = 20
+	// maxListPageSize максимальный размер страницы списка заказов
+	maxListPageSize = 100
+)
+
+// ListOrders возвращает страницу заказов по фильтру
+func (s *Service) ListOrders(ctx context.Context, filter model.OrderFilter, cursor *model.OrderCursor, pageSize int) (*model.OrderPage, error) {
+	if filter.UserUUID == uuid.Nil && !filter.AllUsers {
+		return nil, model.NewInvalidOrderDataError("user UUID is required")
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return nil, model.NewInvalidOrderDataError("created_from must be before created_to")
+	}
+
+	if filter.UpdatedFrom != nil && filter.UpdatedTo != nil && !filter.UpdatedFrom.Before(*filter.UpdatedTo) {
+		return nil, model.NewInvalidOrderDataError("updated_from must be before updated_to")
+	}
+
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultListPageSize
+	case pageSize > maxListPageSize:
+		pageSize = maxListPageSize
+	}
+
+	// Запрашиваем на один заказ больше, чтобы понять, есть ли следующая страница
+	orders, err := s.orderRepository.ListOrders(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+
+	page := &model.OrderPage{Orders: orders}
+
+	if len(orders) > pageSize {
+		page.Orders = orders[:pageSize]
+		last := page.Orders[pageSize-1]
+		page.NextCursor = &model.OrderCursor{
+			CreatedAt: last.CreatedAt,
+			OrderUUID: last.OrderUUID,
+		}
+	}
+
+	return page, nil
+}
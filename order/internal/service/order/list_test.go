@@ -0,0 +1,167 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	repomocks "github.com/radiophysiker/microservices-homework/order/internal/repository/mocks"
+)
+
+func (s *ServiceTestSuite) TestListOrders() {
+	userUUID := uuid.New()
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	cursor := &model.OrderCursor{CreatedAt: now, OrderUUID: uuid.New()}
+
+	newOrders := func(n int) []*model.Order {
+		orders := make([]*model.Order, 0, n)
+		for i := range n {
+			orders = append(orders, &model.Order{
+				OrderUUID: uuid.New(),
+				UserUUID:  userUUID,
+				Status:    model.StatusPaid,
+				CreatedAt: now.Add(-time.Duration(i) * time.Minute),
+			})
+		}
+
+		return orders
+	}
+
+	tests := []struct {
+		name          string
+		filter        model.OrderFilter
+		cursor        *model.OrderCursor
+		pageSize      int
+		setupMock     func(*repomocks.MockOrderRepository)
+		wantOrders    int
+		wantNextAfter int
+		checkErr      func(err error)
+	}{
+		{
+			name:     "last_page",
+			filter:   model.OrderFilter{UserUUID: userUUID, Statuses: []model.Status{model.StatusPaid}},
+			pageSize: 3,
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				filter := model.OrderFilter{UserUUID: userUUID, Statuses: []model.Status{model.StatusPaid}}
+				repo.EXPECT().ListOrders(s.ctx, filter, (*model.OrderCursor)(nil), 4).Return(newOrders(2), nil).Once()
+			},
+			wantOrders:    2,
+			wantNextAfter: -1,
+		},
+		{
+			name:     "has_next_page",
+			filter:   model.OrderFilter{UserUUID: userUUID},
+			cursor:   cursor,
+			pageSize: 2,
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				repo.EXPECT().ListOrders(s.ctx, model.OrderFilter{UserUUID: userUUID}, cursor, 3).Return(newOrders(3), nil).Once()
+			},
+			wantOrders:    2,
+			wantNextAfter: 1,
+		},
+		{
+			name:     "default_page_size",
+			filter:   model.OrderFilter{UserUUID: userUUID},
+			pageSize: 0,
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				repo.EXPECT().ListOrders(s.ctx, model.OrderFilter{UserUUID: userUUID}, (*model.OrderCursor)(nil), defaultListPageSize+1).
+					Return(newOrders(1), nil).Once()
+			},
+			wantOrders:    1,
+			wantNextAfter: -1,
+		},
+		{
+			name:     "page_size_capped",
+			filter:   model.OrderFilter{UserUUID: userUUID},
+			pageSize: 1000,
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				repo.EXPECT().ListOrders(s.ctx, model.OrderFilter{UserUUID: userUUID}, (*model.OrderCursor)(nil), maxListPageSize+1).
+					Return(newOrders(0), nil).Once()
+			},
+			wantOrders:    0,
+			wantNextAfter: -1,
+		},
+		{
+			name:      "missing_user",
+			filter:    model.OrderFilter{},
+			setupMock: func(repo *repomocks.MockOrderRepository) {},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInvalidOrderData)
+			},
+		},
+		{
+			name:     "all_users_updated_in_window",
+			filter:   model.OrderFilter{AllUsers: true, Statuses: []model.Status{model.StatusPaid}, UpdatedFrom: &earlier, UpdatedTo: &now},
+			pageSize: 3,
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				filter := model.OrderFilter{AllUsers: true, Statuses: []model.Status{model.StatusPaid}, UpdatedFrom: &earlier, UpdatedTo: &now}
+				repo.EXPECT().ListOrders(s.ctx, filter, (*model.OrderCursor)(nil), 4).Return(newOrders(2), nil).Once()
+			},
+			wantOrders:    2,
+			wantNextAfter: -1,
+		},
+		{
+			name:      "invalid_updated_range",
+			filter:    model.OrderFilter{AllUsers: true, UpdatedFrom: &now, UpdatedTo: &now},
+			setupMock: func(repo *repomocks.MockOrderRepository) {},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInvalidOrderData)
+			},
+		},
+		{
+			name:      "invalid_created_range",
+			filter:    model.OrderFilter{UserUUID: userUUID, CreatedFrom: &now, CreatedTo: &earlier},
+			setupMock: func(repo *repomocks.MockOrderRepository) {},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInvalidOrderData)
+			},
+		},
+		{
+			name:   "repository_error",
+			filter: model.OrderFilter{UserUUID: userUUID},
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				repo.EXPECT().ListOrders(s.ctx, model.OrderFilter{UserUUID: userUUID}, (*model.OrderCursor)(nil), defaultListPageSize+1).
+					Return(nil, errors.New("database error")).Once()
+			},
+			checkErr: func(err error) {
+				assert.Error(s.T(), err)
+				assert.Contains(s.T(), err.Error(), "failed to list orders")
+				assert.Contains(s.T(), err.Error(), "database error")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock(s.repo)
+
+			page, err := s.service.ListOrders(s.ctx, tt.filter, tt.cursor, tt.pageSize)
+
+			if tt.checkErr != nil {
+				tt.checkErr(err)
+				require.Nil(s.T(), page)
+
+				return
+			}
+
+			require.NoError(s.T(), err)
+			require.NotNil(s.T(), page)
+			require.Len(s.T(), page.Orders, tt.wantOrders)
+
+			if tt.wantNextAfter < 0 {
+				require.Nil(s.T(), page.NextCursor)
+				return
+			}
+
+			last := page.Orders[tt.wantNextAfter]
+			require.NotNil(s.T(), page.NextCursor)
+			require.Equal(s.T(), last.OrderUUID, page.NextCursor.OrderUUID)
+			require.Equal(s.T(), last.CreatedAt, page.NextCursor.CreatedAt)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package order
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
+)
+
+// outboxTraceContext сохраняет контекст трассировки запроса вместе с событием,
+// чтобы outbox relay опубликовал его в том же трейсе
+func outboxTraceContext(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	tracing.Inject(ctx, carrier)
+
+	return carrier
+}
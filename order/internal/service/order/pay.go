@@ -2,40 +2,54 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/radiophysiker/microservices-homework/order/internal/converter"
+	"github.com/radiophysiker/microservices-homework/order/internal/converter/kafka/encoder"
 	"github.com/radiophysiker/microservices-homework/order/internal/model"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	paymentpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
 )
 
-// PayOrder проводит оплату заказа
-func (s *Service) PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMethod model.PaymentMethod) (*model.Order, error) {
-	// Получаем заказ
-	order, err := s.orderRepository.GetOrder(ctx, orderUUID.String())
+// PayOrder проводит оплату заказа пользователя. Если payment service подтверждает
+// оплату асинхронно, заказ переходит в PAYMENT_PROCESSING и ждет события с результатом.
+// Токен карты cardToken передается в payment service без сохранения в заказе
+func (s *Service) PayOrder(ctx context.Context, userUUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod, cardToken string) (*model.Order, error) {
+	// Получаем заказ и проверяем, что он принадлежит пользователю
+	order, err := s.getOwnedOrder(ctx, userUUID, orderUUID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get order: %w", err)
+		return nil, err
 	}
 
-	if order.Status == model.StatusPaid {
-		return nil, model.ErrOrderCannotBePaid
-	}
-
-	if order.Status == model.StatusCancelled {
-		return nil, model.ErrOrderCannotBePaid
+	// Проверяем переход до списания средств, чтобы не провести лишний платеж.
+	// Оплата начинается только из PENDING_PAYMENT, в том числе пока идет асинхронная оплата
+	if err = model.ValidateTransition(order.Status, model.StatusPaymentProcessing); err != nil {
+		return nil, err
 	}
 
 	// Проводим оплату через payment service
-	transactionUUID, err := s.paymentClient.PayOrder(
+	transactionUUID, transactionStatus, err := s.paymentClient.PayOrder(
 		ctx,
 		order.UserUUID.String(),
 		order.OrderUUID.String(),
 		converter.PaymentMethodToProtobuf(paymentMethod),
+		converter.PriceToMinorUnits(order.TotalPrice),
+		model.OrderCurrency,
+		cardToken,
 	)
 	if err != nil {
+		// Отказ провайдера не меняет статус заказа: его можно оплатить другим способом.
+		// Некорректные параметры и чужая оплата заказа не связаны с доступностью payment service
+		if errors.Is(err, model.ErrPaymentDeclined) ||
+			errors.Is(err, model.ErrOrderAlreadyPaid) ||
+			errors.Is(err, model.ErrInvalidPaymentRequest) {
+			return nil, err
+		}
+
 		return nil, fmt.Errorf("%w: %w", model.ErrPaymentServiceUnavailable, err)
 	}
 
@@ -45,35 +59,120 @@ func (s *Service) PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMeth
 		return nil, fmt.Errorf("invalid transaction UUID: %w", err)
 	}
 
-	order.TransactionUUID = &parsedTransactionUUID
-	order.PaymentMethod = &paymentMethod
-	order.Status = model.StatusPaid
+	if transactionStatus == paymentpb.TransactionStatus_TRANSACTION_STATUS_PENDING {
+		return s.markPaymentProcessing(ctx, order, userStatusChange(userUUID), parsedTransactionUUID, paymentMethod)
+	}
 
-	updated, err := s.orderRepository.UpdateOrder(ctx, order)
+	updated, err := s.updateWithRetry(ctx, order, userStatusChange(userUUID), markPaid(ctx, parsedTransactionUUID, paymentMethod))
 	if err != nil {
-		return nil, fmt.Errorf("failed to update order: %w", err)
+		// Платеж уже проведен, но заказ не удалось перевести в PAID
+		logger.Error(ctx, "Payment captured but order was not marked as paid",
+			zap.Error(err),
+			zap.String("order_uuid", orderUUID.String()),
+			zap.String("transaction_uuid", transactionUUID),
+		)
+
+		return nil, err
 	}
 
-	eventUUID := uuid.New()
-	orderPaidEvent := model.OrderPaid{
-		EventUUID:       eventUUID,
-		OrderUUID:       updated.OrderUUID,
-		UserUUID:        updated.UserUUID,
-		PaymentMethod:   *updated.PaymentMethod,
-		TransactionUUID: *updated.TransactionUUID,
+	s.finishPaidOrder(ctx, order.OrderUUID, updated.TotalPrice)
+
+	return updated, nil
+}
+
+// markPaymentProcessing переводит заказ в PAYMENT_PROCESSING до получения результата асинхронной оплаты
+func (s *Service) markPaymentProcessing(ctx context.Context, order *model.Order, change model.StatusChange, transactionUUID uuid.UUID, paymentMethod model.PaymentMethod) (*model.Order, error) {
+	updated, err := s.updateWithRetry(ctx, order, change, func(order *model.Order) (*model.OutboxMessage, error) {
+		if err := order.TransitionTo(model.StatusPaymentProcessing); err != nil {
+			return nil, err
+		}
+
+		order.TransactionUUID = &transactionUUID
+		order.PaymentMethod = &paymentMethod
+
+		return nil, nil
+	})
+	if err != nil {
+		logger.Error(ctx, "Payment started but order was not marked as processing",
+			zap.Error(err),
+			zap.String("order_uuid", order.OrderUUID.String()),
+			zap.String("transaction_uuid", transactionUUID.String()),
+		)
+
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// markPaid возвращает мутацию, переводящую заказ в PAID по проведенной транзакции.
+// Событие OrderPaid сохраняется в outbox в одной транзакции с заказом
+// и публикуется в Kafka фоновым relay
+func markPaid(ctx context.Context, transactionUUID uuid.UUID, paymentMethod model.PaymentMethod) orderMutation {
+	return func(order *model.Order) (*model.OutboxMessage, error) {
+		if err := order.TransitionTo(model.StatusPaid); err != nil {
+			return nil, err
+		}
+
+		order.TransactionUUID = &transactionUUID
+		order.PaymentMethod = &paymentMethod
+
+		return newOrderPaidOutboxMessage(ctx, order)
 	}
+}
 
-	if err := s.orderProducer.ProduceOrderPaid(ctx, orderPaidEvent); err != nil {
-		logger.Error(ctx, "Failed to publish OrderPaid event",
+// finishPaidOrder окончательно списывает детали оплаченного заказа со склада и учитывает выручку.
+// Оплата уже проведена, поэтому ошибка подтверждения резерва только логируется:
+// резерв можно подтвердить повторно
+func (s *Service) finishPaidOrder(ctx context.Context, orderUUID uuid.UUID, totalPrice float64) {
+	if err := s.commitReservation(ctx, orderUUID); err != nil {
+		logger.Error(ctx, "Failed to commit parts reservation",
 			zap.Error(err),
-			zap.String("order_uuid", updated.OrderUUID.String()),
-			zap.String("event_uuid", eventUUID.String()),
+			zap.String("order_uuid", orderUUID.String()),
 		)
 	}
 
+	s.recordRevenue(ctx, totalPrice)
+}
+
+// commitReservation окончательно списывает со склада детали оплаченного заказа.
+// Подтверждение резерва идемпотентно, поэтому его можно повторять
+func (s *Service) commitReservation(ctx context.Context, orderUUID uuid.UUID) error {
+	if err := s.inventoryClient.CommitReservation(ctx, orderUUID.String()); err != nil {
+		return fmt.Errorf("%w: %w", model.ErrInventoryServiceUnavailable, err)
+	}
+
+	return nil
+}
+
+// recordRevenue учитывает выручку оплаченного заказа
+func (s *Service) recordRevenue(ctx context.Context, totalPrice float64) {
 	if s.revenueCounter != nil {
-		s.revenueCounter.Add(ctx, updated.TotalPrice)
+		s.revenueCounter.Add(ctx, totalPrice)
 	}
+}
 
-	return updated, nil
+// newOrderPaidOutboxMessage формирует outbox-сообщение с событием OrderPaid
+func newOrderPaidOutboxMessage(ctx context.Context, order *model.Order) (*model.OutboxMessage, error) {
+	orderPaidEvent := model.OrderPaid{
+		EventUUID:       uuid.New(),
+		OrderUUID:       order.OrderUUID,
+		UserUUID:        order.UserUUID,
+		PaymentMethod:   *order.PaymentMethod,
+		TransactionUUID: *order.TransactionUUID,
+	}
+
+	payload, err := encoder.EncodeOrderPaid(orderPaidEvent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode OrderPaid: %w", err)
+	}
+
+	return &model.OutboxMessage{
+		UUID:          orderPaidEvent.EventUUID,
+		AggregateUUID: orderPaidEvent.OrderUUID,
+		EventType:     model.EventTypeOrderPaid,
+		Key:           []byte(orderPaidEvent.OrderUUID.String()),
+		Payload:       payload,
+		TraceContext:  outboxTraceContext(ctx),
+	}, nil
 }
@@ -2,6 +2,7 @@ package order
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -15,11 +16,14 @@ import (
 )
 
 func (s *ServiceTestSuite) TestPayOrder() {
+	// cardToken - токен карты, который передается в payment service без изменений
+	const cardToken = "tok_4242"
+
 	tests := []struct {
 		name          string
 		orderUUID     uuid.UUID
 		paymentMethod model.PaymentMethod
-		setupMock     func(*repomocks.MockOrderRepository, *clientmocks.MockPaymentClient)
+		setupMock     func(*repomocks.MockOrderRepository, *clientmocks.MockInventoryClient, *clientmocks.MockPaymentClient)
 		wantOrder     *model.Order
 		checkErr      func(err error)
 	}{
@@ -27,26 +31,116 @@ func (s *ServiceTestSuite) TestPayOrder() {
 			name:          "success",
 			orderUUID:     uuid.New(),
 			paymentMethod: model.PaymentMethodCard,
-			setupMock: func(repo *repomocks.MockOrderRepository, pay *clientmocks.MockPaymentClient) {
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
 				order := &model.Order{
 					OrderUUID:  uuid.New(),
-					UserUUID:   uuid.New(),
+					UserUUID:   s.userUUID,
+					Status:     model.StatusPendingPayment,
+					TotalPrice: 1299.99,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				// Сумма передается в копейках без ошибки округления float64
+				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.MatchedBy(func(pm paymentpb.PaymentMethod) bool { return true }), int64(129999), model.OrderCurrency, cardToken).Return("550e8400-e29b-41d4-a716-446655440000", paymentpb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange"), mock.MatchedBy(func(msg *model.OutboxMessage) bool {
+					return msg.EventType == model.EventTypeOrderPaid && msg.AggregateUUID == order.OrderUUID && len(msg.Payload) > 0
+				})).Return(&model.Order{Status: model.StatusPaid}, nil).Once()
+				inv.EXPECT().CommitReservation(s.ctx, mock.AnythingOfType("string")).Return(nil).Once()
+			},
+			wantOrder: &model.Order{
+				Status: model.StatusPaid,
+			},
+		},
+		{
+			name:          "commit_reservation_error_ignored",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodCard,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID:  uuid.New(),
+					UserUUID:   s.userUUID,
 					Status:     model.StatusPendingPayment,
 					TotalPrice: 100,
 				}
 				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
-				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.MatchedBy(func(pm paymentpb.PaymentMethod) bool { return true })).Return("550e8400-e29b-41d4-a716-446655440000", nil).Once()
-				repo.EXPECT().UpdateOrder(s.ctx, mock.AnythingOfType("*model.Order")).Return(&model.Order{Status: model.StatusPaid}, nil).Once()
+				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.MatchedBy(func(pm paymentpb.PaymentMethod) bool { return true }), int64(10000), model.OrderCurrency, cardToken).Return("550e8400-e29b-41d4-a716-446655440000", paymentpb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return(&model.Order{Status: model.StatusPaid}, nil).Once()
+				// Оплата уже проведена, поэтому ошибка подтверждения резерва не прерывает операцию
+				inv.EXPECT().CommitReservation(s.ctx, mock.AnythingOfType("string")).Return(errors.New("inventory service down")).Once()
 			},
 			wantOrder: &model.Order{
 				Status: model.StatusPaid,
 			},
 		},
+		{
+			name:          "update_order_error",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodSBP,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID:  uuid.New(),
+					UserUUID:   s.userUUID,
+					Status:     model.StatusPendingPayment,
+					TotalPrice: 100,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.MatchedBy(func(pm paymentpb.PaymentMethod) bool { return true }), int64(10000), model.OrderCurrency, cardToken).Return("550e8400-e29b-41d4-a716-446655440000", paymentpb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return((*model.Order)(nil), errors.New("database error")).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.Error(s.T(), err)
+				assert.Contains(s.T(), err.Error(), "failed to update order")
+				assert.Contains(s.T(), err.Error(), "database error")
+			},
+		},
+		{
+			name:          "pending_async_payment",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodSBP,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID:  uuid.New(),
+					UserUUID:   s.userUUID,
+					Status:     model.StatusPendingPayment,
+					TotalPrice: 100,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), paymentpb.PaymentMethod_PAYMENT_METHOD_SBP, int64(10000), model.OrderCurrency, cardToken).
+					Return("550e8400-e29b-41d4-a716-446655440000", paymentpb.TransactionStatus_TRANSACTION_STATUS_PENDING, nil).Once()
+				// До результата оплаты событие OrderPaid не публикуется, а резерв не подтверждается
+				repo.EXPECT().UpdateOrder(s.ctx, mock.MatchedBy(func(o *model.Order) bool {
+					return o.Status == model.StatusPaymentProcessing &&
+						o.TransactionUUID != nil && o.TransactionUUID.String() == "550e8400-e29b-41d4-a716-446655440000" &&
+						o.PaymentMethod != nil && *o.PaymentMethod == model.PaymentMethodSBP
+				}), mock.AnythingOfType("model.StatusChange")).Return(&model.Order{Status: model.StatusPaymentProcessing}, nil).Once()
+			},
+			wantOrder: &model.Order{
+				Status: model.StatusPaymentProcessing,
+			},
+		},
+		{
+			name:          "payment_processing_cannot_be_paid_again",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodCard,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID:  uuid.New(),
+					UserUUID:   s.userUUID,
+					Status:     model.StatusPaymentProcessing,
+					TotalPrice: 100,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderCannotBePaid)
+			},
+		},
 		{
 			name:          "get_order_error",
 			orderUUID:     uuid.New(),
 			paymentMethod: model.PaymentMethodCard,
-			setupMock: func(repo *repomocks.MockOrderRepository, pay *clientmocks.MockPaymentClient) {
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
 				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return((*model.Order)(nil), errors.New("order not found")).Once()
 			},
 			wantOrder: nil,
@@ -57,10 +151,28 @@ func (s *ServiceTestSuite) TestPayOrder() {
 			},
 		},
 		{
-			name:          "payment_error",
+			name:          "order_cancelled_cannot_be_paid",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodCard,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID: uuid.New(),
+					UserUUID:  s.userUUID,
+					Status:    model.StatusCancelled,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderCannotBePaid)
+				assert.ErrorIs(s.T(), err, model.ErrInvalidStatusTransition)
+			},
+		},
+		{
+			name:          "another_user_order",
 			orderUUID:     uuid.New(),
 			paymentMethod: model.PaymentMethodCard,
-			setupMock: func(repo *repomocks.MockOrderRepository, pay *clientmocks.MockPaymentClient) {
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
 				order := &model.Order{
 					OrderUUID:  uuid.New(),
 					UserUUID:   uuid.New(),
@@ -68,7 +180,25 @@ func (s *ServiceTestSuite) TestPayOrder() {
 					TotalPrice: 100,
 				}
 				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
-				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.MatchedBy(func(pm paymentpb.PaymentMethod) bool { return true })).Return("", errors.New("payment failed")).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrOrderAccessDenied)
+			},
+		},
+		{
+			name:          "payment_error",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodCard,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID:  uuid.New(),
+					UserUUID:   s.userUUID,
+					Status:     model.StatusPendingPayment,
+					TotalPrice: 100,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.MatchedBy(func(pm paymentpb.PaymentMethod) bool { return true }), int64(10000), model.OrderCurrency, cardToken).Return("", paymentpb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED, errors.New("payment failed")).Once()
 			},
 			wantOrder: nil,
 			checkErr: func(err error) {
@@ -77,13 +207,136 @@ func (s *ServiceTestSuite) TestPayOrder() {
 				assert.Contains(s.T(), err.Error(), "payment failed")
 			},
 		},
+		{
+			name:          "payment_declined",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodCreditCard,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID:  uuid.New(),
+					UserUUID:   s.userUUID,
+					Status:     model.StatusPendingPayment,
+					TotalPrice: 100,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), paymentpb.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD, int64(10000), model.OrderCurrency, cardToken).
+					Return("", paymentpb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED, fmt.Errorf("%w: decline code: LIMIT_EXCEEDED", model.ErrPaymentDeclined)).Once()
+				// Заказ не обновляется и остается в ожидании оплаты
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				require.ErrorIs(s.T(), err, model.ErrPaymentDeclined)
+				require.NotErrorIs(s.T(), err, model.ErrPaymentServiceUnavailable)
+				assert.Contains(s.T(), err.Error(), "LIMIT_EXCEEDED")
+			},
+		},
+		{
+			name:          "order_already_paid_in_payment_service",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodCard,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID:  uuid.New(),
+					UserUUID:   s.userUUID,
+					Status:     model.StatusPendingPayment,
+					TotalPrice: 100,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), paymentpb.PaymentMethod_PAYMENT_METHOD_CARD, int64(10000), model.OrderCurrency, cardToken).
+					Return("", paymentpb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED, fmt.Errorf("%w: order already paid", model.ErrOrderAlreadyPaid)).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				require.ErrorIs(s.T(), err, model.ErrOrderAlreadyPaid)
+				require.NotErrorIs(s.T(), err, model.ErrPaymentServiceUnavailable)
+			},
+		},
+		{
+			name:          "invalid_payment_request",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodCard,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID:  uuid.New(),
+					UserUUID:   s.userUUID,
+					Status:     model.StatusPendingPayment,
+					TotalPrice: 100,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), paymentpb.PaymentMethod_PAYMENT_METHOD_CARD, int64(10000), model.OrderCurrency, cardToken).
+					Return("", paymentpb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED, fmt.Errorf("%w: card token is required", model.ErrInvalidPaymentRequest)).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				require.ErrorIs(s.T(), err, model.ErrInvalidPaymentRequest)
+				require.NotErrorIs(s.T(), err, model.ErrPaymentServiceUnavailable)
+			},
+		},
+		{
+			name:          "payment_rejected_by_risk_rules",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodCard,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID:  uuid.New(),
+					UserUUID:   s.userUUID,
+					Status:     model.StatusPendingPayment,
+					TotalPrice: 100,
+				}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), paymentpb.PaymentMethod_PAYMENT_METHOD_CARD, int64(10000), model.OrderCurrency, cardToken).
+					Return("", paymentpb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED, &model.PaymentDeclinedError{
+						Reason:  "DAILY_LIMIT_EXCEEDED",
+						Message: "rejected by risk rules: DAILY_LIMIT_EXCEEDED",
+					}).Once()
+			},
+			wantOrder: nil,
+			checkErr: func(err error) {
+				var declined *model.PaymentDeclinedError
+
+				require.ErrorIs(s.T(), err, model.ErrPaymentDeclined)
+				require.ErrorAs(s.T(), err, &declined)
+				assert.Equal(s.T(), "DAILY_LIMIT_EXCEEDED", declined.Reason)
+			},
+		},
+		{
+			name:          "version_conflict_retried",
+			orderUUID:     uuid.New(),
+			paymentMethod: model.PaymentMethodCard,
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient, pay *clientmocks.MockPaymentClient) {
+				order := &model.Order{
+					OrderUUID:  uuid.New(),
+					UserUUID:   s.userUUID,
+					Status:     model.StatusPendingPayment,
+					TotalPrice: 100,
+					Version:    1,
+				}
+				fresh := &model.Order{
+					OrderUUID:  order.OrderUUID,
+					UserUUID:   order.UserUUID,
+					Status:     model.StatusPendingPayment,
+					TotalPrice: 100,
+					Version:    2,
+				}
+				conflict := &model.OrderVersionConflictError{OrderUUID: order.OrderUUID.String(), ExpectedVersion: 1, ActualVersion: 2}
+				repo.EXPECT().GetOrder(s.ctx, mock.AnythingOfType("string")).Return(order, nil).Once()
+				pay.EXPECT().PayOrder(s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.MatchedBy(func(pm paymentpb.PaymentMethod) bool { return true }), int64(10000), model.OrderCurrency, cardToken).Return("550e8400-e29b-41d4-a716-446655440000", paymentpb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, order, mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return((*model.Order)(nil), conflict).Once()
+				repo.EXPECT().GetOrder(s.ctx, order.OrderUUID.String()).Return(fresh, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, fresh, mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).Return(&model.Order{Status: model.StatusPaid, Version: 3}, nil).Once()
+				inv.EXPECT().CommitReservation(s.ctx, order.OrderUUID.String()).Return(nil).Once()
+			},
+			wantOrder: &model.Order{
+				Status: model.StatusPaid,
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
-			tt.setupMock(s.repo, s.paymentClient)
+			tt.setupMock(s.repo, s.inventoryClient, s.paymentClient)
 
-			got, err := s.service.PayOrder(s.ctx, tt.orderUUID, tt.paymentMethod)
+			got, err := s.service.PayOrder(s.ctx, s.userUUID, tt.orderUUID, tt.paymentMethod, cardToken)
 
 			if tt.checkErr != nil {
 				tt.checkErr(err)
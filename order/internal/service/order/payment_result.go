@@ -0,0 +1,139 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	paymentpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+// CompletePayment переводит заказ в PAID по событию PaymentCompleted асинхронной оплаты
+// и подтверждает резерв деталей. Ошибка подтверждения резерва возвращается, чтобы событие
+// ушло на повтор: повторная обработка уже оплаченного заказа подтверждает резерв снова.
+// Возвращает nil без ошибки, если событие уже учтено или относится не к текущей оплате заказа
+func (s *Service) CompletePayment(ctx context.Context, event *model.PaymentCompleted) (*model.Order, error) {
+	order, err := s.orderRepository.GetOrder(ctx, event.OrderUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if isPaidBy(order, event.TransactionUUID) {
+		return nil, s.commitReservation(ctx, order.OrderUUID)
+	}
+
+	// Событие может опередить перевод заказа в PAYMENT_PROCESSING,
+	// поэтому заказ в ожидании оплаты без транзакции тоже принимается,
+	// но только если payment service подтверждает транзакцию этого заказа
+	awaitingResult := order.Status == model.StatusPendingPayment && order.TransactionUUID == nil
+	if !awaitingResult && !isProcessingPayment(order, event.TransactionUUID) {
+		logger.Info(ctx, "Order is not awaiting payment result, skipping PaymentCompleted event",
+			zap.String("order_uuid", order.OrderUUID.String()),
+			zap.String("order_status", order.Status.String()),
+			zap.String("transaction_uuid", event.TransactionUUID.String()),
+			zap.String("event_uuid", event.EventUUID.String()),
+		)
+
+		return nil, nil
+	}
+
+	if awaitingResult {
+		confirmed, err := s.isSucceededPayment(ctx, order.OrderUUID, event.TransactionUUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !confirmed {
+			logger.Warn(ctx, "Payment transaction is not confirmed by payment service, skipping PaymentCompleted event",
+				zap.String("order_uuid", order.OrderUUID.String()),
+				zap.String("transaction_uuid", event.TransactionUUID.String()),
+				zap.String("event_uuid", event.EventUUID.String()),
+			)
+
+			return nil, nil
+		}
+	}
+
+	change := paymentStatusChange(event.EventUUID)
+
+	updated, err := s.updateWithRetry(ctx, order, change, markPaid(ctx, event.TransactionUUID, event.PaymentMethod))
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordRevenue(ctx, updated.TotalPrice)
+
+	if err := s.commitReservation(ctx, order.OrderUUID); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// FailPayment возвращает заказ в PENDING_PAYMENT по событию PaymentFailed асинхронной оплаты,
+// после чего заказ можно оплатить повторно. Возвращает nil без ошибки, если событие
+// относится не к текущей оплате заказа
+func (s *Service) FailPayment(ctx context.Context, event *model.PaymentFailed) (*model.Order, error) {
+	order, err := s.orderRepository.GetOrder(ctx, event.OrderUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if !isProcessingPayment(order, event.TransactionUUID) {
+		logger.Info(ctx, "Order is not awaiting payment result, skipping PaymentFailed event",
+			zap.String("order_uuid", order.OrderUUID.String()),
+			zap.String("order_status", order.Status.String()),
+			zap.String("transaction_uuid", event.TransactionUUID.String()),
+			zap.String("event_uuid", event.EventUUID.String()),
+		)
+
+		return nil, nil
+	}
+
+	change := paymentStatusChange(event.EventUUID)
+
+	return s.updateWithRetry(ctx, order, change, func(order *model.Order) (*model.OutboxMessage, error) {
+		if err := order.TransitionTo(model.StatusPendingPayment); err != nil {
+			return nil, err
+		}
+
+		order.TransactionUUID = nil
+		order.PaymentMethod = nil
+
+		return nil, nil
+	})
+}
+
+// isSucceededPayment проверяет по журналу payment service, что транзакция проведена и оплачивает этот заказ
+func (s *Service) isSucceededPayment(ctx context.Context, orderUUID, transactionUUID uuid.UUID) (bool, error) {
+	transaction, err := s.paymentClient.GetTransaction(ctx, transactionUUID.String())
+	if err != nil {
+		if errors.Is(err, model.ErrPaymentTransactionNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("%w: %w", model.ErrPaymentServiceUnavailable, err)
+	}
+
+	return transaction.OrderUUID == orderUUID.String() &&
+		transaction.Status == paymentpb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED, nil
+}
+
+// isPaidBy проверяет, что заказ уже оплачен именно этой транзакцией
+func isPaidBy(order *model.Order, transactionUUID uuid.UUID) bool {
+	return order.Status == model.StatusPaid &&
+		order.TransactionUUID != nil &&
+		*order.TransactionUUID == transactionUUID
+}
+
+// isProcessingPayment проверяет, что заказ ждет результата именно этой транзакции
+func isProcessingPayment(order *model.Order, transactionUUID uuid.UUID) bool {
+	return order.Status == model.StatusPaymentProcessing &&
+		order.TransactionUUID != nil &&
+		*order.TransactionUUID == transactionUUID
+}
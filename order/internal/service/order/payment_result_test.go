@@ -0,0 +1,353 @@
+package order
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientmocks "github.com/radiophysiker/microservices-homework/order/internal/client/grpc/mocks"
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	repomocks "github.com/radiophysiker/microservices-homework/order/internal/repository/mocks"
+	paymentpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+// isPaymentChange проверяет, что статус меняется по событию с результатом оплаты
+func isPaymentChange(eventUUID uuid.UUID) func(model.StatusChange) bool {
+	return func(change model.StatusChange) bool {
+		return change.Actor == model.ActorPaymentConsumer && change.EventUUID != nil && *change.EventUUID == eventUUID
+	}
+}
+
+func (s *ServiceTestSuite) TestCompletePayment() {
+	transactionUUID := uuid.New()
+	event := &model.PaymentCompleted{
+		EventUUID:       uuid.New(),
+		TransactionUUID: transactionUUID,
+		OrderUUID:       uuid.New(),
+		UserUUID:        s.userUUID,
+		PaymentMethod:   model.PaymentMethodSBP,
+	}
+	sbp := model.PaymentMethodSBP
+
+	tests := []struct {
+		name      string
+		setupMock func(*repomocks.MockOrderRepository, *clientmocks.MockInventoryClient)
+		wantOrder *model.Order
+		checkErr  func(err error)
+	}{
+		{
+			name: "processing_order_marked_paid",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{
+					OrderUUID:       event.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaymentProcessing,
+					TransactionUUID: &transactionUUID,
+					PaymentMethod:   &sbp,
+					TotalPrice:      100,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, order, mock.MatchedBy(isPaymentChange(event.EventUUID)), mock.MatchedBy(func(msg *model.OutboxMessage) bool {
+					return msg.EventType == model.EventTypeOrderPaid && msg.AggregateUUID == order.OrderUUID
+				})).Return(&model.Order{OrderUUID: order.OrderUUID, Status: model.StatusPaid}, nil).Once()
+				inv.EXPECT().CommitReservation(s.ctx, event.OrderUUID.String()).Return(nil).Once()
+			},
+			wantOrder: &model.Order{Status: model.StatusPaid},
+		},
+		{
+			name: "event_ahead_of_processing_status",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{
+					OrderUUID: event.OrderUUID,
+					UserUUID:  s.userUUID,
+					Status:    model.StatusPendingPayment,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				s.paymentClient.EXPECT().GetTransaction(s.ctx, transactionUUID.String()).Return(&model.PaymentTransaction{
+					TransactionUUID: transactionUUID.String(),
+					OrderUUID:       event.OrderUUID.String(),
+					Status:          paymentpb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED,
+				}, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, mock.MatchedBy(func(o *model.Order) bool {
+					return o.Status == model.StatusPaid && *o.TransactionUUID == transactionUUID && *o.PaymentMethod == model.PaymentMethodSBP
+				}), mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).
+					Return(&model.Order{OrderUUID: order.OrderUUID, Status: model.StatusPaid}, nil).Once()
+				inv.EXPECT().CommitReservation(s.ctx, event.OrderUUID.String()).Return(nil).Once()
+			},
+			wantOrder: &model.Order{Status: model.StatusPaid},
+		},
+		{
+			name: "event_ahead_of_processing_status_unknown_transaction",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{
+					OrderUUID: event.OrderUUID,
+					UserUUID:  s.userUUID,
+					Status:    model.StatusPendingPayment,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				s.paymentClient.EXPECT().GetTransaction(s.ctx, transactionUUID.String()).
+					Return(nil, model.ErrPaymentTransactionNotFound).Once()
+			},
+		},
+		{
+			name: "event_ahead_of_processing_status_transaction_of_other_order",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{
+					OrderUUID: event.OrderUUID,
+					UserUUID:  s.userUUID,
+					Status:    model.StatusPendingPayment,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				s.paymentClient.EXPECT().GetTransaction(s.ctx, transactionUUID.String()).Return(&model.PaymentTransaction{
+					TransactionUUID: transactionUUID.String(),
+					OrderUUID:       uuid.NewString(),
+					Status:          paymentpb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED,
+				}, nil).Once()
+			},
+		},
+		{
+			name: "event_ahead_of_processing_status_transaction_pending",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{
+					OrderUUID: event.OrderUUID,
+					UserUUID:  s.userUUID,
+					Status:    model.StatusPendingPayment,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				s.paymentClient.EXPECT().GetTransaction(s.ctx, transactionUUID.String()).Return(&model.PaymentTransaction{
+					TransactionUUID: transactionUUID.String(),
+					OrderUUID:       event.OrderUUID.String(),
+					Status:          paymentpb.TransactionStatus_TRANSACTION_STATUS_PENDING,
+				}, nil).Once()
+			},
+		},
+		{
+			name: "event_ahead_of_processing_status_payment_unavailable",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{
+					OrderUUID: event.OrderUUID,
+					UserUUID:  s.userUUID,
+					Status:    model.StatusPendingPayment,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				s.paymentClient.EXPECT().GetTransaction(s.ctx, transactionUUID.String()).
+					Return(nil, errors.New("connection refused")).Once()
+			},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrPaymentServiceUnavailable)
+			},
+		},
+		{
+			name: "commit_error_returned_for_retry",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{
+					OrderUUID:       event.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaymentProcessing,
+					TransactionUUID: &transactionUUID,
+					PaymentMethod:   &sbp,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				repo.EXPECT().UpdateOrderWithOutbox(s.ctx, order, mock.AnythingOfType("model.StatusChange"), mock.AnythingOfType("*model.OutboxMessage")).
+					Return(&model.Order{OrderUUID: order.OrderUUID, Status: model.StatusPaid}, nil).Once()
+				inv.EXPECT().CommitReservation(s.ctx, event.OrderUUID.String()).Return(errors.New("unauthenticated")).Once()
+			},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInventoryServiceUnavailable)
+			},
+		},
+		{
+			name: "already_paid_commits_reservation_again",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{
+					OrderUUID:       event.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaid,
+					TransactionUUID: &transactionUUID,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				inv.EXPECT().CommitReservation(s.ctx, event.OrderUUID.String()).Return(nil).Once()
+			},
+		},
+		{
+			name: "already_paid_commit_error",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{
+					OrderUUID:       event.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaid,
+					TransactionUUID: &transactionUUID,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				inv.EXPECT().CommitReservation(s.ctx, event.OrderUUID.String()).Return(errors.New("inventory service down")).Once()
+			},
+			checkErr: func(err error) {
+				assert.ErrorIs(s.T(), err, model.ErrInventoryServiceUnavailable)
+			},
+		},
+		{
+			name: "assembled_order_skipped",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				order := &model.Order{
+					OrderUUID:       event.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusAssembled,
+					TransactionUUID: &transactionUUID,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name: "other_transaction_skipped",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				other := uuid.New()
+				order := &model.Order{
+					OrderUUID:       event.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaymentProcessing,
+					TransactionUUID: &other,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name: "get_order_error",
+			setupMock: func(repo *repomocks.MockOrderRepository, inv *clientmocks.MockInventoryClient) {
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return((*model.Order)(nil), errors.New("database error")).Once()
+			},
+			checkErr: func(err error) {
+				assert.Contains(s.T(), err.Error(), "failed to get order")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock(s.repo, s.inventoryClient)
+
+			got, err := s.service.CompletePayment(s.ctx, event)
+
+			if tt.checkErr != nil {
+				require.Error(s.T(), err)
+				tt.checkErr(err)
+
+				return
+			}
+
+			require.NoError(s.T(), err)
+
+			if tt.wantOrder == nil {
+				require.Nil(s.T(), got)
+				return
+			}
+
+			require.NotNil(s.T(), got)
+			require.Equal(s.T(), tt.wantOrder.Status, got.Status)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestFailPayment() {
+	transactionUUID := uuid.New()
+	event := &model.PaymentFailed{
+		EventUUID:       uuid.New(),
+		TransactionUUID: transactionUUID,
+		OrderUUID:       uuid.New(),
+		UserUUID:        s.userUUID,
+		DeclineCode:     "TIMEOUT",
+	}
+	sbp := model.PaymentMethodSBP
+
+	tests := []struct {
+		name      string
+		setupMock func(*repomocks.MockOrderRepository)
+		wantOrder *model.Order
+		checkErr  func(err error)
+	}{
+		{
+			name: "processing_order_returned_to_pending",
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				order := &model.Order{
+					OrderUUID:       event.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaymentProcessing,
+					TransactionUUID: &transactionUUID,
+					PaymentMethod:   &sbp,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				// Транзакция и способ оплаты сбрасываются, чтобы заказ можно было оплатить заново
+				repo.EXPECT().UpdateOrder(s.ctx, mock.MatchedBy(func(o *model.Order) bool {
+					return o.Status == model.StatusPendingPayment && o.TransactionUUID == nil && o.PaymentMethod == nil
+				}), mock.MatchedBy(isPaymentChange(event.EventUUID))).Return(&model.Order{Status: model.StatusPendingPayment}, nil).Once()
+			},
+			wantOrder: &model.Order{Status: model.StatusPendingPayment},
+		},
+		{
+			name: "already_paid_skipped",
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				order := &model.Order{
+					OrderUUID:       event.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaid,
+					TransactionUUID: &transactionUUID,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name: "pending_order_skipped",
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				order := &model.Order{
+					OrderUUID: event.OrderUUID,
+					UserUUID:  s.userUUID,
+					Status:    model.StatusPendingPayment,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name: "update_error",
+			setupMock: func(repo *repomocks.MockOrderRepository) {
+				order := &model.Order{
+					OrderUUID:       event.OrderUUID,
+					UserUUID:        s.userUUID,
+					Status:          model.StatusPaymentProcessing,
+					TransactionUUID: &transactionUUID,
+				}
+				repo.EXPECT().GetOrder(s.ctx, event.OrderUUID.String()).Return(order, nil).Once()
+				repo.EXPECT().UpdateOrder(s.ctx, order, mock.AnythingOfType("model.StatusChange")).Return((*model.Order)(nil), errors.New("database error")).Once()
+			},
+			checkErr: func(err error) {
+				assert.Contains(s.T(), err.Error(), "failed to update order")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock(s.repo)
+
+			got, err := s.service.FailPayment(s.ctx, event)
+
+			if tt.checkErr != nil {
+				require.Error(s.T(), err)
+				tt.checkErr(err)
+
+				return
+			}
+
+			require.NoError(s.T(), err)
+
+			if tt.wantOrder == nil {
+				require.Nil(s.T(), got)
+				return
+			}
+
+			require.NotNil(s.T(), got)
+			require.Equal(s.T(), tt.wantOrder.Status, got.Status)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/converter/kafka/encoder"
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// refundOrder отменяет оплаченный заказ с возвратом средств. Сначала заказ закрепляется
+// за возвратом переходом PAID → REFUNDING, чтобы параллельная сборка не могла его перехватить,
+// затем средства возвращаются через payment service, списанные детали возвращаются на склад,
+// и заказ переходит в REFUNDED вместе с событием OrderCancelled. Если какой-то шаг не удался,
+// заказ остается в REFUNDING, а повторная отмена продолжает возврат: ни payment service,
+// ни inventory service не выполняют повторный возврат дважды
+func (s *Service) refundOrder(ctx context.Context, userUUID uuid.UUID, order *model.Order) (*model.Order, error) {
+	if order.TransactionUUID == nil {
+		return nil, model.NewInvalidOrderDataError("paid order has no transaction UUID")
+	}
+
+	change := userStatusChange(userUUID)
+
+	if order.Status != model.StatusRefunding {
+		claimed, err := s.updateWithRetry(ctx, order, change, func(order *model.Order) (*model.OutboxMessage, error) {
+			return nil, order.TransitionTo(model.StatusRefunding)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		order = claimed
+	}
+
+	refundUUID, err := s.paymentClient.RefundPayment(
+		ctx,
+		order.UserUUID.String(),
+		order.OrderUUID.String(),
+		order.TransactionUUID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", model.ErrPaymentServiceUnavailable, err)
+	}
+
+	parsedRefundUUID, err := uuid.Parse(refundUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid refund UUID: %w", err)
+	}
+
+	if err := s.restockReservation(ctx, order.OrderUUID); err != nil {
+		logger.Error(ctx, "Payment refunded but parts were not restocked",
+			zap.Error(err),
+			zap.String("order_uuid", order.OrderUUID.String()),
+			zap.String("refund_uuid", refundUUID),
+		)
+
+		return nil, err
+	}
+
+	updated, err := s.updateWithRetry(ctx, order, change, func(order *model.Order) (*model.OutboxMessage, error) {
+		if err := order.TransitionTo(model.StatusRefunded); err != nil {
+			return nil, err
+		}
+
+		return newOrderCancelledOutboxMessage(ctx, order, &parsedRefundUUID)
+	})
+	if err != nil {
+		// Средства уже возвращены, заказ остается в REFUNDING до повторной отмены
+		logger.Error(ctx, "Payment refunded but order was not marked as refunded",
+			zap.Error(err),
+			zap.String("order_uuid", order.OrderUUID.String()),
+			zap.String("refund_uuid", refundUUID),
+		)
+
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// newOrderCancelledOutboxMessage формирует outbox-сообщение с событием OrderCancelled
+func newOrderCancelledOutboxMessage(ctx context.Context, order *model.Order, refundUUID *uuid.UUID) (*model.OutboxMessage, error) {
+	orderCancelledEvent := model.OrderCancelled{
+		EventUUID:  uuid.New(),
+		OrderUUID:  order.OrderUUID,
+		UserUUID:   order.UserUUID,
+		RefundUUID: refundUUID,
+	}
+
+	payload, err := encoder.EncodeOrderCancelled(orderCancelledEvent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode OrderCancelled: %w", err)
+	}
+
+	return &model.OutboxMessage{
+		UUID:          orderCancelledEvent.EventUUID,
+		AggregateUUID: orderCancelledEvent.OrderUUID,
+		EventType:     model.EventTypeOrderCancelled,
+		Key:           []byte(orderCancelledEvent.OrderUUID.String()),
+		Payload:       payload,
+		TraceContext:  outboxTraceContext(ctx),
+	}, nil
+}
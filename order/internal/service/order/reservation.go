@@ -0,0 +1,49 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// releaseReservation возвращает зарезервированные под заказ детали на склад.
+// Отсутствие резерва не считается ошибкой: снимать нечего.
+// Ошибка только логируется: заказ уже не претендует на детали, а резерв можно снять повторно
+func (s *Service) releaseReservation(ctx context.Context, orderUUID uuid.UUID) {
+	err := s.inventoryClient.ReleaseReservation(ctx, orderUUID.String())
+	if errors.Is(err, model.ErrReservationNotFound) {
+		return
+	}
+
+	if err != nil {
+		logger.Error(ctx, "Failed to release parts reservation",
+			zap.Error(err),
+			zap.String("order_uuid", orderUUID.String()),
+		)
+	}
+}
+
+// restockReservation возвращает на склад детали, списанные под оплаченный заказ.
+// Заказ без резерва (созданный до появления резервирования) пропускается: возвращать нечего
+func (s *Service) restockReservation(ctx context.Context, orderUUID uuid.UUID) error {
+	err := s.inventoryClient.RestockReservation(ctx, orderUUID.String())
+	if errors.Is(err, model.ErrReservationNotFound) {
+		logger.Warn(ctx, "Refunded order has no parts reservation to restock",
+			zap.String("order_uuid", orderUUID.String()),
+		)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %w", model.ErrInventoryServiceUnavailable, err)
+	}
+
+	return nil
+}
@@ -9,7 +9,6 @@ import (
 
 	clientGrpc "github.com/radiophysiker/microservices-homework/order/internal/client/grpc"
 	"github.com/radiophysiker/microservices-homework/order/internal/repository"
-	orderProducer "github.com/radiophysiker/microservices-homework/order/internal/service/producer/order_producer"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
 )
 
@@ -18,7 +17,6 @@ type Service struct {
 	orderRepository repository.OrderRepository
 	inventoryClient clientGrpc.InventoryClient
 	paymentClient   clientGrpc.PaymentClient
-	orderProducer   *orderProducer.Service
 	ordersCounter   metric.Int64Counter
 	revenueCounter  metric.Float64Counter
 }
@@ -29,7 +27,6 @@ func NewService(
 	orderRepository repository.OrderRepository,
 	inventoryClient clientGrpc.InventoryClient,
 	paymentClient clientGrpc.PaymentClient,
-	orderProducer *orderProducer.Service,
 ) *Service {
 	meter := otel.Meter("order-service")
 
@@ -53,7 +50,6 @@ func NewService(
 		orderRepository: orderRepository,
 		inventoryClient: inventoryClient,
 		paymentClient:   paymentClient,
-		orderProducer:   orderProducer,
 		ordersCounter:   ordersCounter,
 		revenueCounter:  revenueCounter,
 	}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 
 	clientmocks "github.com/radiophysiker/microservices-homework/order/internal/client/grpc/mocks"
 	repomocks "github.com/radiophysiker/microservices-homework/order/internal/repository/mocks"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
 )
 
 // ServiceTestSuite содержит общее окружение для всех тестов сервиса
@@ -18,15 +20,19 @@ type ServiceTestSuite struct {
 	paymentClient   *clientmocks.MockPaymentClient
 	service         *Service
 	ctx             context.Context
+	userUUID        uuid.UUID
 }
 
 // SetupTest запускается перед каждым тестом
 func (s *ServiceTestSuite) SetupTest() {
+	logger.SetNopLogger()
+
 	s.ctx = context.Background()
+	s.userUUID = uuid.New()
 	s.repo = repomocks.NewMockOrderRepository(s.T())
 	s.inventoryClient = clientmocks.NewMockInventoryClient(s.T())
 	s.paymentClient = clientmocks.NewMockPaymentClient(s.T())
-	s.service = NewService(s.ctx, s.repo, s.inventoryClient, s.paymentClient, nil)
+	s.service = NewService(s.ctx, s.repo, s.inventoryClient, s.paymentClient)
 }
 
 // TestServiceSuite запускает все тесты suite
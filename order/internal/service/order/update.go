@@ -0,0 +1,55 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// maxConflictAttempts число попыток обновить заказ при конфликте версий
+const maxConflictAttempts = 3
+
+// orderMutation изменяет заказ перед сохранением и при необходимости возвращает
+// событие для outbox. Вызывается повторно на перечитанном заказе при конфликте версий
+type orderMutation func(order *model.Order) (*model.OutboxMessage, error)
+
+// updateWithRetry применяет mutate к заказу и сохраняет его. При конфликте версий
+// заказ перечитывается и mutate применяется заново, не более maxConflictAttempts раз
+func (s *Service) updateWithRetry(ctx context.Context, order *model.Order, change model.StatusChange, mutate orderMutation) (*model.Order, error) {
+	for attempt := 1; ; attempt++ {
+		message, err := mutate(order)
+		if err != nil {
+			return nil, err
+		}
+
+		var updated *model.Order
+		if message != nil {
+			updated, err = s.orderRepository.UpdateOrderWithOutbox(ctx, order, change, message)
+		} else {
+			updated, err = s.orderRepository.UpdateOrder(ctx, order, change)
+		}
+
+		if err == nil {
+			return updated, nil
+		}
+
+		if !errors.Is(err, model.ErrOrderVersionConflict) || attempt >= maxConflictAttempts {
+			return nil, fmt.Errorf("failed to update order: %w", err)
+		}
+
+		logger.Info(ctx, "Order version conflict, retrying",
+			zap.String("order_uuid", order.OrderUUID.String()),
+			zap.Int("attempt", attempt),
+		)
+
+		order, err = s.orderRepository.GetOrder(ctx, order.OrderUUID.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get order: %w", err)
+		}
+	}
+}
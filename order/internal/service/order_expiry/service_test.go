@@ -0,0 +1,105 @@
+package order_expiry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	servicemocks "github.com/radiophysiker/microservices-homework/order/internal/service/mocks"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// ServiceTestSuite содержит общее окружение для тестов задачи отмены просроченных заказов
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx          context.Context
+	orderService *servicemocks.MockOrderService
+	service      *Service
+}
+
+// SetupTest запускается перед каждым тестом
+func (s *ServiceTestSuite) SetupTest() {
+	logger.SetNopLogger()
+
+	s.ctx = context.Background()
+	s.orderService = servicemocks.NewMockOrderService(s.T())
+	s.service = NewService(s.orderService, time.Hour, time.Second, 2)
+}
+
+// createdBeforeTTL проверяет, что граница отсчитана от текущего времени на величину TTL
+func createdBeforeTTL(ttl time.Duration) any {
+	return mock.MatchedBy(func(createdBefore time.Time) bool {
+		age := time.Since(createdBefore)
+		return age >= ttl && age < ttl+time.Minute
+	})
+}
+
+func (s *ServiceTestSuite) TestExpirePending() {
+	tests := []struct {
+		name      string
+		setupMock func(orderService *servicemocks.MockOrderService)
+	}{
+		{
+			name: "single_batch",
+			setupMock: func(orderService *servicemocks.MockOrderService) {
+				orderService.EXPECT().ExpirePendingOrders(s.ctx, createdBeforeTTL(time.Hour), 2).Return(1, nil).Once()
+			},
+		},
+		{
+			name: "drains_full_batches",
+			setupMock: func(orderService *servicemocks.MockOrderService) {
+				orderService.EXPECT().ExpirePendingOrders(s.ctx, createdBeforeTTL(time.Hour), 2).Return(2, nil).Twice()
+				orderService.EXPECT().ExpirePendingOrders(s.ctx, createdBeforeTTL(time.Hour), 2).Return(0, nil).Once()
+			},
+		},
+		{
+			name: "error_stops_until_next_tick",
+			setupMock: func(orderService *servicemocks.MockOrderService) {
+				orderService.EXPECT().ExpirePendingOrders(s.ctx, createdBeforeTTL(time.Hour), 2).Return(0, errors.New("database error")).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			tt.setupMock(s.orderService)
+
+			s.service.expirePending(s.ctx)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestStop() {
+	s.orderService.EXPECT().ExpirePendingOrders(mock.Anything, mock.Anything, 2).Return(0, nil)
+
+	runErr := make(chan error, 1)
+
+	go func() {
+		runErr <- s.service.Run(s.ctx)
+	}()
+
+	require.Eventually(s.T(), func() bool {
+		s.service.mu.Lock()
+		defer s.service.mu.Unlock()
+
+		return s.service.cancel != nil
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(s.T(), s.service.Stop(s.ctx))
+	require.ErrorIs(s.T(), <-runErr, context.Canceled)
+}
+
+func (s *ServiceTestSuite) TestStopBeforeRun() {
+	require.NoError(s.T(), s.service.Stop(s.ctx))
+}
+
+// TestServiceSuite запускает все тесты suite
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
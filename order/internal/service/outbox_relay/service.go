@@ -0,0 +1,103 @@
+package outbox_relay
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/order/internal/model"
+	"github.com/radiophysiker/microservices-homework/order/internal/repository"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
+)
+
+// Service периодически публикует события из outbox в Kafka
+type Service struct {
+	outboxRepository repository.OutboxRepository
+	producers        map[string]kafka.Producer
+	interval         time.Duration
+	batchSize        int
+}
+
+// NewService создает новый экземпляр Service.
+// producers сопоставляет тип события из outbox с producer'ом его топика.
+func NewService(
+	outboxRepository repository.OutboxRepository,
+	producers map[string]kafka.Producer,
+	interval time.Duration,
+	batchSize int,
+) *Service {
+	return &Service{
+		outboxRepository: outboxRepository,
+		producers:        producers,
+		interval:         interval,
+		batchSize:        batchSize,
+	}
+}
+
+// Run запускает цикл публикации событий до отмены контекста
+func (s *Service) Run(ctx context.Context) error {
+	logger.Info(ctx, "Starting outbox relay",
+		zap.Duration("interval", s.interval),
+		zap.Int("batch_size", s.batchSize),
+	)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.relayPending(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayPending публикует накопившиеся события пачками, пока outbox не опустеет
+func (s *Service) relayPending(ctx context.Context) {
+	for ctx.Err() == nil {
+		sent, err := s.outboxRepository.ProcessPending(ctx, s.batchSize, s.publish)
+		if err != nil {
+			logger.Error(ctx, "Failed to relay outbox messages",
+				zap.Error(err),
+				zap.Int("sent", sent),
+			)
+
+			return
+		}
+
+		if sent < s.batchSize {
+			return
+		}
+	}
+}
+
+// publish отправляет событие в топик, соответствующий его типу.
+// Публикация продолжает трейс запроса, в котором событие было создано
+func (s *Service) publish(ctx context.Context, message *model.OutboxMessage) error {
+	producer, ok := s.producers[message.EventType]
+	if !ok {
+		return fmt.Errorf("no producer for event type %q", message.EventType)
+	}
+
+	ctx = tracing.Extract(ctx, propagation.MapCarrier(message.TraceContext))
+
+	if err := producer.Send(ctx, message.Key, message.Payload, nil); err != nil {
+		return fmt.Errorf("failed to send %s event: %w", message.EventType, err)
+	}
+
+	logger.Info(ctx, "Outbox message published",
+		zap.String("event_type", message.EventType),
+		zap.String("event_uuid", message.UUID.String()),
+		zap.String("aggregate_uuid", message.AggregateUUID.String()),
+	)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -11,13 +12,38 @@ import (
 // OrderService представляет интерфейс для работы с заказами
 type OrderService interface {
 	// CreateOrder создает новый заказ
-	CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID) (*model.Order, error)
-	// GetOrder возвращает заказ по UUID
-	GetOrder(ctx context.Context, orderUUID uuid.UUID) (*model.Order, error)
-	// PayOrder проводит оплату заказа
-	PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMethod model.PaymentMethod) (*model.Order, error)
-	// CancelOrder отменяет заказ
-	CancelOrder(ctx context.Context, orderUUID uuid.UUID) (*model.Order, error)
+	CreateOrder(ctx context.Context, userUUID uuid.UUID, items []model.OrderItem) (*model.Order, error)
+	// GetOrder возвращает заказ пользователя по UUID
+	GetOrder(ctx context.Context, userUUID, orderUUID uuid.UUID) (*model.Order, error)
+	// ListOrders возвращает страницу заказов по фильтру
+	ListOrders(ctx context.Context, filter model.OrderFilter, cursor *model.OrderCursor, pageSize int) (*model.OrderPage, error)
+	// GetOrderHistory возвращает историю статусов заказа пользователя
+	GetOrderHistory(ctx context.Context, userUUID, orderUUID uuid.UUID) ([]*model.StatusHistoryEntry, error)
+	// PayOrder проводит оплату заказа пользователя; cardToken передается для оплаты картой
+	PayOrder(ctx context.Context, userUUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod, cardToken string) (*model.Order, error)
+	// CompletePayment переводит заказ в PAID по событию об успешной асинхронной оплате
+	CompletePayment(ctx context.Context, event *model.PaymentCompleted) (*model.Order, error)
+	// FailPayment возвращает заказ в ожидание оплаты по событию об отказе в асинхронной оплате
+	FailPayment(ctx context.Context, event *model.PaymentFailed) (*model.Order, error)
+	// CancelOrder отменяет заказ пользователя
+	CancelOrder(ctx context.Context, userUUID, orderUUID uuid.UUID) (*model.Order, error)
+	// ExpirePendingOrders отменяет до limit неоплаченных заказов, созданных раньше createdBefore.
+	// Возвращает число отмененных заказов
+	ExpirePendingOrders(ctx context.Context, createdBefore time.Time, limit int) (int, error)
+}
+
+// OutboxRelayService представляет интерфейс для публикации событий из outbox
+type OutboxRelayService interface {
+	// Run запускает публикацию событий из outbox в Kafka
+	Run(ctx context.Context) error
+}
+
+// OrderExpiryService представляет интерфейс для фоновой отмены неоплаченных заказов
+type OrderExpiryService interface {
+	// Run периодически отменяет заказы, не оплаченные за отведенное время
+	Run(ctx context.Context) error
+	// Stop останавливает Run и ждет завершения текущей пачки
+	Stop(ctx context.Context) error
 }
 
 // OrderConsumerService представляет интерфейс для consumer'а событий ShipAssembled
@@ -25,3 +51,15 @@ type OrderConsumerService interface {
 	// RunConsumer запускает consumer для обработки событий ShipAssembled
 	RunConsumer(ctx context.Context) error
 }
+
+// PaymentConsumerService представляет интерфейс для consumer'а событий PaymentCompleted и PaymentFailed
+type PaymentConsumerService interface {
+	// RunConsumer запускает consumer для обработки результатов асинхронной оплаты
+	RunConsumer(ctx context.Context) error
+}
+
+// IdempotencyService представляет интерфейс для выполнения запросов с ключом идемпотентности
+type IdempotencyService interface {
+	// Execute выполняет fn не более одного раза для ключа и возвращает сохраненный ответ при повторе
+	Execute(ctx context.Context, key model.IdempotencyKey, requestHash []byte, fn func(ctx context.Context) ([]byte, error)) ([]byte, error)
+}
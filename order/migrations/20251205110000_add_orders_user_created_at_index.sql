@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- keyset pagination of user orders by (created_at, uuid)
+CREATE INDEX IF NOT EXISTS idx_orders_user_created_at ON orders (user_uuid, created_at DESC, uuid DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_user_created_at;
+-- +goose StatementEnd
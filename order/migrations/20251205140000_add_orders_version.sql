@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- version is incremented on every update and used for optimistic locking
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
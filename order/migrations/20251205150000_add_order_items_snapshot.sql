@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- snapshot of the part at order creation time
+ALTER TABLE order_items
+    ADD COLUMN IF NOT EXISTS unit_price DECIMAL(10,2) NOT NULL DEFAULT 0.00,
+    ADD COLUMN IF NOT EXISTS part_name TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS category TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS category,
+    DROP COLUMN IF EXISTS part_name,
+    DROP COLUMN IF EXISTS unit_price;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- lets the expiry job find stale unpaid orders without scanning the whole table
+CREATE INDEX IF NOT EXISTS idx_orders_pending_created_at
+    ON orders (created_at)
+    WHERE status = 'PENDING_PAYMENT';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_pending_created_at;
+-- +goose StatementEnd
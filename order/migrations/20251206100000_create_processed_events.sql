@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- event uuids already handled by kafka consumers, used to skip redelivered events
+CREATE TABLE IF NOT EXISTS processed_events (
+    consumer     TEXT        NOT NULL,
+    event_uuid   TEXT        NOT NULL,
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (consumer, event_uuid)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS processed_events;
+-- +goose StatementEnd
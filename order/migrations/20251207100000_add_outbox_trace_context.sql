@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- trace context of the request that produced the event, propagated to Kafka headers by the relay
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS trace_context JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE outbox DROP COLUMN IF EXISTS trace_context;
+-- +goose StatementEnd
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/app"
+	"github.com/radiophysiker/microservices-homework/payment/internal/config"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/closer"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+const configPath = "./deploy/compose/payment/.env"
+
+func main() {
+	since := flag.String("since", "", "начало окна сверки в RFC 3339; по умолчанию until минус RECONCILE_WINDOW")
+	until := flag.String("until", "", "конец окна сверки в RFC 3339; по умолчанию текущее время минус RECONCILE_SETTLE_DELAY")
+	format := flag.String("format", formatJSON, "формат отчета: json или csv")
+	output := flag.String("output", "", "файл отчета; по умолчанию stdout")
+	flag.Parse()
+
+	if err := config.Load(configPath); err != nil {
+		panic(fmt.Errorf("failed to load config: %w", err))
+	}
+
+	window, err := parseWindow(*since, *until, time.Now())
+	if err != nil {
+		panic(err)
+	}
+
+	writeReport, err := reportWriter(*format)
+	if err != nil {
+		panic(err)
+	}
+
+	appCtx, appCancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer appCancel()
+	defer gracefulShutdown()
+
+	reconciler, err := app.NewReconciler(appCtx)
+	if err != nil {
+		logger.SetNopLogger()
+		logger.Fatal(appCtx, "failed to create reconciler", zap.Error(err))
+	}
+
+	report, err := reconciler.Run(appCtx, window)
+	if err != nil {
+		logger.Fatal(appCtx, "failed to reconcile payments", zap.Error(err))
+	}
+
+	logger.Info(appCtx, "Сверка оплат завершена",
+		zap.Time("since", window.Since),
+		zap.Time("until", window.Until),
+		zap.Int("checked_payments", report.CheckedPayments),
+		zap.Int("checked_orders", report.CheckedOrders),
+		zap.Int("mismatches", len(report.Mismatches)),
+	)
+
+	if err := writeOutput(*output, func(w io.Writer) error { return writeReport(w, report) }); err != nil {
+		logger.Fatal(appCtx, "failed to write reconciliation report", zap.Error(err))
+	}
+}
+
+// parseWindow строит окно сверки из флагов; незаданные границы берутся из конфигурации
+func parseWindow(rawSince, rawUntil string, now time.Time) (model.ReconciliationWindow, error) {
+	cfg := config.AppConfig().Reconciliation
+
+	until := now.Add(-cfg.SettleDelay())
+	if rawUntil != "" {
+		parsed, err := time.Parse(time.RFC3339, rawUntil)
+		if err != nil {
+			return model.ReconciliationWindow{}, fmt.Errorf("invalid -until: %w", err)
+		}
+
+		until = parsed
+	}
+
+	since := until.Add(-cfg.Window())
+	if rawSince != "" {
+		parsed, err := time.Parse(time.RFC3339, rawSince)
+		if err != nil {
+			return model.ReconciliationWindow{}, fmt.Errorf("invalid -since: %w", err)
+		}
+
+		since = parsed
+	}
+
+	if !since.Before(until) {
+		return model.ReconciliationWindow{}, fmt.Errorf("-since %s must be before -until %s", since.Format(time.RFC3339), until.Format(time.RFC3339))
+	}
+
+	return model.ReconciliationWindow{Since: since, Until: until}, nil
+}
+
+// writeOutput пишет отчет в файл path или в stdout, если путь не задан
+func writeOutput(path string, write func(w io.Writer) error) error {
+	if path == "" {
+		return write(os.Stdout)
+	}
+
+	f, err := os.Create(path) //nolint:gosec // путь к отчету задает оператор
+	if err != nil {
+		return err
+	}
+
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func gracefulShutdown() {
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := closer.CloseAll(shutdownCtx); err != nil {
+		logger.Fatal(context.Background(), "failed to shutdown gracefully", zap.Error(err))
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+const (
+	formatJSON = "json"
+	formatCSV  = "csv"
+)
+
+// csvHeader - колонки CSV-отчета, по одной строке на расхождение
+var csvHeader = []string{
+	"kind",
+	"order_uuid",
+	"transaction_uuid",
+	"order_status",
+	"transaction_status",
+	"order_amount",
+	"ledger_amount",
+	"order_currency",
+	"ledger_currency",
+}
+
+type jsonReport struct {
+	Since           time.Time      `json:"since"`
+	Until           time.Time      `json:"until"`
+	CheckedPayments int            `json:"checked_payments"`
+	CheckedOrders   int            `json:"checked_orders"`
+	MismatchCount   int            `json:"mismatch_count"`
+	Mismatches      []jsonMismatch `json:"mismatches"`
+}
+
+type jsonMismatch struct {
+	Kind              string `json:"kind"`
+	OrderUUID         string `json:"order_uuid"`
+	TransactionUUID   string `json:"transaction_uuid,omitempty"`
+	OrderStatus       string `json:"order_status,omitempty"`
+	TransactionStatus string `json:"transaction_status,omitempty"`
+	OrderAmount       int64  `json:"order_amount"`
+	LedgerAmount      int64  `json:"ledger_amount"`
+	OrderCurrency     string `json:"order_currency,omitempty"`
+	LedgerCurrency    string `json:"ledger_currency,omitempty"`
+}
+
+// reportWriter возвращает функцию записи отчета в формате format
+func reportWriter(format string) (func(w io.Writer, report *model.ReconciliationReport) error, error) {
+	switch format {
+	case formatJSON:
+		return writeJSON, nil
+	case formatCSV:
+		return writeCSV, nil
+	default:
+		return nil, fmt.Errorf("unknown report format %q, expected %s or %s", format, formatJSON, formatCSV)
+	}
+}
+
+// writeJSON пишет отчет одним JSON-документом
+func writeJSON(w io.Writer, report *model.ReconciliationReport) error {
+	out := jsonReport{
+		Since:           report.Window.Since,
+		Until:           report.Window.Until,
+		CheckedPayments: report.CheckedPayments,
+		CheckedOrders:   report.CheckedOrders,
+		MismatchCount:   len(report.Mismatches),
+		Mismatches:      make([]jsonMismatch, 0, len(report.Mismatches)),
+	}
+
+	for _, mismatch := range report.Mismatches {
+		out.Mismatches = append(out.Mismatches, jsonMismatch{
+			Kind:              string(mismatch.Kind),
+			OrderUUID:         mismatch.OrderUUID.String(),
+			TransactionUUID:   transactionUUIDString(mismatch),
+			OrderStatus:       mismatch.OrderStatus,
+			TransactionStatus: mismatch.TransactionStatus,
+			OrderAmount:       mismatch.OrderAmount,
+			LedgerAmount:      mismatch.LedgerAmount,
+			OrderCurrency:     mismatch.OrderCurrency,
+			LedgerCurrency:    mismatch.LedgerCurrency,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(out)
+}
+
+// writeCSV пишет расхождения таблицей с заголовком
+func writeCSV(w io.Writer, report *model.ReconciliationReport) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, mismatch := range report.Mismatches {
+		if err := writer.Write([]string{
+			string(mismatch.Kind),
+			mismatch.OrderUUID.String(),
+			transactionUUIDString(mismatch),
+			mismatch.OrderStatus,
+			mismatch.TransactionStatus,
+			strconv.FormatInt(mismatch.OrderAmount, 10),
+			strconv.FormatInt(mismatch.LedgerAmount, 10),
+			mismatch.OrderCurrency,
+			mismatch.LedgerCurrency,
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func transactionUUIDString(mismatch model.Mismatch) string {
+	if mismatch.TransactionUUID == nil {
+		return ""
+	}
+
+	return mismatch.TransactionUUID.String()
+}
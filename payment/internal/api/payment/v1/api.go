@@ -9,11 +9,14 @@ import (
 type API struct {
 	pb.UnimplementedPaymentServiceServer
 	paymentService service.PaymentService
+	// callbackSecret - общий с провайдерами секрет для проверки подписи callback'ов
+	callbackSecret string
 }
 
 // NewAPI создает новый экземпляр API
-func NewAPI(paymentService service.PaymentService) *API {
+func NewAPI(paymentService service.PaymentService, callbackSecret string) *API {
 	return &API{
 		paymentService: paymentService,
+		callbackSecret: callbackSecret,
 	}
 }
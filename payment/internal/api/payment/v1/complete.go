@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/converter"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider/callback"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+// CompletePayment принимает callback провайдера с итогом асинхронной оплаты.
+// Callback без действительной подписи провайдера отклоняется до обращения к сервису
+func (a *API) CompletePayment(ctx context.Context, req *pb.CompletePaymentRequest) (*pb.CompletePaymentResponse, error) {
+	if !a.isSignedCallback(ctx, req) {
+		return nil, status.Error(codes.Unauthenticated, "invalid payment callback signature")
+	}
+
+	transaction, err := a.paymentService.CompletePayment(
+		ctx,
+		req.GetTransactionUuid(),
+		converter.TransactionStatusFromProtobuf(req.GetStatus()),
+		model.DeclineCode(req.GetDeclineCode()),
+	)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidCallbackRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid payment callback: %v", err)
+		}
+
+		if errors.Is(err, model.ErrTransactionNotFound) {
+			return nil, status.Error(codes.NotFound, "transaction not found")
+		}
+
+		if errors.Is(err, model.ErrPaymentAlreadyFinalized) {
+			return nil, status.Error(codes.FailedPrecondition, "payment already finalized")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &pb.CompletePaymentResponse{
+		Transaction: converter.ToProtoTransaction(transaction),
+	}, nil
+}
+
+// isSignedCallback проверяет подпись callback'а, которую HTTP-шлюз передает в metadata
+func (a *API) isSignedCallback(ctx context.Context, req *pb.CompletePaymentRequest) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	signatures := md.Get(callback.SignatureMetadataKey)
+	if len(signatures) == 0 {
+		return false
+	}
+
+	return callback.Verify(
+		a.callbackSecret,
+		signatures[0],
+		req.GetTransactionUuid(),
+		req.GetStatus().String(),
+		req.GetDeclineCode(),
+	)
+}
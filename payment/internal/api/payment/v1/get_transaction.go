@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/converter"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+// GetTransaction возвращает транзакцию по UUID
+func (a *API) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error) {
+	transaction, err := a.paymentService.GetTransaction(ctx, req.GetTransactionUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidTransactionRequest) {
+			return nil, status.Error(codes.InvalidArgument, "invalid transaction request")
+		}
+
+		if errors.Is(err, model.ErrTransactionNotFound) {
+			return nil, status.Error(codes.NotFound, "transaction not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &pb.GetTransactionResponse{
+		Transaction: converter.ToProtoTransaction(transaction),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/converter"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+// ListTransactions возвращает транзакции пользователя и/или заказа
+func (a *API) ListTransactions(ctx context.Context, req *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
+	var filter model.TransactionFilter
+
+	if req.GetUserUuid() != "" {
+		userUUID, err := uuid.Parse(req.GetUserUuid())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user UUID: %v", err)
+		}
+
+		filter.UserUUID = &userUUID
+	}
+
+	if req.GetOrderUuid() != "" {
+		orderUUID, err := uuid.Parse(req.GetOrderUuid())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order UUID: %v", err)
+		}
+
+		filter.OrderUUID = &orderUUID
+	}
+
+	cursor, err := converter.DecodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+
+	page, err := a.paymentService.ListTransactions(ctx, filter, cursor, int(req.GetPageSize()))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidTransactionRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid list transactions request: %v", err)
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return converter.ToProtoListTransactionsResponse(page), nil
+}
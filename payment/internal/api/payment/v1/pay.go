@@ -4,25 +4,73 @@ import (
 	"context"
 	"errors"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/radiophysiker/microservices-homework/payment/internal/converter"
 	"github.com/radiophysiker/microservices-homework/payment/internal/model"
 	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
 )
 
 // PayOrder проводит оплату заказа
 func (a *API) PayOrder(ctx context.Context, req *pb.PayOrderRequest) (*pb.PayOrderResponse, error) {
-	transactionUUID, err := a.paymentService.PayOrder(ctx, req.GetUserUuid(), req.GetOrderUuid(), req.GetPaymentMethod())
+	transaction, err := a.paymentService.PayOrder(
+		ctx,
+		req.GetUserUuid(),
+		req.GetOrderUuid(),
+		converter.PaymentMethodFromProtobuf(req.GetPaymentMethod()),
+		req.GetAmount(),
+		req.GetCurrency(),
+		req.GetCardToken(),
+	)
 	if err != nil {
 		if errors.Is(err, model.ErrInvalidPaymentRequest) {
-			return nil, status.Error(codes.InvalidArgument, "invalid payment request")
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		var declined *model.PaymentDeclinedError
+		if errors.As(err, &declined) {
+			return nil, paymentRefusedError(string(declined.Code), declineSourceProvider, "decline code: "+string(declined.Code))
+		}
+
+		var rejected *model.PaymentRejectedError
+		if errors.As(err, &rejected) {
+			return nil, paymentRefusedError(string(rejected.Reason), declineSourceRisk, "rejected by risk rules: "+string(rejected.Reason))
+		}
+
+		if errors.Is(err, model.ErrOrderAlreadyPaid) {
+			return nil, status.Error(codes.AlreadyExists, "order already paid")
 		}
 
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
 	return &pb.PayOrderResponse{
-		TransactionUuid: transactionUUID,
+		TransactionUuid: transaction.TransactionUUID.String(),
+		Status:          converter.TransactionStatusToProtobuf(transaction.Status),
 	}, nil
 }
+
+const (
+	// errorDomain - домен причин отказа в ErrorInfo
+	errorDomain = "payment"
+	// declineSourceProvider - отказ платежного провайдера
+	declineSourceProvider = "provider"
+	// declineSourceRisk - отказ правил риска
+	declineSourceRisk = "risk"
+)
+
+// paymentRefusedError возвращает FailedPrecondition с машиночитаемой причиной отказа в ErrorInfo
+func paymentRefusedError(reason, source, message string) error {
+	st, err := status.New(codes.FailedPrecondition, message).WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"source": source},
+	})
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, message)
+	}
+
+	return st.Err()
+}
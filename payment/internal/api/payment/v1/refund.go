@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+// RefundPayment возвращает средства по транзакции оплаты заказа
+func (a *API) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	refundUUID, err := a.paymentService.RefundPayment(ctx, req.GetUserUuid(), req.GetOrderUuid(), req.GetTransactionUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidRefundRequest) {
+			return nil, status.Error(codes.InvalidArgument, "invalid refund request")
+		}
+
+		if errors.Is(err, model.ErrTransactionNotFound) {
+			return nil, status.Error(codes.NotFound, "transaction not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &pb.RefundPaymentResponse{
+		RefundUuid: refundUUID,
+	}, nil
+}
@@ -3,24 +3,41 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/radiophysiker/microservices-homework/payment/internal/config"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider/callback"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/closer"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/grpc/health"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/metrics"
+	grpcMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/grpc"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/migrator"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
 	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
 )
 
+// callbackRoute - единственный маршрут HTTP-шлюза: подтверждение оплаты от провайдера (CompletePayment)
+const callbackRoute = "POST /api/v1/payments/{transaction_uuid}/callback"
+
 type App struct {
-	diContainer *diContainer
-	grpcServer  *grpc.Server
-	listener    net.Listener
+	diContainer   *diContainer
+	grpcServer    *grpc.Server
+	httpServer    *http.Server
+	listener      net.Listener
+	gatewayCancel context.CancelFunc
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -35,13 +52,86 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	logger.Info(ctx, "PaymentService gRPC server listening", zap.String("address", a.listener.Addr().String()))
+	parentCtx := ctx
+	g, ctx := errgroup.WithContext(ctx)
 
-	if err := a.grpcServer.Serve(a.listener); err != nil {
-		logger.Fatal(ctx, "failed to serve gRPC", zap.Error(err))
-	}
+	g.Go(func() error {
+		logger.Info(ctx, "PaymentService gRPC server listening", zap.String("address", a.listener.Addr().String()))
 
-	return nil
+		if err := a.grpcServer.Serve(a.listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			logger.Error(ctx, "gRPC serve failed", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		logger.Info(ctx, "PaymentService HTTP Gateway listen", zap.String("addr", a.httpServer.Addr))
+
+		if err := a.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(ctx, "HTTP serve failed", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		outboxRelayService, err := a.diContainer.OutboxRelayService(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to get OutboxRelayService", zap.Error(err))
+			return err
+		}
+
+		if err := outboxRelayService.Run(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				logger.Info(ctx, "Outbox relay stopped")
+				return nil
+			}
+
+			logger.Error(ctx, "Outbox relay error", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		paymentExpiryService, err := a.diContainer.PaymentExpiryService(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to get PaymentExpiryService", zap.Error(err))
+			return err
+		}
+
+		if err := paymentExpiryService.Run(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				logger.Info(ctx, "Payment expiry job stopped")
+				return nil
+			}
+
+			logger.Error(ctx, "Payment expiry job error", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+
+	// Завершаем по ctx
+	g.Go(func() error {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(parentCtx, 30*time.Second)
+		defer cancel()
+		a.gatewayCancel()
+		a.grpcServer.GracefulStop()
+
+		return a.httpServer.Shutdown(shutdownCtx)
+	})
+
+	return g.Wait()
 }
 
 func (a *App) initDeps(ctx context.Context) error {
@@ -50,8 +140,10 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initLogger,
 		a.initCloser,
 		a.initTracing,
+		a.initMigrations,
 		a.initListener,
 		a.initGRPCServer,
+		a.initHTTPGateway,
 	}
 
 	for _, f := range inits {
@@ -84,6 +176,16 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initMetrics(ctx context.Context) error {
+	if err := metrics.InitProvider(ctx, config.AppConfig().Metrics); err != nil {
+		return err
+	}
+
+	closer.AddNamed("Metrics provider", metrics.Shutdown)
+
+	return nil
+}
+
 func (a *App) initTracing(ctx context.Context) error {
 	if err := tracing.InitTracer(ctx, config.AppConfig().Tracing); err != nil {
 		return err
@@ -94,6 +196,15 @@ func (a *App) initTracing(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) initMigrations(ctx context.Context) error {
+	pool, err := a.diContainer.Pool(ctx)
+	if err != nil {
+		return err
+	}
+
+	return migrator.Run(ctx, pool, config.AppConfig().Migrations.Directory())
+}
+
 func (a *App) initListener(_ context.Context) error {
 	listener, err := net.Listen("tcp", config.AppConfig().PaymentGRPC.Address())
 	if err != nil {
@@ -114,10 +225,22 @@ func (a *App) initListener(_ context.Context) error {
 	return nil
 }
 
-func (a *App) initGRPCServer(_ context.Context) error {
+func (a *App) initGRPCServer(ctx context.Context) error {
+	api, err := a.diContainer.API(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Платежный API вызывают только другие сервисы. Callback провайдера приходит через HTTP-шлюз
+	// без сервисного токена и проверяется по HMAC-подписи в CompletePayment
 	a.grpcServer = grpc.NewServer(
-		grpc.UnaryInterceptor(
+		grpc.ChainUnaryInterceptor(
 			tracing.UnaryServerInterceptor(config.AppConfig().Tracing.ServiceName()),
+			grpcMiddleware.ServiceTokenInterceptor(
+				config.AppConfig().ServiceAuth.Token(),
+				pb.PaymentService_CompletePayment_FullMethodName,
+				grpc_health_v1.Health_Check_FullMethodName,
+			),
 		),
 	)
 
@@ -130,7 +253,62 @@ func (a *App) initGRPCServer(_ context.Context) error {
 
 	health.RegisterService(a.grpcServer)
 
-	pb.RegisterPaymentServiceServer(a.grpcServer, a.diContainer.API())
+	pb.RegisterPaymentServiceServer(a.grpcServer, api)
+
+	return nil
+}
+
+// initHTTPGateway поднимает HTTP-шлюз, через который платежные провайдеры
+// присылают асинхронные подтверждения оплаты
+func (a *App) initHTTPGateway(ctx context.Context) error {
+	gatewayCtx, gatewayCancel := context.WithCancel(ctx)
+	a.gatewayCancel = gatewayCancel
+
+	// Подпись провайдера передается в gRPC metadata, где ее проверяет CompletePayment
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+		if strings.EqualFold(key, callback.SignatureHeader) {
+			return callback.SignatureMetadataKey, true
+		}
+
+		return runtime.DefaultHeaderMatcher(key)
+	}))
+
+	err := pb.RegisterPaymentServiceHandlerFromEndpoint(
+		gatewayCtx,
+		mux,
+		config.AppConfig().PaymentGRPC.Address(),
+		[]grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register gateway: %w", err)
+	}
+
+	// Наружу открыт только маршрут callback: остальные методы PaymentService шлюз тоже
+	// зарегистрировал бы по путям вида /payment.v1.PaymentService/<Method>
+	callbackMux := http.NewServeMux()
+	callbackMux.Handle(callbackRoute, mux)
+
+	handler := tracing.HTTPHandlerMiddleware(config.AppConfig().Tracing.ServiceName())(callbackMux)
+
+	a.httpServer = &http.Server{
+		Addr:              config.AppConfig().PaymentHTTP.Address(),
+		Handler:           handler,
+		ReadTimeout:       60 * time.Second,
+		WriteTimeout:      60 * time.Second,
+		ReadHeaderTimeout: 60 * time.Second,
+		IdleTimeout:       120 * time.Second,
+	}
+
+	closer.AddNamed("HTTP server", func(ctx context.Context) error {
+		a.gatewayCancel()
+
+		shutdownCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+
+		return a.httpServer.Shutdown(shutdownCtx)
+	})
 
 	return nil
 }
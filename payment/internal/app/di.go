@@ -1,32 +1,510 @@
 package app
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
 	apiv1 "github.com/radiophysiker/microservices-homework/payment/internal/api/payment/v1"
+	clientGrpc "github.com/radiophysiker/microservices-homework/payment/internal/client/grpc"
+	orderClient "github.com/radiophysiker/microservices-homework/payment/internal/client/grpc/order/v1"
+	"github.com/radiophysiker/microservices-homework/payment/internal/config"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider/card"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider/investor"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider/sbp"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider/simulator"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	outboxRepo "github.com/radiophysiker/microservices-homework/payment/internal/repository/outbox"
+	transactionRepo "github.com/radiophysiker/microservices-homework/payment/internal/repository/transaction"
 	"github.com/radiophysiker/microservices-homework/payment/internal/service"
+	outboxRelaySvc "github.com/radiophysiker/microservices-homework/payment/internal/service/outbox_relay"
 	paymentSvc "github.com/radiophysiker/microservices-homework/payment/internal/service/payment"
+	paymentExpirySvc "github.com/radiophysiker/microservices-homework/payment/internal/service/payment_expiry"
+	reconciliationSvc "github.com/radiophysiker/microservices-homework/payment/internal/service/reconciliation"
+	riskSvc "github.com/radiophysiker/microservices-homework/payment/internal/service/risk"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/closer"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	kafkaProducer "github.com/radiophysiker/microservices-homework/platform/pkg/kafka/producer"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	grpcMiddleware "github.com/radiophysiker/microservices-homework/platform/pkg/middleware/grpc"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
+	orderpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/order/v1"
 )
 
 type diContainer struct {
-	paymentService service.PaymentService
-	api            *apiv1.API
+	pool                  *pgxpool.Pool
+	transactionRepository repository.TransactionRepository
+	outboxRepository      repository.OutboxRepository
+	providers             map[model.PaymentMethod]provider.Provider
+	riskService           service.RiskService
+	paymentService        service.PaymentService
+	paymentExpiryService  service.PaymentExpiryService
+	reconciliationService service.ReconciliationService
+	api                   *apiv1.API
+
+	orderConn   *grpc.ClientConn
+	orderClient clientGrpc.OrderClient
+
+	paymentCompletedSyncProducer sarama.SyncProducer
+	paymentCompletedProducer     kafka.Producer
+	paymentFailedSyncProducer    sarama.SyncProducer
+	paymentFailedProducer        kafka.Producer
+	outboxRelayService           service.OutboxRelayService
 }
 
 func newDiContainer() *diContainer {
 	return &diContainer{}
 }
 
-func (d *diContainer) PaymentService() service.PaymentService {
+func (d *diContainer) Pool(ctx context.Context) (*pgxpool.Pool, error) {
+	if d.pool == nil {
+		pc, err := pgxpool.ParseConfig(config.AppConfig().Postgres.DSN())
+		if err != nil {
+			return nil, fmt.Errorf("parse postgres config: %w", err)
+		}
+
+		pc.MaxConns = config.AppConfig().Postgres.PoolMaxConns()
+		pc.MinConns = config.AppConfig().Postgres.PoolMinConns()
+		pc.MaxConnLifetime = config.AppConfig().Postgres.PoolMaxConnLifetime()
+		pc.MaxConnIdleTime = config.AppConfig().Postgres.PoolMaxConnIdleTime()
+
+		ctxConnect, cancelConnect := context.WithTimeout(ctx, 10*time.Second)
+		defer cancelConnect()
+
+		pool, err := pgxpool.NewWithConfig(ctxConnect, pc)
+		if err != nil {
+			return nil, fmt.Errorf("create postgres pool: %w", err)
+		}
+
+		if err := pool.Ping(ctx); err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("ping postgres: %w", err)
+		}
+
+		closer.AddNamed("PostgreSQL pool", func(ctx context.Context) error {
+			pool.Close()
+			return nil
+		})
+
+		d.pool = pool
+	}
+
+	return d.pool, nil
+}
+
+func (d *diContainer) TransactionRepository(ctx context.Context) (repository.TransactionRepository, error) {
+	if d.transactionRepository == nil {
+		pool, err := d.Pool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.transactionRepository = transactionRepo.NewRepository(pool)
+	}
+
+	return d.transactionRepository, nil
+}
+
+func (d *diContainer) OutboxRepository(ctx context.Context) (repository.OutboxRepository, error) {
+	if d.outboxRepository == nil {
+		pool, err := d.Pool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.outboxRepository = outboxRepo.NewRepository(pool)
+	}
+
+	return d.outboxRepository, nil
+}
+
+// Providers возвращает платежных провайдеров по способам оплаты.
+// Реальных эквайеров пока нет, поэтому каждый провайдер проводит списания через свой экземпляр симулятора
+func (d *diContainer) Providers() (map[model.PaymentMethod]provider.Provider, error) {
+	if d.providers == nil {
+		cfg, err := newSimulatorConfig(config.AppConfig().Simulator)
+		if err != nil {
+			return nil, err
+		}
+
+		d.providers = map[model.PaymentMethod]provider.Provider{
+			model.PaymentMethodCard:          card.NewProvider(simulator.NewProvider(model.PaymentMethodCard, cfg)),
+			model.PaymentMethodCreditCard:    card.NewProvider(simulator.NewProvider(model.PaymentMethodCreditCard, cfg)),
+			model.PaymentMethodSBP:           sbp.NewProvider(simulator.NewProvider(model.PaymentMethodSBP, cfg)),
+			model.PaymentMethodInvestorMoney: investor.NewProvider(simulator.NewProvider(model.PaymentMethodInvestorMoney, cfg)),
+		}
+	}
+
+	return d.providers, nil
+}
+
+// newSimulatorConfig разбирает правила отказов симулятора из конфигурации
+func newSimulatorConfig(cfg config.PaymentSimulatorConfig) (simulator.Config, error) {
+	byMethod := make(map[model.PaymentMethod]model.DeclineCode, len(cfg.DeclineCodesByMethod()))
+	for rawMethod, code := range cfg.DeclineCodesByMethod() {
+		method, err := model.ParsePaymentMethod(rawMethod)
+		if err != nil {
+			return simulator.Config{}, fmt.Errorf("parse simulator decline rules: %w", err)
+		}
+
+		byMethod[method] = model.DeclineCode(code)
+	}
+
+	asyncMethods := make([]model.PaymentMethod, 0, len(cfg.AsyncMethods()))
+	for _, rawMethod := range cfg.AsyncMethods() {
+		method, err := model.ParsePaymentMethod(rawMethod)
+		if err != nil {
+			return simulator.Config{}, fmt.Errorf("parse simulator async methods: %w", err)
+		}
+
+		asyncMethods = append(asyncMethods, method)
+	}
+
+	byCard := make(map[string]model.DeclineCode, len(cfg.DeclineCodesByCard()))
+	for cardToken, code := range cfg.DeclineCodesByCard() {
+		byCard[cardToken] = model.DeclineCode(code)
+	}
+
+	byUser := make(map[uuid.UUID]model.DeclineCode, len(cfg.DeclineCodesByUser()))
+	for rawUserUUID, code := range cfg.DeclineCodesByUser() {
+		userUUID, err := uuid.Parse(rawUserUUID)
+		if err != nil {
+			return simulator.Config{}, fmt.Errorf("parse simulator decline rules: invalid user uuid %q: %w", rawUserUUID, err)
+		}
+
+		byUser[userUUID] = model.DeclineCode(code)
+	}
+
+	return simulator.Config{
+		Latency:              cfg.Latency(),
+		LatencyJitter:        cfg.LatencyJitter(),
+		DeclineRate:          cfg.DeclineRate(),
+		DeclineCodesByMethod: byMethod,
+		DeclineCodesByUser:   byUser,
+		DeclineCodesByCard:   byCard,
+		AsyncMethods:         asyncMethods,
+		CallbackDelay:        cfg.CallbackDelay(),
+		CallbackBaseURL:      cfg.CallbackBaseURL(),
+		CallbackSecret:       config.AppConfig().PaymentHTTP.CallbackSecret(),
+	}, nil
+}
+
+// OrderConn возвращает соединение с order service. Нужно только для сверки оплат
+func (d *diContainer) OrderConn(ctx context.Context) (*grpc.ClientConn, error) {
+	if d.orderConn == nil {
+		address := config.AppConfig().Reconciliation.OrderAddress()
+		if address == "" {
+			return nil, fmt.Errorf("RECONCILE_ORDER_GRPC_ADDRESS is not set")
+		}
+
+		conn, err := grpc.NewClient(
+			address,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithChainUnaryInterceptor(
+				tracing.UnaryClientInterceptor(config.AppConfig().Tracing.ServiceName()),
+				grpcMiddleware.ServiceTokenClientInterceptor(config.AppConfig().Reconciliation.OrderServiceToken()),
+			),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("connect order grpc: %w", err)
+		}
+
+		closer.AddNamed("order gRPC connection", func(ctx context.Context) error {
+			return conn.Close()
+		})
+
+		d.orderConn = conn
+	}
+
+	return d.orderConn, nil
+}
+
+func (d *diContainer) OrderClient(ctx context.Context) (clientGrpc.OrderClient, error) {
+	if d.orderClient == nil {
+		conn, err := d.OrderConn(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.orderClient = orderClient.NewClient(
+			orderpb.NewOrderServiceClient(conn),
+		)
+	}
+
+	return d.orderClient, nil
+}
+
+func (d *diContainer) ReconciliationService(ctx context.Context) (service.ReconciliationService, error) {
+	if d.reconciliationService == nil {
+		transactionRepository, err := d.TransactionRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		orderClient, err := d.OrderClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.reconciliationService = reconciliationSvc.NewService(ctx, transactionRepository, orderClient)
+	}
+
+	return d.reconciliationService, nil
+}
+
+func (d *diContainer) RiskService(ctx context.Context) (service.RiskService, error) {
+	if d.riskService == nil {
+		transactionRepository, err := d.TransactionRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := newRiskConfig(config.AppConfig().Risk)
+		if err != nil {
+			return nil, err
+		}
+
+		d.riskService = riskSvc.NewService(transactionRepository, cfg)
+	}
+
+	return d.riskService, nil
+}
+
+// newRiskConfig разбирает правила риска из конфигурации
+func newRiskConfig(cfg config.PaymentRiskConfig) (riskSvc.Config, error) {
+	blockedUsers := make(map[uuid.UUID]struct{}, len(cfg.BlockedUsers()))
+	for _, rawUserUUID := range cfg.BlockedUsers() {
+		userUUID, err := uuid.Parse(rawUserUUID)
+		if err != nil {
+			return riskSvc.Config{}, fmt.Errorf("parse risk blocked users: invalid user uuid %q: %w", rawUserUUID, err)
+		}
+
+		blockedUsers[userUUID] = struct{}{}
+	}
+
+	dailyByMethod, err := parseMethodLimits(cfg.DailyLimitsByMethod())
+	if err != nil {
+		return riskSvc.Config{}, fmt.Errorf("parse risk daily limits: %w", err)
+	}
+
+	monthlyByMethod, err := parseMethodLimits(cfg.MonthlyLimitsByMethod())
+	if err != nil {
+		return riskSvc.Config{}, fmt.Errorf("parse risk monthly limits: %w", err)
+	}
+
+	return riskSvc.Config{
+		BlockedUsers:          blockedUsers,
+		DailyLimit:            cfg.DailyLimit(),
+		MonthlyLimit:          cfg.MonthlyLimit(),
+		DailyLimitsByMethod:   dailyByMethod,
+		MonthlyLimitsByMethod: monthlyByMethod,
+		VelocityMaxPayments:   cfg.VelocityMaxPayments(),
+		VelocityWindow:        cfg.VelocityWindow(),
+	}, nil
+}
+
+// parseMethodLimits разбирает лимиты вида CREDIT_CARD=100000
+func parseMethodLimits(raw map[string]string) (map[model.PaymentMethod]int64, error) {
+	limits := make(map[model.PaymentMethod]int64, len(raw))
+	for rawMethod, rawLimit := range raw {
+		method, err := model.ParsePaymentMethod(rawMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		limit, err := strconv.ParseInt(rawLimit, 10, 64)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid limit %q for %s", rawLimit, method)
+		}
+
+		limits[method] = limit
+	}
+
+	return limits, nil
+}
+
+func (d *diContainer) PaymentService(ctx context.Context) (service.PaymentService, error) {
 	if d.paymentService == nil {
-		d.paymentService = paymentSvc.NewService()
+		transactionRepository, err := d.TransactionRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		providers, err := d.Providers()
+		if err != nil {
+			return nil, err
+		}
+
+		riskService, err := d.RiskService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.paymentService = paymentSvc.NewService(transactionRepository, providers, riskService)
+	}
+
+	return d.paymentService, nil
+}
+
+func (d *diContainer) PaymentExpiryService(ctx context.Context) (service.PaymentExpiryService, error) {
+	if d.paymentExpiryService == nil {
+		paymentService, err := d.PaymentService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := config.AppConfig().PaymentExpiry
+
+		d.paymentExpiryService = paymentExpirySvc.NewService(
+			paymentService,
+			cfg.PendingTimeout(),
+			cfg.Interval(),
+			cfg.BatchSize(),
+		)
+
+		closer.AddNamed("Payment expiry job", d.paymentExpiryService.Stop)
 	}
 
-	return d.paymentService
+	return d.paymentExpiryService, nil
 }
 
-func (d *diContainer) API() *apiv1.API {
+func (d *diContainer) PaymentCompletedSyncProducer(ctx context.Context) (sarama.SyncProducer, error) {
+	if d.paymentCompletedSyncProducer == nil {
+		cfg := config.AppConfig()
+		producerCfg := cfg.PaymentCompletedProducer
+
+		producer, err := sarama.NewSyncProducer(
+			cfg.Kafka.Brokers(),
+			producerCfg.Config(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create sync producer: %w", err)
+		}
+
+		closer.AddNamed("PaymentCompleted sync producer", func(ctx context.Context) error {
+			return producer.Close()
+		})
+
+		d.paymentCompletedSyncProducer = producer
+	}
+
+	return d.paymentCompletedSyncProducer, nil
+}
+
+func (d *diContainer) PaymentCompletedProducer(ctx context.Context) (kafka.Producer, error) {
+	if d.paymentCompletedProducer == nil {
+		syncProducer, err := d.PaymentCompletedSyncProducer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := config.AppConfig()
+		topic := cfg.PaymentCompletedProducer.Topic()
+
+		d.paymentCompletedProducer = kafkaProducer.NewProducer(
+			syncProducer,
+			topic,
+			logger.Logger(),
+		)
+	}
+
+	return d.paymentCompletedProducer, nil
+}
+
+func (d *diContainer) PaymentFailedSyncProducer(ctx context.Context) (sarama.SyncProducer, error) {
+	if d.paymentFailedSyncProducer == nil {
+		cfg := config.AppConfig()
+		producerCfg := cfg.PaymentFailedProducer
+
+		producer, err := sarama.NewSyncProducer(
+			cfg.Kafka.Brokers(),
+			producerCfg.Config(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create sync producer: %w", err)
+		}
+
+		closer.AddNamed("PaymentFailed sync producer", func(ctx context.Context) error {
+			return producer.Close()
+		})
+
+		d.paymentFailedSyncProducer = producer
+	}
+
+	return d.paymentFailedSyncProducer, nil
+}
+
+func (d *diContainer) PaymentFailedProducer(ctx context.Context) (kafka.Producer, error) {
+	if d.paymentFailedProducer == nil {
+		syncProducer, err := d.PaymentFailedSyncProducer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := config.AppConfig()
+		topic := cfg.PaymentFailedProducer.Topic()
+
+		d.paymentFailedProducer = kafkaProducer.NewProducer(
+			syncProducer,
+			topic,
+			logger.Logger(),
+		)
+	}
+
+	return d.paymentFailedProducer, nil
+}
+
+func (d *diContainer) OutboxRelayService(ctx context.Context) (service.OutboxRelayService, error) {
+	if d.outboxRelayService == nil {
+		outboxRepository, err := d.OutboxRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		paymentCompletedProducer, err := d.PaymentCompletedProducer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		paymentFailedProducer, err := d.PaymentFailedProducer(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := config.AppConfig().OutboxRelay
+
+		d.outboxRelayService = outboxRelaySvc.NewService(
+			outboxRepository,
+			map[string]kafka.Producer{
+				model.EventTypePaymentCompleted: paymentCompletedProducer,
+				model.EventTypePaymentFailed:    paymentFailedProducer,
+			},
+			cfg.Interval(),
+			cfg.BatchSize(),
+		)
+	}
+
+	return d.outboxRelayService, nil
+}
+
+func (d *diContainer) API(ctx context.Context) (*apiv1.API, error) {
 	if d.api == nil {
-		d.api = apiv1.NewAPI(d.PaymentService())
+		paymentService, err := d.PaymentService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.api = apiv1.NewAPI(paymentService, config.AppConfig().PaymentHTTP.CallbackSecret())
 	}
 
-	return d.api
+	return d.api, nil
 }
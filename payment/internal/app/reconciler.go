@@ -0,0 +1,44 @@
+package app
+
+import (
+	"context"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+// Reconciler выполняет разовую сверку журнала платежей с заказами.
+// В отличие от App не поднимает серверы и не применяет миграции
+type Reconciler struct {
+	app *App
+}
+
+// NewReconciler создает Reconciler: инициализирует DI, логгер, closer и метрики.
+// Заказы читаются через API order service, поэтому доступ к его базе не нужен
+func NewReconciler(ctx context.Context) (*Reconciler, error) {
+	a := &App{}
+
+	inits := []func(context.Context) error{
+		a.initDI,
+		a.initLogger,
+		a.initCloser,
+		a.initMetrics,
+	}
+
+	for _, f := range inits {
+		if err := f(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Reconciler{app: a}, nil
+}
+
+// Run сверяет оплаты и оплаченные заказы за окно window
+func (r *Reconciler) Run(ctx context.Context, window model.ReconciliationWindow) (*model.ReconciliationReport, error) {
+	reconciliationService, err := r.app.diContainer.ReconciliationService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciliationService.Reconcile(ctx, window)
+}
@@ -0,0 +1,17 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+// OrderClient представляет интерфейс для работы с order service. Используется только для сверки оплат
+type OrderClient interface {
+	// ListPaidOrders возвращает заказы в статусах PAID и ASSEMBLED, измененные в окне window
+	ListPaidOrders(ctx context.Context, window model.ReconciliationWindow) ([]*model.OrderPayment, error)
+	// GetOrders возвращает найденные заказы по списку UUID; отсутствующие пропускаются
+	GetOrders(ctx context.Context, orderUUIDs []uuid.UUID) ([]*model.OrderPayment, error)
+}
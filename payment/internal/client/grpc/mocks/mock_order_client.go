@@ -0,0 +1,176 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOrderClient creates a new instance of MockOrderClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrderClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrderClient {
+	mock := &MockOrderClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOrderClient is an autogenerated mock type for the OrderClient type
+type MockOrderClient struct {
+	mock.Mock
+}
+
+type MockOrderClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrderClient) EXPECT() *MockOrderClient_Expecter {
+	return &MockOrderClient_Expecter{mock: &_m.Mock}
+}
+
+// GetOrders provides a mock function for the type MockOrderClient
+func (_mock *MockOrderClient) GetOrders(ctx context.Context, orderUUIDs []uuid.UUID) ([]*model.OrderPayment, error) {
+	ret := _mock.Called(ctx, orderUUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrders")
+	}
+
+	var r0 []*model.OrderPayment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*model.OrderPayment, error)); ok {
+		return returnFunc(ctx, orderUUIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*model.OrderPayment); ok {
+		r0 = returnFunc(ctx, orderUUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderPayment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderClient_GetOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrders'
+type MockOrderClient_GetOrders_Call struct {
+	*mock.Call
+}
+
+// GetOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUIDs []uuid.UUID
+func (_e *MockOrderClient_Expecter) GetOrders(ctx interface{}, orderUUIDs interface{}) *MockOrderClient_GetOrders_Call {
+	return &MockOrderClient_GetOrders_Call{Call: _e.mock.On("GetOrders", ctx, orderUUIDs)}
+}
+
+func (_c *MockOrderClient_GetOrders_Call) Run(run func(ctx context.Context, orderUUIDs []uuid.UUID)) *MockOrderClient_GetOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderClient_GetOrders_Call) Return(orderPayments []*model.OrderPayment, err error) *MockOrderClient_GetOrders_Call {
+	_c.Call.Return(orderPayments, err)
+	return _c
+}
+
+func (_c *MockOrderClient_GetOrders_Call) RunAndReturn(run func(ctx context.Context, orderUUIDs []uuid.UUID) ([]*model.OrderPayment, error)) *MockOrderClient_GetOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPaidOrders provides a mock function for the type MockOrderClient
+func (_mock *MockOrderClient) ListPaidOrders(ctx context.Context, window model.ReconciliationWindow) ([]*model.OrderPayment, error) {
+	ret := _mock.Called(ctx, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPaidOrders")
+	}
+
+	var r0 []*model.OrderPayment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ReconciliationWindow) ([]*model.OrderPayment, error)); ok {
+		return returnFunc(ctx, window)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ReconciliationWindow) []*model.OrderPayment); ok {
+		r0 = returnFunc(ctx, window)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderPayment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.ReconciliationWindow) error); ok {
+		r1 = returnFunc(ctx, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderClient_ListPaidOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPaidOrders'
+type MockOrderClient_ListPaidOrders_Call struct {
+	*mock.Call
+}
+
+// ListPaidOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window model.ReconciliationWindow
+func (_e *MockOrderClient_Expecter) ListPaidOrders(ctx interface{}, window interface{}) *MockOrderClient_ListPaidOrders_Call {
+	return &MockOrderClient_ListPaidOrders_Call{Call: _e.mock.On("ListPaidOrders", ctx, window)}
+}
+
+func (_c *MockOrderClient_ListPaidOrders_Call) Run(run func(ctx context.Context, window model.ReconciliationWindow)) *MockOrderClient_ListPaidOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ReconciliationWindow
+		if args[1] != nil {
+			arg1 = args[1].(model.ReconciliationWindow)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderClient_ListPaidOrders_Call) Return(orderPayments []*model.OrderPayment, err error) *MockOrderClient_ListPaidOrders_Call {
+	_c.Call.Return(orderPayments, err)
+	return _c
+}
+
+func (_c *MockOrderClient_ListPaidOrders_Call) RunAndReturn(run func(ctx context.Context, window model.ReconciliationWindow) ([]*model.OrderPayment, error)) *MockOrderClient_ListPaidOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	orderpb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/order/v1"
+)
+
+const (
+	// pageSize - размер страницы ListOrders и число UUID в одном запросе GetOrders; максимум, который принимает order service
+	pageSize = 100
+	// orderCurrency - валюта заказов; order service хранит цены только в рублях
+	orderCurrency = "RUB"
+)
+
+// paidStatuses - статусы заказа, в которых у него должна быть проведенная оплата
+var paidStatuses = []orderpb.OrderStatus{
+	orderpb.OrderStatus_ORDER_STATUS_PAID,
+	orderpb.OrderStatus_ORDER_STATUS_ASSEMBLED,
+}
+
+// Client реализует интерфейс OrderClient. Вызовы аутентифицируются сервисным токеном,
+// поэтому order service отдает заказы всех пользователей
+type Client struct {
+	orderClient orderpb.OrderServiceClient
+}
+
+// NewClient создает новый экземпляр Client
+func NewClient(orderClient orderpb.OrderServiceClient) *Client {
+	return &Client{
+		orderClient: orderClient,
+	}
+}
+
+// ListPaidOrders возвращает заказы в статусах PAID и ASSEMBLED, измененные в окне window
+func (c *Client) ListPaidOrders(ctx context.Context, window model.ReconciliationWindow) ([]*model.OrderPayment, error) {
+	return c.listOrders(ctx, &orderpb.ListOrdersRequest{
+		Statuses:    paidStatuses,
+		UpdatedFrom: timestamppb.New(window.Since),
+		UpdatedTo:   timestamppb.New(window.Until),
+	})
+}
+
+// GetOrders возвращает найденные заказы по списку UUID; отсутствующие пропускаются
+func (c *Client) GetOrders(ctx context.Context, orderUUIDs []uuid.UUID) ([]*model.OrderPayment, error) {
+	var orders []*model.OrderPayment
+
+	for start := 0; start < len(orderUUIDs); start += pageSize {
+		end := min(start+pageSize, len(orderUUIDs))
+
+		chunk := make([]string, 0, end-start)
+		for _, orderUUID := range orderUUIDs[start:end] {
+			chunk = append(chunk, orderUUID.String())
+		}
+
+		found, err := c.listOrders(ctx, &orderpb.ListOrdersRequest{OrderUuids: chunk})
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, found...)
+	}
+
+	return orders, nil
+}
+
+// listOrders читает все страницы ListOrders по фильтру req
+func (c *Client) listOrders(ctx context.Context, req *orderpb.ListOrdersRequest) ([]*model.OrderPayment, error) {
+	req.PageSize = pageSize
+
+	var orders []*model.OrderPayment
+
+	for {
+		resp, err := c.orderClient.ListOrders(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list orders: %w", err)
+		}
+
+		for _, order := range resp.GetOrders() {
+			orderPayment, err := toOrderPayment(order)
+			if err != nil {
+				return nil, err
+			}
+
+			orders = append(orders, orderPayment)
+		}
+
+		if resp.GetNextPageToken() == "" {
+			return orders, nil
+		}
+
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
+
+// toOrderPayment конвертирует заказ из ответа order service в модель service.
+// Цена заказа приходит в рублях с копейками и переводится в минимальные единицы
+func toOrderPayment(order *orderpb.GetOrderResponse) (*model.OrderPayment, error) {
+	orderUUID, err := uuid.Parse(order.GetOrderUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid order uuid %q: %w", order.GetOrderUuid(), err)
+	}
+
+	userUUID, err := uuid.Parse(order.GetUserUuid())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user uuid %q of order %s: %w", order.GetUserUuid(), orderUUID, err)
+	}
+
+	var transactionUUID *uuid.UUID
+
+	if order.TransactionUuid != nil {
+		parsed, err := uuid.Parse(order.GetTransactionUuid())
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction uuid %q of order %s: %w", order.GetTransactionUuid(), orderUUID, err)
+		}
+
+		transactionUUID = &parsed
+	}
+
+	return &model.OrderPayment{
+		OrderUUID:       orderUUID,
+		UserUUID:        userUUID,
+		Status:          strings.TrimPrefix(order.GetStatus().String(), "ORDER_STATUS_"),
+		TransactionUUID: transactionUUID,
+		Amount:          int64(math.Round(order.GetTotalPrice() * 100)),
+		Currency:        orderCurrency,
+	}, nil
+}
@@ -11,9 +11,22 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger      LoggerConfig
-	Tracing     TracingConfig
-	PaymentGRPC PaymentGRPCConfig
+	Logger                   LoggerConfig
+	Tracing                  TracingConfig
+	Metrics                  MetricsConfig
+	PaymentGRPC              PaymentGRPCConfig
+	PaymentHTTP              PaymentHTTPConfig
+	ServiceAuth              ServiceAuthConfig
+	Postgres                 PostgresConfig
+	Migrations               MigrationsConfig
+	Simulator                PaymentSimulatorConfig
+	Risk                     PaymentRiskConfig
+	Kafka                    KafkaConfig
+	PaymentCompletedProducer PaymentCompletedProducerConfig
+	PaymentFailedProducer    PaymentFailedProducerConfig
+	OutboxRelay              OutboxRelayConfig
+	PaymentExpiry            PaymentExpiryConfig
+	Reconciliation           ReconciliationConfig
 }
 
 func Load(path ...string) error {
@@ -32,15 +45,93 @@ func Load(path ...string) error {
 		return err
 	}
 
+	metricsCfg, err := env.NewMetricsConfig()
+	if err != nil {
+		return err
+	}
+
 	paymentGRPCCfg, err := env.NewPaymentGRPCConfig()
 	if err != nil {
 		return err
 	}
 
+	paymentHTTPCfg, err := env.NewPaymentHTTPConfig()
+	if err != nil {
+		return err
+	}
+
+	serviceAuthCfg, err := env.NewServiceAuthConfig()
+	if err != nil {
+		return err
+	}
+
+	postgresCfg, err := env.NewPostgresConfig()
+	if err != nil {
+		return err
+	}
+
+	migrationsCfg, err := env.NewMigrationsConfig()
+	if err != nil {
+		return err
+	}
+
+	simulatorCfg, err := env.NewPaymentSimulatorConfig()
+	if err != nil {
+		return err
+	}
+
+	riskCfg, err := env.NewPaymentRiskConfig()
+	if err != nil {
+		return err
+	}
+
+	kafkaCfg, err := env.NewKafkaConfig()
+	if err != nil {
+		return err
+	}
+
+	paymentCompletedProducerCfg, err := env.NewPaymentCompletedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	paymentFailedProducerCfg, err := env.NewPaymentFailedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	outboxRelayCfg, err := env.NewOutboxRelayConfig()
+	if err != nil {
+		return err
+	}
+
+	paymentExpiryCfg, err := env.NewPaymentExpiryConfig()
+	if err != nil {
+		return err
+	}
+
+	reconciliationCfg, err := env.NewReconciliationConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Logger:      loggerCfg,
-		Tracing:     tracingCfg,
-		PaymentGRPC: paymentGRPCCfg,
+		Logger:                   loggerCfg,
+		Tracing:                  tracingCfg,
+		Metrics:                  metricsCfg,
+		PaymentGRPC:              paymentGRPCCfg,
+		PaymentHTTP:              paymentHTTPCfg,
+		ServiceAuth:              serviceAuthCfg,
+		Postgres:                 postgresCfg,
+		Migrations:               migrationsCfg,
+		Simulator:                simulatorCfg,
+		Risk:                     riskCfg,
+		Kafka:                    kafkaCfg,
+		PaymentCompletedProducer: paymentCompletedProducerCfg,
+		PaymentFailedProducer:    paymentFailedProducerCfg,
+		OutboxRelay:              outboxRelayCfg,
+		PaymentExpiry:            paymentExpiryCfg,
+		Reconciliation:           reconciliationCfg,
 	}
 
 	return nil
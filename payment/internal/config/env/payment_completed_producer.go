@@ -0,0 +1,35 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type PaymentCompletedProducerEnvConfig struct {
+	Topic string `env:"PAYMENT_COMPLETED_TOPIC_NAME,required"`
+}
+
+type paymentCompletedProducerConfig struct {
+	raw PaymentCompletedProducerEnvConfig
+}
+
+func NewPaymentCompletedProducerConfig() (*paymentCompletedProducerConfig, error) {
+	var raw PaymentCompletedProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentCompletedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *paymentCompletedProducerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *paymentCompletedProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
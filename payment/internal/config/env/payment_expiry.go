@@ -0,0 +1,39 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentExpiryEnvConfig struct {
+	PendingTimeout time.Duration `env:"PAYMENT_PENDING_TIMEOUT" envDefault:"15m"`
+	Interval       time.Duration `env:"PAYMENT_EXPIRY_INTERVAL" envDefault:"1m"`
+	BatchSize      int           `env:"PAYMENT_EXPIRY_BATCH_SIZE" envDefault:"100"`
+}
+
+type paymentExpiryConfig struct {
+	raw paymentExpiryEnvConfig
+}
+
+func NewPaymentExpiryConfig() (*paymentExpiryConfig, error) {
+	var raw paymentExpiryEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentExpiryConfig{raw: raw}, nil
+}
+
+// PendingTimeout — время, после которого неподтвержденная асинхронная оплата завершается отказом
+func (cfg *paymentExpiryConfig) PendingTimeout() time.Duration {
+	return cfg.raw.PendingTimeout
+}
+
+func (cfg *paymentExpiryConfig) Interval() time.Duration {
+	return cfg.raw.Interval
+}
+
+func (cfg *paymentExpiryConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
@@ -0,0 +1,35 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type PaymentFailedProducerEnvConfig struct {
+	Topic string `env:"PAYMENT_FAILED_TOPIC_NAME,required"`
+}
+
+type paymentFailedProducerConfig struct {
+	raw PaymentFailedProducerEnvConfig
+}
+
+func NewPaymentFailedProducerConfig() (*paymentFailedProducerConfig, error) {
+	var raw PaymentFailedProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentFailedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *paymentFailedProducerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *paymentFailedProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
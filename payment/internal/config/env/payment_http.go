@@ -0,0 +1,35 @@
+package env
+
+import (
+	"net"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentHTTPEnvConfig struct {
+	Host           string `env:"HTTP_HOST" envDefault:"0.0.0.0"`
+	Port           string `env:"HTTP_PORT" envDefault:"8082"`
+	CallbackSecret string `env:"PROVIDER_CALLBACK_SECRET" envDefault:""`
+}
+
+type paymentHTTPConfig struct {
+	raw paymentHTTPEnvConfig
+}
+
+func NewPaymentHTTPConfig() (*paymentHTTPConfig, error) {
+	var raw paymentHTTPEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentHTTPConfig{raw: raw}, nil
+}
+
+func (cfg *paymentHTTPConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
+
+// CallbackSecret — общий с провайдерами секрет подписи callback'ов; пустой секрет отклоняет все callback'и
+func (cfg *paymentHTTPConfig) CallbackSecret() string {
+	return cfg.raw.CallbackSecret
+}
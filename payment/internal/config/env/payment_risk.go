@@ -0,0 +1,82 @@
+package env
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentRiskEnvConfig struct {
+	BlockedUsers          []string          `env:"RISK_BLOCKED_USERS"`
+	DailyLimit            int64             `env:"RISK_DAILY_LIMIT" envDefault:"0"`
+	MonthlyLimit          int64             `env:"RISK_MONTHLY_LIMIT" envDefault:"0"`
+	DailyLimitsByMethod   map[string]string `env:"RISK_DAILY_LIMITS_BY_METHOD" envKeyValSeparator:"="`
+	MonthlyLimitsByMethod map[string]string `env:"RISK_MONTHLY_LIMITS_BY_METHOD" envKeyValSeparator:"="`
+	VelocityMaxPayments   int               `env:"RISK_VELOCITY_MAX_PAYMENTS" envDefault:"0"`
+	VelocityWindow        time.Duration     `env:"RISK_VELOCITY_WINDOW" envDefault:"1m"`
+}
+
+type paymentRiskConfig struct {
+	raw paymentRiskEnvConfig
+}
+
+func NewPaymentRiskConfig() (*paymentRiskConfig, error) {
+	var raw paymentRiskEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.DailyLimit < 0 {
+		return nil, fmt.Errorf("RISK_DAILY_LIMIT must not be negative, got %d", raw.DailyLimit)
+	}
+
+	if raw.MonthlyLimit < 0 {
+		return nil, fmt.Errorf("RISK_MONTHLY_LIMIT must not be negative, got %d", raw.MonthlyLimit)
+	}
+
+	if raw.VelocityMaxPayments < 0 {
+		return nil, fmt.Errorf("RISK_VELOCITY_MAX_PAYMENTS must not be negative, got %d", raw.VelocityMaxPayments)
+	}
+
+	if raw.VelocityMaxPayments > 0 && raw.VelocityWindow <= 0 {
+		return nil, fmt.Errorf("RISK_VELOCITY_WINDOW must be positive, got %v", raw.VelocityWindow)
+	}
+
+	return &paymentRiskConfig{raw: raw}, nil
+}
+
+// BlockedUsers — UUID пользователей, которым запрещено оплачивать заказы
+func (cfg *paymentRiskConfig) BlockedUsers() []string {
+	return cfg.raw.BlockedUsers
+}
+
+// DailyLimit — лимит суммы оплат пользователя за 24 часа, 0 — без лимита
+func (cfg *paymentRiskConfig) DailyLimit() int64 {
+	return cfg.raw.DailyLimit
+}
+
+// MonthlyLimit — лимит суммы оплат пользователя за 30 дней, 0 — без лимита
+func (cfg *paymentRiskConfig) MonthlyLimit() int64 {
+	return cfg.raw.MonthlyLimit
+}
+
+// DailyLimitsByMethod — дневные лимиты по способу оплаты, например CREDIT_CARD=100000
+func (cfg *paymentRiskConfig) DailyLimitsByMethod() map[string]string {
+	return cfg.raw.DailyLimitsByMethod
+}
+
+// MonthlyLimitsByMethod — месячные лимиты по способу оплаты, например CREDIT_CARD=1000000
+func (cfg *paymentRiskConfig) MonthlyLimitsByMethod() map[string]string {
+	return cfg.raw.MonthlyLimitsByMethod
+}
+
+// VelocityMaxPayments — сколько оплат пользователь может провести за VelocityWindow, 0 — без ограничения
+func (cfg *paymentRiskConfig) VelocityMaxPayments() int {
+	return cfg.raw.VelocityMaxPayments
+}
+
+// VelocityWindow — окно ограничения частоты оплат
+func (cfg *paymentRiskConfig) VelocityWindow() time.Duration {
+	return cfg.raw.VelocityWindow
+}
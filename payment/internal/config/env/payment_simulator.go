@@ -0,0 +1,82 @@
+package env
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentSimulatorEnvConfig struct {
+	Latency              time.Duration     `env:"SIMULATOR_LATENCY" envDefault:"0s"`
+	LatencyJitter        time.Duration     `env:"SIMULATOR_LATENCY_JITTER" envDefault:"0s"`
+	DeclineRate          float64           `env:"SIMULATOR_DECLINE_RATE" envDefault:"0"`
+	DeclineCodesByMethod map[string]string `env:"SIMULATOR_DECLINE_CODES_BY_METHOD" envKeyValSeparator:"="`
+	DeclineCodesByUser   map[string]string `env:"SIMULATOR_DECLINE_CODES_BY_USER" envKeyValSeparator:"="`
+	DeclineCodesByCard   map[string]string `env:"SIMULATOR_DECLINE_CODES_BY_CARD" envKeyValSeparator:"="`
+	AsyncMethods         []string          `env:"SIMULATOR_ASYNC_METHODS" envDefault:"SBP,INVESTOR_MONEY"`
+	CallbackDelay        time.Duration     `env:"SIMULATOR_CALLBACK_DELAY" envDefault:"5s"`
+	CallbackBaseURL      string            `env:"SIMULATOR_CALLBACK_BASE_URL" envDefault:"http://localhost:8082"`
+}
+
+type paymentSimulatorConfig struct {
+	raw paymentSimulatorEnvConfig
+}
+
+func NewPaymentSimulatorConfig() (*paymentSimulatorConfig, error) {
+	var raw paymentSimulatorEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.DeclineRate < 0 || raw.DeclineRate > 1 {
+		return nil, fmt.Errorf("SIMULATOR_DECLINE_RATE must be between 0 and 1, got %v", raw.DeclineRate)
+	}
+
+	return &paymentSimulatorConfig{raw: raw}, nil
+}
+
+// Latency — задержка ответа симулятора на каждое списание
+func (cfg *paymentSimulatorConfig) Latency() time.Duration {
+	return cfg.raw.Latency
+}
+
+// LatencyJitter — верхняя граница случайной добавки к задержке
+func (cfg *paymentSimulatorConfig) LatencyJitter() time.Duration {
+	return cfg.raw.LatencyJitter
+}
+
+// DeclineRate — доля случайных отказов, от 0 до 1
+func (cfg *paymentSimulatorConfig) DeclineRate() float64 {
+	return cfg.raw.DeclineRate
+}
+
+// DeclineCodesByMethod — коды отказа по способу оплаты, например CREDIT_CARD=LIMIT_EXCEEDED
+func (cfg *paymentSimulatorConfig) DeclineCodesByMethod() map[string]string {
+	return cfg.raw.DeclineCodesByMethod
+}
+
+// DeclineCodesByUser — коды отказа по UUID пользователя
+func (cfg *paymentSimulatorConfig) DeclineCodesByUser() map[string]string {
+	return cfg.raw.DeclineCodesByUser
+}
+
+// DeclineCodesByCard — коды отказа по токену карты
+func (cfg *paymentSimulatorConfig) DeclineCodesByCard() map[string]string {
+	return cfg.raw.DeclineCodesByCard
+}
+
+// AsyncMethods — способы оплаты, итог которых приходит callback'ом, например SBP,INVESTOR_MONEY
+func (cfg *paymentSimulatorConfig) AsyncMethods() []string {
+	return cfg.raw.AsyncMethods
+}
+
+// CallbackDelay — задержка callback'а асинхронной оплаты
+func (cfg *paymentSimulatorConfig) CallbackDelay() time.Duration {
+	return cfg.raw.CallbackDelay
+}
+
+// CallbackBaseURL — адрес HTTP API payment service, на который симулятор отправляет callback'и
+func (cfg *paymentSimulatorConfig) CallbackBaseURL() string {
+	return cfg.raw.CallbackBaseURL
+}
@@ -0,0 +1,56 @@
+package env
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type reconciliationEnvConfig struct {
+	OrderAddress      string        `env:"RECONCILE_ORDER_GRPC_ADDRESS"`
+	OrderServiceToken string        `env:"RECONCILE_ORDER_SERVICE_TOKEN" envDefault:""`
+	Window            time.Duration `env:"RECONCILE_WINDOW" envDefault:"24h"`
+	SettleDelay       time.Duration `env:"RECONCILE_SETTLE_DELAY" envDefault:"5m"`
+}
+
+type reconciliationConfig struct {
+	raw reconciliationEnvConfig
+}
+
+func NewReconciliationConfig() (*reconciliationConfig, error) {
+	var raw reconciliationEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.Window <= 0 {
+		return nil, fmt.Errorf("RECONCILE_WINDOW must be positive, got %v", raw.Window)
+	}
+
+	if raw.SettleDelay < 0 {
+		return nil, fmt.Errorf("RECONCILE_SETTLE_DELAY must not be negative, got %v", raw.SettleDelay)
+	}
+
+	return &reconciliationConfig{raw: raw}, nil
+}
+
+// OrderAddress — адрес gRPC-сервера order service в формате host:port
+func (cfg *reconciliationConfig) OrderAddress() string {
+	return cfg.raw.OrderAddress
+}
+
+// OrderServiceToken — сервисный токен, с которым сверка читает заказы всех пользователей через ListOrders
+func (cfg *reconciliationConfig) OrderServiceToken() string {
+	return cfg.raw.OrderServiceToken
+}
+
+// Window — длина окна сверки по умолчанию
+func (cfg *reconciliationConfig) Window() time.Duration {
+	return cfg.raw.Window
+}
+
+// SettleDelay — отступ конца окна от текущего момента, чтобы не сверять оплаты, которые еще проводятся
+func (cfg *reconciliationConfig) SettleDelay() time.Duration {
+	return cfg.raw.SettleDelay
+}
@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type serviceAuthEnvConfig struct {
+	Token string `env:"SERVICE_AUTH_TOKEN" envDefault:""`
+}
+
+type serviceAuthConfig struct {
+	raw serviceAuthEnvConfig
+}
+
+func NewServiceAuthConfig() (*serviceAuthConfig, error) {
+	var raw serviceAuthEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &serviceAuthConfig{raw: raw}, nil
+}
+
+// Token возвращает сервисный токен, без которого payment отклоняет вызовы, кроме callback провайдеров
+func (cfg *serviceAuthConfig) Token() string {
+	return cfg.raw.Token
+}
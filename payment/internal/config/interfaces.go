@@ -1,5 +1,11 @@
 package config
 
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
 type LoggerConfig interface {
 	Level() string
 	AsJSON() bool
@@ -15,6 +21,86 @@ type TracingConfig interface {
 	Environment() string
 }
 
+type MetricsConfig interface {
+	CollectorEndpoint() string
+	CollectorInterval() time.Duration
+}
+
 type PaymentGRPCConfig interface {
 	Address() string
 }
+
+type PaymentHTTPConfig interface {
+	Address() string
+	CallbackSecret() string
+}
+
+type ServiceAuthConfig interface {
+	Token() string
+}
+
+type PostgresConfig interface {
+	DSN() string
+	PoolMaxConns() int32
+	PoolMinConns() int32
+	PoolMaxConnLifetime() time.Duration
+	PoolMaxConnIdleTime() time.Duration
+}
+
+type MigrationsConfig interface {
+	Directory() string
+}
+
+type PaymentSimulatorConfig interface {
+	Latency() time.Duration
+	LatencyJitter() time.Duration
+	DeclineRate() float64
+	DeclineCodesByMethod() map[string]string
+	DeclineCodesByUser() map[string]string
+	DeclineCodesByCard() map[string]string
+	AsyncMethods() []string
+	CallbackDelay() time.Duration
+	CallbackBaseURL() string
+}
+
+type PaymentRiskConfig interface {
+	BlockedUsers() []string
+	DailyLimit() int64
+	MonthlyLimit() int64
+	DailyLimitsByMethod() map[string]string
+	MonthlyLimitsByMethod() map[string]string
+	VelocityMaxPayments() int
+	VelocityWindow() time.Duration
+}
+
+type KafkaConfig interface {
+	Brokers() []string
+}
+
+type PaymentCompletedProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
+}
+
+type PaymentFailedProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
+}
+
+type OutboxRelayConfig interface {
+	Interval() time.Duration
+	BatchSize() int
+}
+
+type PaymentExpiryConfig interface {
+	PendingTimeout() time.Duration
+	Interval() time.Duration
+	BatchSize() int
+}
+
+type ReconciliationConfig interface {
+	OrderAddress() string
+	OrderServiceToken() string
+	Window() time.Duration
+	SettleDelay() time.Duration
+}
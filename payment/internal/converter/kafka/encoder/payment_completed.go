@@ -0,0 +1,30 @@
+package encoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/converter"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+func EncodePaymentCompleted(paymentCompleted model.PaymentCompleted) ([]byte, error) {
+	pb := &eventspb.PaymentCompleted{
+		EventUuid:       paymentCompleted.EventUUID.String(),
+		TransactionUuid: paymentCompleted.TransactionUUID.String(),
+		OrderUuid:       paymentCompleted.OrderUUID.String(),
+		UserUuid:        paymentCompleted.UserUUID.String(),
+		PaymentMethod:   converter.PaymentMethodToProtobuf(paymentCompleted.PaymentMethod),
+		Amount:          paymentCompleted.Amount,
+		Currency:        paymentCompleted.Currency,
+	}
+
+	data, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal PaymentCompleted: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,29 @@
+package encoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/converter"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+func EncodePaymentFailed(paymentFailed model.PaymentFailed) ([]byte, error) {
+	pb := &eventspb.PaymentFailed{
+		EventUuid:       paymentFailed.EventUUID.String(),
+		TransactionUuid: paymentFailed.TransactionUUID.String(),
+		OrderUuid:       paymentFailed.OrderUUID.String(),
+		UserUuid:        paymentFailed.UserUUID.String(),
+		PaymentMethod:   converter.PaymentMethodToProtobuf(paymentFailed.PaymentMethod),
+		DeclineCode:     string(paymentFailed.DeclineCode),
+	}
+
+	data, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal PaymentFailed: %w", err)
+	}
+
+	return data, nil
+}
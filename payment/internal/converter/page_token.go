@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+// pageToken представляет содержимое курсора пагинации списка транзакций
+type pageToken struct {
+	CreatedAt       time.Time `json:"c"`
+	TransactionUUID uuid.UUID `json:"u"`
+}
+
+// EncodePageToken кодирует курсор в непрозрачную строку для клиента
+func EncodePageToken(cursor *model.TransactionCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(pageToken{
+		CreatedAt:       cursor.CreatedAt,
+		TransactionUUID: cursor.TransactionUUID,
+	})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodePageToken декодирует курсор из строки, полученной от клиента.
+// Пустая строка означает первую страницу
+func DecodePageToken(token string) (*model.TransactionCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode page token: %w", err)
+	}
+
+	var t pageToken
+
+	if err = json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("failed to parse page token: %w", err)
+	}
+
+	if t.TransactionUUID == uuid.Nil || t.CreatedAt.IsZero() {
+		return nil, fmt.Errorf("page token is incomplete")
+	}
+
+	return &model.TransactionCursor{
+		CreatedAt:       t.CreatedAt,
+		TransactionUUID: t.TransactionUUID,
+	}, nil
+}
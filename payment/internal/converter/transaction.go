@@ -0,0 +1,124 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+// PaymentMethodFromProtobuf конвертирует protobuf способ оплаты в модель service
+func PaymentMethodFromProtobuf(paymentMethod pb.PaymentMethod) model.PaymentMethod {
+	switch paymentMethod {
+	case pb.PaymentMethod_PAYMENT_METHOD_CARD:
+		return model.PaymentMethodCard
+	case pb.PaymentMethod_PAYMENT_METHOD_SBP:
+		return model.PaymentMethodSBP
+	case pb.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD:
+		return model.PaymentMethodCreditCard
+	case pb.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY:
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnspecified
+	}
+}
+
+// PaymentMethodToProtobuf конвертирует способ оплаты модели service в protobuf
+func PaymentMethodToProtobuf(paymentMethod model.PaymentMethod) pb.PaymentMethod {
+	switch paymentMethod {
+	case model.PaymentMethodCard:
+		return pb.PaymentMethod_PAYMENT_METHOD_CARD
+	case model.PaymentMethodSBP:
+		return pb.PaymentMethod_PAYMENT_METHOD_SBP
+	case model.PaymentMethodCreditCard:
+		return pb.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD
+	case model.PaymentMethodInvestorMoney:
+		return pb.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY
+	default:
+		return pb.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
+
+// ToProtoTransaction конвертирует транзакцию модели service в protobuf
+func ToProtoTransaction(transaction *model.Transaction) *pb.Transaction {
+	if transaction == nil {
+		return nil
+	}
+
+	result := &pb.Transaction{
+		TransactionUuid: transaction.TransactionUUID.String(),
+		OrderUuid:       transaction.OrderUUID.String(),
+		UserUuid:        transaction.UserUUID.String(),
+		Type:            transactionTypeToProtobuf(transaction.Type),
+		Status:          TransactionStatusToProtobuf(transaction.Status),
+		PaymentMethod:   PaymentMethodToProtobuf(transaction.PaymentMethod),
+		Amount:          transaction.Amount,
+		Currency:        transaction.Currency,
+		FailureCode:     string(transaction.FailureCode),
+		CreatedAt:       timestamppb.New(transaction.CreatedAt),
+		UpdatedAt:       timestamppb.New(transaction.UpdatedAt),
+	}
+
+	if transaction.ParentTransactionUUID != nil {
+		parentUUID := transaction.ParentTransactionUUID.String()
+		result.ParentTransactionUuid = &parentUUID
+	}
+
+	return result
+}
+
+// ToProtoListTransactionsResponse конвертирует страницу транзакций в ответ ListTransactions
+func ToProtoListTransactionsResponse(page *model.TransactionPage) *pb.ListTransactionsResponse {
+	transactions := make([]*pb.Transaction, 0, len(page.Transactions))
+	for _, transaction := range page.Transactions {
+		transactions = append(transactions, ToProtoTransaction(transaction))
+	}
+
+	return &pb.ListTransactionsResponse{
+		Transactions:  transactions,
+		NextPageToken: EncodePageToken(page.NextCursor),
+	}
+}
+
+func transactionTypeToProtobuf(transactionType model.TransactionType) pb.TransactionType {
+	switch transactionType {
+	case model.TransactionTypePayment:
+		return pb.TransactionType_TRANSACTION_TYPE_PAYMENT
+	case model.TransactionTypeRefund:
+		return pb.TransactionType_TRANSACTION_TYPE_REFUND
+	default:
+		return pb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+	}
+}
+
+// TransactionStatusFromProtobuf конвертирует protobuf статус транзакции в модель service
+func TransactionStatusFromProtobuf(status pb.TransactionStatus) model.TransactionStatus {
+	switch status {
+	case pb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED:
+		return model.TransactionStatusSucceeded
+	case pb.TransactionStatus_TRANSACTION_STATUS_REFUNDED:
+		return model.TransactionStatusRefunded
+	case pb.TransactionStatus_TRANSACTION_STATUS_PENDING:
+		return model.TransactionStatusPending
+	case pb.TransactionStatus_TRANSACTION_STATUS_FAILED:
+		return model.TransactionStatusFailed
+	default:
+		return model.TransactionStatusUnspecified
+	}
+}
+
+// TransactionStatusToProtobuf конвертирует статус транзакции модели service в protobuf
+func TransactionStatusToProtobuf(status model.TransactionStatus) pb.TransactionStatus {
+	switch status {
+	case model.TransactionStatusSucceeded:
+		return pb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED
+	case model.TransactionStatusRefunded:
+		return pb.TransactionStatus_TRANSACTION_STATUS_REFUNDED
+	case model.TransactionStatusPending:
+		return pb.TransactionStatus_TRANSACTION_STATUS_PENDING
+	case model.TransactionStatusFailed:
+		return pb.TransactionStatus_TRANSACTION_STATUS_FAILED
+	default:
+		return pb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"fmt"
+)
+
+// DeclineCode - код отказа платежного провайдера
+type DeclineCode string
+
+const (
+	DeclineCodeDoNotHonor        DeclineCode = "DO_NOT_HONOR"
+	DeclineCodeInsufficientFunds DeclineCode = "INSUFFICIENT_FUNDS"
+	DeclineCodeCardExpired       DeclineCode = "CARD_EXPIRED"
+	DeclineCodeLimitExceeded     DeclineCode = "LIMIT_EXCEEDED"
+	DeclineCodeSuspectedFraud    DeclineCode = "SUSPECTED_FRAUD"
+	// DeclineCodeTimeout - провайдер не подтвердил асинхронную оплату вовремя
+	DeclineCodeTimeout DeclineCode = "TIMEOUT"
+)
+
+// PaymentDeclinedError описывает отказ провайдера в списании средств.
+// Сопоставляется с ErrPaymentDeclined через errors.Is
+type PaymentDeclinedError struct {
+	PaymentMethod PaymentMethod
+	Code          DeclineCode
+}
+
+// Error реализует интерфейс error
+func (e *PaymentDeclinedError) Error() string {
+	return fmt.Sprintf("%s: %s via %s", ErrPaymentDeclined, e.Code, e.PaymentMethod)
+}
+
+// Is позволяет сравнивать ошибку с ErrPaymentDeclined через errors.Is
+func (e *PaymentDeclinedError) Is(target error) bool {
+	return target == ErrPaymentDeclined
+}
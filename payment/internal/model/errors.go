@@ -4,5 +4,23 @@ import (
 	"errors"
 )
 
-// ErrInvalidPaymentRequest - ошибка "некорректный запрос на оплату"
-var ErrInvalidPaymentRequest = errors.New("invalid payment request")
+var (
+	// ErrInvalidPaymentRequest - ошибка "некорректный запрос на оплату"
+	ErrInvalidPaymentRequest = errors.New("invalid payment request")
+	// ErrInvalidRefundRequest - ошибка "некорректный запрос на возврат"
+	ErrInvalidRefundRequest = errors.New("invalid refund request")
+	// ErrInvalidCallbackRequest - ошибка "некорректный callback провайдера"
+	ErrInvalidCallbackRequest = errors.New("invalid payment callback request")
+	// ErrInvalidTransactionRequest - ошибка "некорректный запрос транзакций"
+	ErrInvalidTransactionRequest = errors.New("invalid transaction request")
+	// ErrTransactionNotFound - ошибка "транзакция не найдена"
+	ErrTransactionNotFound = errors.New("transaction not found")
+	// ErrOrderAlreadyPaid - ошибка "заказ уже оплачен"
+	ErrOrderAlreadyPaid = errors.New("order already paid")
+	// ErrPaymentDeclined - ошибка "провайдер отклонил платеж"
+	ErrPaymentDeclined = errors.New("payment declined")
+	// ErrPaymentRejected - ошибка "платеж отклонен правилами риска"
+	ErrPaymentRejected = errors.New("payment rejected by risk rules")
+	// ErrPaymentAlreadyFinalized - ошибка "оплата уже завершена с другим итогом"
+	ErrPaymentAlreadyFinalized = errors.New("payment already finalized")
+)
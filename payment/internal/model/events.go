@@ -0,0 +1,26 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+// PaymentCompleted представляет событие о подтверждении асинхронной оплаты
+type PaymentCompleted struct {
+	EventUUID       uuid.UUID
+	TransactionUUID uuid.UUID
+	OrderUUID       uuid.UUID
+	UserUUID        uuid.UUID
+	PaymentMethod   PaymentMethod
+	Amount          int64
+	Currency        string
+}
+
+// PaymentFailed представляет событие об отказе в асинхронной оплате
+type PaymentFailed struct {
+	EventUUID       uuid.UUID
+	TransactionUUID uuid.UUID
+	OrderUUID       uuid.UUID
+	UserUUID        uuid.UUID
+	PaymentMethod   PaymentMethod
+	DeclineCode     DeclineCode
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// EventTypePaymentCompleted - тип события "оплата подтверждена" в outbox
+	EventTypePaymentCompleted = "PaymentCompleted"
+	// EventTypePaymentFailed - тип события "оплата не прошла" в outbox
+	EventTypePaymentFailed = "PaymentFailed"
+)
+
+// OutboxMessage представляет событие, ожидающее публикации в Kafka
+type OutboxMessage struct {
+	UUID          uuid.UUID
+	AggregateUUID uuid.UUID
+	EventType     string
+	Key           []byte
+	Payload       []byte
+	// TraceContext - контекст трассировки запроса, в котором создано событие
+	TraceContext map[string]string
+	CreatedAt    time.Time
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReconciliationWindow задает полуинтервал [Since, Until), за который сверяются оплаты
+type ReconciliationWindow struct {
+	Since time.Time
+	Until time.Time
+}
+
+// OrderPayment представляет оплату заказа так, как ее видит order service
+type OrderPayment struct {
+	OrderUUID       uuid.UUID
+	UserUUID        uuid.UUID
+	Status          string
+	TransactionUUID *uuid.UUID
+	// Amount - сумма заказа в минимальных единицах валюты (копейках)
+	Amount   int64
+	Currency string
+}
+
+// MismatchKind - вид расхождения между журналом платежей и заказами
+type MismatchKind string
+
+const (
+	// MismatchKindOrphanTransaction - проведенная оплата, на которую не ссылается ни один заказ
+	MismatchKindOrphanTransaction MismatchKind = "ORPHAN_TRANSACTION"
+	// MismatchKindPaidOrderWithoutTransaction - оплаченный заказ без проведенной оплаты в журнале
+	MismatchKindPaidOrderWithoutTransaction MismatchKind = "PAID_ORDER_WITHOUT_TRANSACTION"
+	// MismatchKindAmountMismatch - сумма или валюта оплаты не совпадает с суммой заказа
+	MismatchKindAmountMismatch MismatchKind = "AMOUNT_MISMATCH"
+)
+
+// Mismatch описывает одно расхождение. Поля, неизвестные для вида расхождения, остаются пустыми
+type Mismatch struct {
+	Kind              MismatchKind
+	OrderUUID         uuid.UUID
+	TransactionUUID   *uuid.UUID
+	OrderStatus       string
+	TransactionStatus string
+	OrderAmount       int64
+	LedgerAmount      int64
+	OrderCurrency     string
+	LedgerCurrency    string
+}
+
+// ReconciliationReport - результат сверки журнала платежей с заказами
+type ReconciliationReport struct {
+	Window          ReconciliationWindow
+	CheckedPayments int
+	CheckedOrders   int
+	Mismatches      []Mismatch
+}
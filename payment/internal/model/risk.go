@@ -0,0 +1,59 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RiskReason - машиночитаемая причина отказа правилами риска
+type RiskReason string
+
+const (
+	RiskReasonUserBlocked                RiskReason = "USER_BLOCKED"
+	RiskReasonVelocityExceeded           RiskReason = "VELOCITY_LIMIT_EXCEEDED"
+	RiskReasonDailyLimitExceeded         RiskReason = "DAILY_LIMIT_EXCEEDED"
+	RiskReasonMonthlyLimitExceeded       RiskReason = "MONTHLY_LIMIT_EXCEEDED"
+	RiskReasonMethodDailyLimitExceeded   RiskReason = "METHOD_DAILY_LIMIT_EXCEEDED"
+	RiskReasonMethodMonthlyLimitExceeded RiskReason = "METHOD_MONTHLY_LIMIT_EXCEEDED"
+)
+
+// PaymentRejectedError описывает отказ правил риска в проведении платежа.
+// Сопоставляется с ErrPaymentRejected через errors.Is
+type PaymentRejectedError struct {
+	Reason RiskReason
+	Detail string
+}
+
+// Error реализует интерфейс error
+func (e *PaymentRejectedError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", ErrPaymentRejected, e.Reason, e.Detail)
+}
+
+// Is позволяет сравнивать ошибку с ErrPaymentRejected через errors.Is
+func (e *PaymentRejectedError) Is(target error) bool {
+	return target == ErrPaymentRejected
+}
+
+// PaymentStatsQuery задает окна, за которые считается статистика платежей пользователя.
+// Суммы учитываются только в валюте Currency, количество платежей - во всех валютах
+type PaymentStatsQuery struct {
+	UserUUID      uuid.UUID
+	PaymentMethod PaymentMethod
+	Currency      string
+	DaySince      time.Time
+	MonthSince    time.Time
+	VelocitySince time.Time
+}
+
+// PaymentStats - статистика платежей пользователя для правил риска.
+// Суммы включают успешные и ожидающие подтверждения оплаты, без возвращенных и отклоненных
+type PaymentStats struct {
+	DailyAmount         int64
+	MonthlyAmount       int64
+	MethodDailyAmount   int64
+	MethodMonthlyAmount int64
+	// RecentPayments - число попыток оплаты с VelocitySince, включая отклоненные
+	RecentPayments int
+}
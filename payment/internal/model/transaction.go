@@ -0,0 +1,134 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentMethod представляет способ оплаты
+type PaymentMethod int
+
+const (
+	PaymentMethodUnspecified PaymentMethod = iota
+	PaymentMethodCard
+	PaymentMethodSBP
+	PaymentMethodCreditCard
+	PaymentMethodInvestorMoney
+)
+
+// String возвращает строковое представление PaymentMethod
+func (pm PaymentMethod) String() string {
+	switch pm {
+	case PaymentMethodCard:
+		return "CARD"
+	case PaymentMethodSBP:
+		return "SBP"
+	case PaymentMethodCreditCard:
+		return "CREDIT_CARD"
+	case PaymentMethodInvestorMoney:
+		return "INVESTOR_MONEY"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// ParsePaymentMethod возвращает PaymentMethod по его строковому представлению
+func ParsePaymentMethod(s string) (PaymentMethod, error) {
+	for _, pm := range []PaymentMethod{PaymentMethodCard, PaymentMethodSBP, PaymentMethodCreditCard, PaymentMethodInvestorMoney} {
+		if pm.String() == s {
+			return pm, nil
+		}
+	}
+
+	return PaymentMethodUnspecified, fmt.Errorf("unknown payment method %q", s)
+}
+
+// TransactionType представляет тип транзакции
+type TransactionType int
+
+const (
+	TransactionTypeUnspecified TransactionType = iota
+	TransactionTypePayment
+	TransactionTypeRefund
+)
+
+// String возвращает строковое представление TransactionType
+func (t TransactionType) String() string {
+	switch t {
+	case TransactionTypePayment:
+		return "PAYMENT"
+	case TransactionTypeRefund:
+		return "REFUND"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// TransactionStatus представляет статус транзакции
+type TransactionStatus int
+
+const (
+	TransactionStatusUnspecified TransactionStatus = iota
+	TransactionStatusSucceeded
+	TransactionStatusRefunded
+	TransactionStatusPending
+	TransactionStatusFailed
+)
+
+// String возвращает строковое представление TransactionStatus
+func (s TransactionStatus) String() string {
+	switch s {
+	case TransactionStatusSucceeded:
+		return "SUCCEEDED"
+	case TransactionStatusRefunded:
+		return "REFUNDED"
+	case TransactionStatusPending:
+		return "PENDING"
+	case TransactionStatusFailed:
+		return "FAILED"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// Transaction представляет запись журнала движения средств
+type Transaction struct {
+	TransactionUUID uuid.UUID
+	OrderUUID       uuid.UUID
+	UserUUID        uuid.UUID
+	Type            TransactionType
+	Status          TransactionStatus
+	PaymentMethod   PaymentMethod
+	// Amount - сумма в минимальных единицах валюты (копейках)
+	Amount   int64
+	Currency string
+	// FailureCode - код отказа провайдера; задается только для статуса FAILED
+	FailureCode DeclineCode
+	// ParentTransactionUUID - исходная оплата; задается только для возврата
+	ParentTransactionUUID *uuid.UUID
+	// CardToken - токен карты оплаты картой; передается провайдеру и не сохраняется в журнале
+	CardToken string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TransactionFilter задает условия выборки списка транзакций.
+// Должен быть задан хотя бы один из UUID
+type TransactionFilter struct {
+	UserUUID  *uuid.UUID
+	OrderUUID *uuid.UUID
+}
+
+// TransactionCursor указывает на последнюю транзакцию предыдущей страницы списка
+type TransactionCursor struct {
+	CreatedAt       time.Time
+	TransactionUUID uuid.UUID
+}
+
+// TransactionPage представляет страницу списка транзакций
+type TransactionPage struct {
+	Transactions []*Transaction
+	NextCursor   *TransactionCursor
+}
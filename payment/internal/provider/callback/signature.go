@@ -0,0 +1,37 @@
+package callback
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// SignatureHeader - HTTP-заголовок, в котором провайдер передает подпись callback'а
+	SignatureHeader = "X-Callback-Signature"
+	// SignatureMetadataKey - ключ gRPC metadata, в который HTTP-шлюз переносит подпись
+	SignatureMetadataKey = "x-callback-signature"
+)
+
+// Sign возвращает hex-подпись HMAC-SHA256 итога асинхронной оплаты.
+// Подписываются UUID транзакции, статус и код отказа, поэтому подпись
+// одной транзакции нельзя переиспользовать для другой или для другого итога
+func Sign(secret, transactionUUID, status, declineCode string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.Join([]string{transactionUUID, status, declineCode}, "\n")))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись callback'а за постоянное время.
+// С пустым секретом не принимается ни одна подпись
+func Verify(secret, signature, transactionUUID, status, declineCode string) bool {
+	if secret == "" || signature == "" {
+		return false
+	}
+
+	expected := Sign(secret, transactionUUID, status, declineCode)
+
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
@@ -0,0 +1,40 @@
+package callback
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	const (
+		secret          = "secret"
+		transactionUUID = "550e8400-e29b-41d4-a716-446655440020"
+		status          = "TRANSACTION_STATUS_SUCCEEDED"
+	)
+
+	signature := Sign(secret, transactionUUID, status, "")
+
+	tests := []struct {
+		name            string
+		secret          string
+		signature       string
+		transactionUUID string
+		status          string
+		declineCode     string
+		want            bool
+	}{
+		{name: "valid", secret: secret, signature: signature, transactionUUID: transactionUUID, status: status, want: true},
+		{name: "wrong_secret", secret: "other", signature: signature, transactionUUID: transactionUUID, status: status},
+		{name: "empty_secret", signature: Sign("", transactionUUID, status, ""), transactionUUID: transactionUUID, status: status},
+		{name: "missing_signature", secret: secret, transactionUUID: transactionUUID, status: status},
+		{name: "other_transaction", secret: secret, signature: signature, transactionUUID: "550e8400-e29b-41d4-a716-446655440021", status: status},
+		{name: "other_status", secret: secret, signature: signature, transactionUUID: transactionUUID, status: "TRANSACTION_STATUS_FAILED", declineCode: "DO_NOT_HONOR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Verify(tt.secret, tt.signature, tt.transactionUUID, tt.status, tt.declineCode))
+		})
+	}
+}
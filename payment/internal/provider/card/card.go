@@ -0,0 +1,35 @@
+package card
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider"
+)
+
+// Provider проводит оплату банковской или кредитной картой через эквайера.
+// Списание без токена карты отклоняется до обращения к эквайеру
+type Provider struct {
+	acquirer provider.Provider
+}
+
+// NewProvider создает провайдера оплаты картой, проводящего списания через acquirer
+func NewProvider(acquirer provider.Provider) *Provider {
+	return &Provider{acquirer: acquirer}
+}
+
+// Charge проверяет токен карты и списывает оплату через эквайера
+func (p *Provider) Charge(ctx context.Context, payment *model.Transaction) (model.TransactionStatus, error) {
+	if payment.CardToken == "" {
+		return model.TransactionStatusUnspecified, fmt.Errorf("%w: card token is required for %s",
+			model.ErrInvalidPaymentRequest, payment.PaymentMethod)
+	}
+
+	return p.acquirer.Charge(ctx, payment)
+}
+
+// Accept передает эквайеру сохраненную асинхронную оплату
+func (p *Provider) Accept(ctx context.Context, payment *model.Transaction) {
+	p.acquirer.Accept(ctx, payment)
+}
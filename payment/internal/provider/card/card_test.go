@@ -0,0 +1,64 @@
+package card
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	providerMocks "github.com/radiophysiker/microservices-homework/payment/internal/provider/mocks"
+)
+
+func TestProviderCharge(t *testing.T) {
+	declined := &model.PaymentDeclinedError{PaymentMethod: model.PaymentMethodCard, Code: model.DeclineCodeCardExpired}
+
+	tests := []struct {
+		name       string
+		cardToken  string
+		setupMock  func(ctx context.Context, acquirer *providerMocks.MockProvider, payment *model.Transaction)
+		wantStatus model.TransactionStatus
+		wantErr    error
+	}{
+		{
+			name:      "charged_by_acquirer",
+			cardToken: "tok_4242",
+			setupMock: func(ctx context.Context, acquirer *providerMocks.MockProvider, payment *model.Transaction) {
+				acquirer.EXPECT().Charge(ctx, payment).Return(model.TransactionStatusSucceeded, nil).Once()
+			},
+			wantStatus: model.TransactionStatusSucceeded,
+		},
+		{
+			name:      "declined_by_acquirer",
+			cardToken: "tok_0002",
+			setupMock: func(ctx context.Context, acquirer *providerMocks.MockProvider, payment *model.Transaction) {
+				acquirer.EXPECT().Charge(ctx, payment).Return(model.TransactionStatusUnspecified, declined).Once()
+			},
+			wantErr: model.ErrPaymentDeclined,
+		},
+		{
+			name:      "card_token_required",
+			setupMock: func(context.Context, *providerMocks.MockProvider, *model.Transaction) {},
+			wantErr:   model.ErrInvalidPaymentRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			acquirer := providerMocks.NewMockProvider(t)
+			payment := &model.Transaction{PaymentMethod: model.PaymentMethodCard, CardToken: tt.cardToken}
+			tt.setupMock(ctx, acquirer, payment)
+
+			status, err := NewProvider(acquirer).Charge(ctx, payment)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStatus, status)
+		})
+	}
+}
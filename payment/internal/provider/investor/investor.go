@@ -0,0 +1,29 @@
+package investor
+
+import (
+	"context"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider"
+)
+
+// Provider проводит оплату средствами инвесторов через их платежный шлюз.
+// Собственных проверок у способа нет: решение о списании принимает шлюз
+type Provider struct {
+	gateway provider.Provider
+}
+
+// NewProvider создает провайдера оплаты средствами инвесторов, проводящего списания через gateway
+func NewProvider(gateway provider.Provider) *Provider {
+	return &Provider{gateway: gateway}
+}
+
+// Charge списывает оплату через шлюз инвесторов
+func (p *Provider) Charge(ctx context.Context, payment *model.Transaction) (model.TransactionStatus, error) {
+	return p.gateway.Charge(ctx, payment)
+}
+
+// Accept передает шлюзу инвесторов сохраненную асинхронную оплату
+func (p *Provider) Accept(ctx context.Context, payment *model.Transaction) {
+	p.gateway.Accept(ctx, payment)
+}
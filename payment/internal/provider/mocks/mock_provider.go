@@ -0,0 +1,151 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package provider
+
+import (
+	"context"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockProvider creates a new instance of MockProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProvider {
+	mock := &MockProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProvider is an autogenerated mock type for the Provider type
+type MockProvider struct {
+	mock.Mock
+}
+
+type MockProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProvider) EXPECT() *MockProvider_Expecter {
+	return &MockProvider_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function for the type MockProvider
+func (_mock *MockProvider) Accept(ctx context.Context, payment *model.Transaction) {
+	_mock.Called(ctx, payment)
+	return
+}
+
+// MockProvider_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type MockProvider_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *model.Transaction
+func (_e *MockProvider_Expecter) Accept(ctx interface{}, payment interface{}) *MockProvider_Accept_Call {
+	return &MockProvider_Accept_Call{Call: _e.mock.On("Accept", ctx, payment)}
+}
+
+func (_c *MockProvider_Accept_Call) Run(run func(ctx context.Context, payment *model.Transaction)) *MockProvider_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(*model.Transaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProvider_Accept_Call) Return() *MockProvider_Accept_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProvider_Accept_Call) RunAndReturn(run func(ctx context.Context, payment *model.Transaction)) *MockProvider_Accept_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Charge provides a mock function for the type MockProvider
+func (_mock *MockProvider) Charge(ctx context.Context, payment *model.Transaction) (model.TransactionStatus, error) {
+	ret := _mock.Called(ctx, payment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Charge")
+	}
+
+	var r0 model.TransactionStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Transaction) (model.TransactionStatus, error)); ok {
+		return returnFunc(ctx, payment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Transaction) model.TransactionStatus); ok {
+		r0 = returnFunc(ctx, payment)
+	} else {
+		r0 = ret.Get(0).(model.TransactionStatus)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Transaction) error); ok {
+		r1 = returnFunc(ctx, payment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProvider_Charge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Charge'
+type MockProvider_Charge_Call struct {
+	*mock.Call
+}
+
+// Charge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *model.Transaction
+func (_e *MockProvider_Expecter) Charge(ctx interface{}, payment interface{}) *MockProvider_Charge_Call {
+	return &MockProvider_Charge_Call{Call: _e.mock.On("Charge", ctx, payment)}
+}
+
+func (_c *MockProvider_Charge_Call) Run(run func(ctx context.Context, payment *model.Transaction)) *MockProvider_Charge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(*model.Transaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProvider_Charge_Call) Return(transactionStatus model.TransactionStatus, err error) *MockProvider_Charge_Call {
+	_c.Call.Return(transactionStatus, err)
+	return _c
+}
+
+func (_c *MockProvider_Charge_Call) RunAndReturn(run func(ctx context.Context, payment *model.Transaction) (model.TransactionStatus, error)) *MockProvider_Charge_Call {
+	_c.Call.Return(run)
+	return _c
+}
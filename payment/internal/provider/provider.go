@@ -0,0 +1,18 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+// Provider представляет интерфейс платежного провайдера, проводящего списания одним способом оплаты
+type Provider interface {
+	// Charge списывает сумму оплаты payment и возвращает ее статус:
+	// SUCCEEDED для проведенного списания или PENDING, если итог придет callback'ом позже.
+	// При отказе возвращает *model.PaymentDeclinedError с кодом причины
+	Charge(ctx context.Context, payment *model.Transaction) (model.TransactionStatus, error)
+	// Accept сообщает провайдеру, что оплата в статусе PENDING сохранена в журнале.
+	// Итог асинхронной оплаты отправляется callback'ом только после этого вызова
+	Accept(ctx context.Context, payment *model.Transaction)
+}
@@ -0,0 +1,37 @@
+package sbp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider"
+)
+
+// currency - СБП проводит переводы только в рублях
+const currency = "RUB"
+
+// Provider проводит оплату через систему быстрых платежей
+type Provider struct {
+	gateway provider.Provider
+}
+
+// NewProvider создает провайдера СБП, проводящего списания через gateway
+func NewProvider(gateway provider.Provider) *Provider {
+	return &Provider{gateway: gateway}
+}
+
+// Charge проверяет валюту оплаты и списывает ее через шлюз СБП
+func (p *Provider) Charge(ctx context.Context, payment *model.Transaction) (model.TransactionStatus, error) {
+	if payment.Currency != currency {
+		return model.TransactionStatusUnspecified, fmt.Errorf("%w: SBP supports only %s, got %s",
+			model.ErrInvalidPaymentRequest, currency, payment.Currency)
+	}
+
+	return p.gateway.Charge(ctx, payment)
+}
+
+// Accept передает шлюзу СБП сохраненную асинхронную оплату
+func (p *Provider) Accept(ctx context.Context, payment *model.Transaction) {
+	p.gateway.Accept(ctx, payment)
+}
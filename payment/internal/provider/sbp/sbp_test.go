@@ -0,0 +1,55 @@
+package sbp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	providerMocks "github.com/radiophysiker/microservices-homework/payment/internal/provider/mocks"
+)
+
+func TestProviderCharge(t *testing.T) {
+	tests := []struct {
+		name       string
+		currency   string
+		setupMock  func(ctx context.Context, gateway *providerMocks.MockProvider, payment *model.Transaction)
+		wantStatus model.TransactionStatus
+		wantErr    error
+	}{
+		{
+			name:     "charged_by_gateway",
+			currency: "RUB",
+			setupMock: func(ctx context.Context, gateway *providerMocks.MockProvider, payment *model.Transaction) {
+				gateway.EXPECT().Charge(ctx, payment).Return(model.TransactionStatusPending, nil).Once()
+			},
+			wantStatus: model.TransactionStatusPending,
+		},
+		{
+			name:      "foreign_currency_rejected",
+			currency:  "USD",
+			setupMock: func(context.Context, *providerMocks.MockProvider, *model.Transaction) {},
+			wantErr:   model.ErrInvalidPaymentRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			gateway := providerMocks.NewMockProvider(t)
+			payment := &model.Transaction{PaymentMethod: model.PaymentMethodSBP, Currency: tt.currency}
+			tt.setupMock(ctx, gateway, payment)
+
+			status, err := NewProvider(gateway).Charge(ctx, payment)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStatus, status)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/propagation"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider/callback"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+// callbackTimeout - таймаут HTTP-запроса с callback'ом
+const callbackTimeout = 10 * time.Second
+
+// Config задает поведение симулятора платежного провайдера
+type Config struct {
+	// Latency - задержка ответа на каждое списание
+	Latency time.Duration
+	// LatencyJitter - верхняя граница случайной добавки к Latency
+	LatencyJitter time.Duration
+	// DeclineRate - доля случайных отказов с кодом DO_NOT_HONOR, от 0 до 1
+	DeclineRate float64
+	// DeclineCodesByMethod - отказ с заданным кодом для всех списаний способом оплаты
+	DeclineCodesByMethod map[model.PaymentMethod]model.DeclineCode
+	// DeclineCodesByUser - отказ с заданным кодом для всех списаний пользователя
+	DeclineCodesByUser map[uuid.UUID]model.DeclineCode
+	// DeclineCodesByCard - отказ с заданным кодом для всех списаний картой с этим токеном
+	DeclineCodesByCard map[string]model.DeclineCode
+	// AsyncMethods - способы оплаты, итог которых приходит callback'ом после CallbackDelay
+	AsyncMethods []model.PaymentMethod
+	// CallbackDelay - задержка callback'а асинхронной оплаты
+	CallbackDelay time.Duration
+	// CallbackBaseURL - адрес HTTP API payment service для callback'ов;
+	// если пуст, асинхронные оплаты завершаются только по таймауту
+	CallbackBaseURL string
+	// CallbackSecret - секрет, которым подписываются callback'и
+	CallbackSecret string
+}
+
+// Provider симулирует провайдера одного способа оплаты без обращения к реальному эквайеру
+type Provider struct {
+	method     model.PaymentMethod
+	cfg        Config
+	async      bool
+	httpClient *http.Client
+	// random возвращает число из [0, 1); подменяется в тестах
+	random func() float64
+}
+
+// NewProvider создает симулятор провайдера для способа оплаты method
+func NewProvider(method model.PaymentMethod, cfg Config) *Provider {
+	return &Provider{
+		method:     method,
+		cfg:        cfg,
+		async:      slices.Contains(cfg.AsyncMethods, method),
+		httpClient: &http.Client{Timeout: callbackTimeout},
+		random:     rand.Float64,
+	}
+}
+
+// Charge выдерживает настроенную задержку и решает, одобрить ли списание.
+// Правила по карте и пользователю проверяются раньше правил по способу оплаты и случайных отказов.
+// Асинхронный способ оплаты сразу возвращает PENDING, а итог решается в Accept
+func (p *Provider) Charge(ctx context.Context, payment *model.Transaction) (model.TransactionStatus, error) {
+	if err := p.wait(ctx); err != nil {
+		return model.TransactionStatusUnspecified, err
+	}
+
+	if p.async {
+		return model.TransactionStatusPending, nil
+	}
+
+	code, declined := p.declineCode(payment)
+
+	if !declined {
+		return model.TransactionStatusSucceeded, nil
+	}
+
+	logger.Info(ctx, "Симулятор отклонил платеж",
+		zap.String("order_uuid", payment.OrderUUID.String()),
+		zap.String("payment_method", p.method.String()),
+		zap.String("decline_code", string(code)))
+
+	return model.TransactionStatusUnspecified, &model.PaymentDeclinedError{PaymentMethod: p.method, Code: code}
+}
+
+// Accept решает итог сохраненной асинхронной оплаты и отправляет его callback'ом через CallbackDelay.
+// Для синхронного способа оплаты ничего не делает
+func (p *Provider) Accept(ctx context.Context, payment *model.Transaction) {
+	if !p.async {
+		return
+	}
+
+	code, _ := p.declineCode(payment)
+	p.scheduleCallback(ctx, payment, code)
+}
+
+// declineCode возвращает код отказа, если списание должно быть отклонено
+func (p *Provider) declineCode(payment *model.Transaction) (model.DeclineCode, bool) {
+	if code, ok := p.cfg.DeclineCodesByCard[payment.CardToken]; ok && payment.CardToken != "" {
+		return code, true
+	}
+
+	if code, ok := p.cfg.DeclineCodesByUser[payment.UserUUID]; ok {
+		return code, true
+	}
+
+	if code, ok := p.cfg.DeclineCodesByMethod[p.method]; ok {
+		return code, true
+	}
+
+	if p.cfg.DeclineRate > 0 && p.random() < p.cfg.DeclineRate {
+		return model.DeclineCodeDoNotHonor, true
+	}
+
+	return "", false
+}
+
+// wait выдерживает задержку ответа или возвращает ошибку отмененного контекста
+func (p *Provider) wait(ctx context.Context) error {
+	delay := p.cfg.Latency
+	if p.cfg.LatencyJitter > 0 {
+		delay += rand.N(p.cfg.LatencyJitter)
+	}
+
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// scheduleCallback отправляет итог асинхронной оплаты через CallbackDelay.
+// Callback не зависит от отмены ctx запроса, но продолжает его трейс
+func (p *Provider) scheduleCallback(ctx context.Context, payment *model.Transaction, code model.DeclineCode) {
+	if p.cfg.CallbackBaseURL == "" {
+		logger.Info(ctx, "Callback симулятора отключен, оплата завершится по таймауту",
+			zap.String("transaction_uuid", payment.TransactionUUID.String()))
+
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	transactionUUID := payment.TransactionUUID
+
+	time.AfterFunc(p.cfg.CallbackDelay, func() {
+		if err := p.sendCallback(ctx, transactionUUID, code); err != nil {
+			logger.Error(ctx, "Не удалось отправить callback симулятора",
+				zap.String("transaction_uuid", transactionUUID.String()),
+				zap.Error(err))
+		}
+	})
+}
+
+// sendCallback отправляет итог оплаты на HTTP endpoint callback'ов payment service
+func (p *Provider) sendCallback(ctx context.Context, transactionUUID uuid.UUID, code model.DeclineCode) error {
+	req := &pb.CompletePaymentRequest{Status: pb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED}
+	if code != "" {
+		req.Status = pb.TransactionStatus_TRANSACTION_STATUS_FAILED
+		req.DeclineCode = string(code)
+	}
+
+	body, err := protojson.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal callback: %w", err)
+	}
+
+	callbackURL, err := url.JoinPath(p.cfg.CallbackBaseURL, "api/v1/payments", transactionUUID.String(), "callback")
+	if err != nil {
+		return fmt.Errorf("build callback url: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create callback request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(callback.SignatureHeader, callback.Sign(
+		p.cfg.CallbackSecret,
+		transactionUUID.String(),
+		req.GetStatus().String(),
+		req.GetDeclineCode(),
+	))
+	tracing.Inject(ctx, propagation.HeaderCarrier(httpReq.Header))
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("send callback: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("callback rejected with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
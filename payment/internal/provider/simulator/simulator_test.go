@@ -0,0 +1,231 @@
+package simulator
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider/callback"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+func TestProviderCharge(t *testing.T) {
+	logger.SetNopLogger()
+
+	userUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")
+	blockedUserUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440009")
+
+	const (
+		cardToken        = "tok_4242"
+		blockedCardToken = "tok_0002"
+	)
+
+	tests := []struct {
+		name      string
+		method    model.PaymentMethod
+		userUUID  uuid.UUID
+		cardToken string
+		cfg       Config
+		random    float64
+		wantCode  model.DeclineCode
+	}{
+		{name: "approves_by_default", method: model.PaymentMethodCard, userUUID: userUUID},
+		{
+			name:     "declines_user",
+			method:   model.PaymentMethodCard,
+			userUUID: blockedUserUUID,
+			cfg: Config{
+				DeclineCodesByUser: map[uuid.UUID]model.DeclineCode{blockedUserUUID: model.DeclineCodeSuspectedFraud},
+			},
+			wantCode: model.DeclineCodeSuspectedFraud,
+		},
+		{
+			name:     "declines_method",
+			method:   model.PaymentMethodCreditCard,
+			userUUID: userUUID,
+			cfg: Config{
+				DeclineCodesByMethod: map[model.PaymentMethod]model.DeclineCode{model.PaymentMethodCreditCard: model.DeclineCodeLimitExceeded},
+			},
+			wantCode: model.DeclineCodeLimitExceeded,
+		},
+		{
+			name:     "method_rule_ignores_other_methods",
+			method:   model.PaymentMethodSBP,
+			userUUID: userUUID,
+			cfg: Config{
+				DeclineCodesByMethod: map[model.PaymentMethod]model.DeclineCode{model.PaymentMethodCreditCard: model.DeclineCodeLimitExceeded},
+			},
+		},
+		{
+			name:     "user_rule_wins_over_method_rule",
+			method:   model.PaymentMethodCreditCard,
+			userUUID: blockedUserUUID,
+			cfg: Config{
+				DeclineCodesByMethod: map[model.PaymentMethod]model.DeclineCode{model.PaymentMethodCreditCard: model.DeclineCodeLimitExceeded},
+				DeclineCodesByUser:   map[uuid.UUID]model.DeclineCode{blockedUserUUID: model.DeclineCodeCardExpired},
+			},
+			wantCode: model.DeclineCodeCardExpired,
+		},
+		{
+			name:      "declines_card",
+			method:    model.PaymentMethodCard,
+			userUUID:  userUUID,
+			cardToken: blockedCardToken,
+			cfg: Config{
+				DeclineCodesByCard: map[string]model.DeclineCode{blockedCardToken: model.DeclineCodeCardExpired},
+			},
+			wantCode: model.DeclineCodeCardExpired,
+		},
+		{
+			name:      "card_rule_ignores_other_cards",
+			method:    model.PaymentMethodCard,
+			userUUID:  userUUID,
+			cardToken: cardToken,
+			cfg: Config{
+				DeclineCodesByCard: map[string]model.DeclineCode{blockedCardToken: model.DeclineCodeCardExpired},
+			},
+		},
+		{
+			name:      "card_rule_wins_over_user_rule",
+			method:    model.PaymentMethodCreditCard,
+			userUUID:  blockedUserUUID,
+			cardToken: blockedCardToken,
+			cfg: Config{
+				DeclineCodesByUser: map[uuid.UUID]model.DeclineCode{blockedUserUUID: model.DeclineCodeSuspectedFraud},
+				DeclineCodesByCard: map[string]model.DeclineCode{blockedCardToken: model.DeclineCodeInsufficientFunds},
+			},
+			wantCode: model.DeclineCodeInsufficientFunds,
+		},
+		{name: "random_decline", method: model.PaymentMethodCard, userUUID: userUUID, cfg: Config{DeclineRate: 0.3}, random: 0.29, wantCode: model.DeclineCodeDoNotHonor},
+		{name: "random_approve", method: model.PaymentMethodCard, userUUID: userUUID, cfg: Config{DeclineRate: 0.3}, random: 0.3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewProvider(tt.method, tt.cfg)
+			p.random = func() float64 { return tt.random }
+
+			status, err := p.Charge(context.Background(), &model.Transaction{
+				UserUUID:      tt.userUUID,
+				PaymentMethod: tt.method,
+				CardToken:     tt.cardToken,
+			})
+
+			if tt.wantCode == "" {
+				require.NoError(t, err)
+				require.Equal(t, model.TransactionStatusSucceeded, status)
+
+				return
+			}
+
+			require.ErrorIs(t, err, model.ErrPaymentDeclined)
+
+			var declined *model.PaymentDeclinedError
+			require.ErrorAs(t, err, &declined)
+			require.Equal(t, tt.wantCode, declined.Code)
+			require.Equal(t, tt.method, declined.PaymentMethod)
+		})
+	}
+}
+
+func TestProviderChargeHonorsContext(t *testing.T) {
+	p := NewProvider(model.PaymentMethodCard, Config{Latency: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := p.Charge(ctx, &model.Transaction{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestProviderChargeAsync(t *testing.T) {
+	logger.SetNopLogger()
+
+	transactionUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440020")
+
+	tests := []struct {
+		name            string
+		cfg             Config
+		wantStatus      pb.TransactionStatus
+		wantDeclineCode string
+	}{
+		{name: "approves_by_callback", wantStatus: pb.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED},
+		{
+			name: "declines_by_callback",
+			cfg: Config{
+				DeclineCodesByMethod: map[model.PaymentMethod]model.DeclineCode{model.PaymentMethodSBP: model.DeclineCodeInsufficientFunds},
+			},
+			wantStatus:      pb.TransactionStatus_TRANSACTION_STATUS_FAILED,
+			wantDeclineCode: string(model.DeclineCodeInsufficientFunds),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callbacks := make(chan *http.Request, 1)
+			bodies := make(chan []byte, 1)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				callbacks <- r
+				bodies <- body
+			}))
+			defer server.Close()
+
+			cfg := tt.cfg
+			cfg.AsyncMethods = []model.PaymentMethod{model.PaymentMethodSBP}
+			cfg.CallbackBaseURL = server.URL
+			cfg.CallbackSecret = "secret"
+
+			p := NewProvider(model.PaymentMethodSBP, cfg)
+
+			payment := &model.Transaction{TransactionUUID: transactionUUID, PaymentMethod: model.PaymentMethodSBP}
+
+			status, err := p.Charge(context.Background(), payment)
+			require.NoError(t, err)
+			require.Equal(t, model.TransactionStatusPending, status)
+
+			// До сохранения оплаты в журнале callback не отправляется
+			select {
+			case <-callbacks:
+				t.Fatal("callback was sent before the payment was accepted")
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			p.Accept(context.Background(), payment)
+
+			select {
+			case r := <-callbacks:
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "/api/v1/payments/"+transactionUUID.String()+"/callback", r.URL.Path)
+				require.Equal(t, callback.Sign("secret", transactionUUID.String(), tt.wantStatus.String(), tt.wantDeclineCode), r.Header.Get(callback.SignatureHeader))
+			case <-time.After(time.Second):
+				t.Fatal("callback was not sent")
+			}
+
+			var req pb.CompletePaymentRequest
+			require.NoError(t, protojson.Unmarshal(<-bodies, &req))
+			require.Equal(t, tt.wantStatus, req.GetStatus())
+			require.Equal(t, tt.wantDeclineCode, req.GetDeclineCode())
+		})
+	}
+}
+
+func TestProviderChargeAsyncWithoutCallback(t *testing.T) {
+	logger.SetNopLogger()
+
+	p := NewProvider(model.PaymentMethodInvestorMoney, Config{AsyncMethods: []model.PaymentMethod{model.PaymentMethodInvestorMoney}})
+
+	status, err := p.Charge(context.Background(), &model.Transaction{PaymentMethod: model.PaymentMethodInvestorMoney})
+	require.NoError(t, err)
+	require.Equal(t, model.TransactionStatusPending, status)
+}
@@ -0,0 +1,109 @@
+package converter
+
+import (
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	repoModel "github.com/radiophysiker/microservices-homework/payment/internal/repository/model"
+)
+
+// ToServiceTransaction конвертирует модель repository в модель service
+func ToServiceTransaction(repoTransaction *repoModel.Transaction) *model.Transaction {
+	if repoTransaction == nil {
+		return nil
+	}
+
+	return &model.Transaction{
+		TransactionUUID:       repoTransaction.TransactionUUID,
+		OrderUUID:             repoTransaction.OrderUUID,
+		UserUUID:              repoTransaction.UserUUID,
+		Type:                  toServiceTransactionType(repoTransaction.Type),
+		Status:                toServiceTransactionStatus(repoTransaction.Status),
+		PaymentMethod:         toServicePaymentMethod(repoTransaction.PaymentMethod),
+		Amount:                repoTransaction.Amount,
+		Currency:              repoTransaction.Currency,
+		FailureCode:           toServiceFailureCode(repoTransaction.FailureCode),
+		ParentTransactionUUID: repoTransaction.ParentTransactionUUID,
+		CreatedAt:             repoTransaction.CreatedAt,
+		UpdatedAt:             repoTransaction.UpdatedAt,
+	}
+}
+
+// ToRepoTransaction конвертирует модель service в модель repository
+func ToRepoTransaction(serviceTransaction *model.Transaction) *repoModel.Transaction {
+	if serviceTransaction == nil {
+		return nil
+	}
+
+	return &repoModel.Transaction{
+		TransactionUUID:       serviceTransaction.TransactionUUID,
+		OrderUUID:             serviceTransaction.OrderUUID,
+		UserUUID:              serviceTransaction.UserUUID,
+		Type:                  serviceTransaction.Type.String(),
+		Status:                serviceTransaction.Status.String(),
+		PaymentMethod:         serviceTransaction.PaymentMethod.String(),
+		Amount:                serviceTransaction.Amount,
+		Currency:              serviceTransaction.Currency,
+		FailureCode:           toRepoFailureCode(serviceTransaction.FailureCode),
+		ParentTransactionUUID: serviceTransaction.ParentTransactionUUID,
+		CreatedAt:             serviceTransaction.CreatedAt,
+		UpdatedAt:             serviceTransaction.UpdatedAt,
+	}
+}
+
+func toServiceTransactionType(transactionType string) model.TransactionType {
+	switch transactionType {
+	case model.TransactionTypePayment.String():
+		return model.TransactionTypePayment
+	case model.TransactionTypeRefund.String():
+		return model.TransactionTypeRefund
+	default:
+		return model.TransactionTypeUnspecified
+	}
+}
+
+func toServiceTransactionStatus(status string) model.TransactionStatus {
+	switch status {
+	case model.TransactionStatusSucceeded.String():
+		return model.TransactionStatusSucceeded
+	case model.TransactionStatusRefunded.String():
+		return model.TransactionStatusRefunded
+	case model.TransactionStatusPending.String():
+		return model.TransactionStatusPending
+	case model.TransactionStatusFailed.String():
+		return model.TransactionStatusFailed
+	default:
+		return model.TransactionStatusUnspecified
+	}
+}
+
+func toServiceFailureCode(code *string) model.DeclineCode {
+	if code == nil {
+		return ""
+	}
+
+	return model.DeclineCode(*code)
+}
+
+func toRepoFailureCode(code model.DeclineCode) *string {
+	if code == "" {
+		return nil
+	}
+
+	value := string(code)
+
+	return &value
+}
+
+func toServicePaymentMethod(paymentMethod string) model.PaymentMethod {
+	switch paymentMethod {
+	case model.PaymentMethodCard.String():
+		return model.PaymentMethodCard
+	case model.PaymentMethodSBP.String():
+		return model.PaymentMethodSBP
+	case model.PaymentMethodCreditCard.String():
+		return model.PaymentMethodCreditCard
+	case model.PaymentMethodInvestorMoney.String():
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnspecified
+	}
+}
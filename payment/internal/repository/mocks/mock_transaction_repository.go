@@ -0,0 +1,782 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockTransactionRepository creates a new instance of MockTransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransactionRepository {
+	mock := &MockTransactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTransactionRepository is an autogenerated mock type for the TransactionRepository type
+type MockTransactionRepository struct {
+	mock.Mock
+}
+
+type MockTransactionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTransactionRepository) EXPECT() *MockTransactionRepository_Expecter {
+	return &MockTransactionRepository_Expecter{mock: &_m.Mock}
+}
+
+// FailExpiredPayments provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) FailExpiredPayments(ctx context.Context, createdBefore time.Time, limit int, failureCode model.DeclineCode, handler repository.PaymentResultHandler) ([]*model.Transaction, error) {
+	ret := _mock.Called(ctx, createdBefore, limit, failureCode, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailExpiredPayments")
+	}
+
+	var r0 []*model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int, model.DeclineCode, repository.PaymentResultHandler) ([]*model.Transaction, error)); ok {
+		return returnFunc(ctx, createdBefore, limit, failureCode, handler)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int, model.DeclineCode, repository.PaymentResultHandler) []*model.Transaction); ok {
+		r0 = returnFunc(ctx, createdBefore, limit, failureCode, handler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int, model.DeclineCode, repository.PaymentResultHandler) error); ok {
+		r1 = returnFunc(ctx, createdBefore, limit, failureCode, handler)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_FailExpiredPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailExpiredPayments'
+type MockTransactionRepository_FailExpiredPayments_Call struct {
+	*mock.Call
+}
+
+// FailExpiredPayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+//   - failureCode model.DeclineCode
+//   - handler repository.PaymentResultHandler
+func (_e *MockTransactionRepository_Expecter) FailExpiredPayments(ctx interface{}, createdBefore interface{}, limit interface{}, failureCode interface{}, handler interface{}) *MockTransactionRepository_FailExpiredPayments_Call {
+	return &MockTransactionRepository_FailExpiredPayments_Call{Call: _e.mock.On("FailExpiredPayments", ctx, createdBefore, limit, failureCode, handler)}
+}
+
+func (_c *MockTransactionRepository_FailExpiredPayments_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int, failureCode model.DeclineCode, handler repository.PaymentResultHandler)) *MockTransactionRepository_FailExpiredPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 model.DeclineCode
+		if args[3] != nil {
+			arg3 = args[3].(model.DeclineCode)
+		}
+		var arg4 repository.PaymentResultHandler
+		if args[4] != nil {
+			arg4 = args[4].(repository.PaymentResultHandler)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_FailExpiredPayments_Call) Return(transactions []*model.Transaction, err error) *MockTransactionRepository_FailExpiredPayments_Call {
+	_c.Call.Return(transactions, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_FailExpiredPayments_Call) RunAndReturn(run func(ctx context.Context, createdBefore time.Time, limit int, failureCode model.DeclineCode, handler repository.PaymentResultHandler) ([]*model.Transaction, error)) *MockTransactionRepository_FailExpiredPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinalizePayment provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) FinalizePayment(ctx context.Context, paymentUUID uuid.UUID, status model.TransactionStatus, failureCode model.DeclineCode, handler repository.PaymentResultHandler) (*model.Transaction, error) {
+	ret := _mock.Called(ctx, paymentUUID, status, failureCode, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizePayment")
+	}
+
+	var r0 *model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.TransactionStatus, model.DeclineCode, repository.PaymentResultHandler) (*model.Transaction, error)); ok {
+		return returnFunc(ctx, paymentUUID, status, failureCode, handler)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.TransactionStatus, model.DeclineCode, repository.PaymentResultHandler) *model.Transaction); ok {
+		r0 = returnFunc(ctx, paymentUUID, status, failureCode, handler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.TransactionStatus, model.DeclineCode, repository.PaymentResultHandler) error); ok {
+		r1 = returnFunc(ctx, paymentUUID, status, failureCode, handler)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_FinalizePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalizePayment'
+type MockTransactionRepository_FinalizePayment_Call struct {
+	*mock.Call
+}
+
+// FinalizePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentUUID uuid.UUID
+//   - status model.TransactionStatus
+//   - failureCode model.DeclineCode
+//   - handler repository.PaymentResultHandler
+func (_e *MockTransactionRepository_Expecter) FinalizePayment(ctx interface{}, paymentUUID interface{}, status interface{}, failureCode interface{}, handler interface{}) *MockTransactionRepository_FinalizePayment_Call {
+	return &MockTransactionRepository_FinalizePayment_Call{Call: _e.mock.On("FinalizePayment", ctx, paymentUUID, status, failureCode, handler)}
+}
+
+func (_c *MockTransactionRepository_FinalizePayment_Call) Run(run func(ctx context.Context, paymentUUID uuid.UUID, status model.TransactionStatus, failureCode model.DeclineCode, handler repository.PaymentResultHandler)) *MockTransactionRepository_FinalizePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.TransactionStatus
+		if args[2] != nil {
+			arg2 = args[2].(model.TransactionStatus)
+		}
+		var arg3 model.DeclineCode
+		if args[3] != nil {
+			arg3 = args[3].(model.DeclineCode)
+		}
+		var arg4 repository.PaymentResultHandler
+		if args[4] != nil {
+			arg4 = args[4].(repository.PaymentResultHandler)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_FinalizePayment_Call) Return(transaction *model.Transaction, err error) *MockTransactionRepository_FinalizePayment_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_FinalizePayment_Call) RunAndReturn(run func(ctx context.Context, paymentUUID uuid.UUID, status model.TransactionStatus, failureCode model.DeclineCode, handler repository.PaymentResultHandler) (*model.Transaction, error)) *MockTransactionRepository_FinalizePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderPayment provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) GetOrderPayment(ctx context.Context, orderUUID uuid.UUID) (*model.Transaction, error) {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderPayment")
+	}
+
+	var r0 *model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Transaction, error)); ok {
+		return returnFunc(ctx, orderUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Transaction); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_GetOrderPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderPayment'
+type MockTransactionRepository_GetOrderPayment_Call struct {
+	*mock.Call
+}
+
+// GetOrderPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+func (_e *MockTransactionRepository_Expecter) GetOrderPayment(ctx interface{}, orderUUID interface{}) *MockTransactionRepository_GetOrderPayment_Call {
+	return &MockTransactionRepository_GetOrderPayment_Call{Call: _e.mock.On("GetOrderPayment", ctx, orderUUID)}
+}
+
+func (_c *MockTransactionRepository_GetOrderPayment_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID)) *MockTransactionRepository_GetOrderPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetOrderPayment_Call) Return(transaction *model.Transaction, err error) *MockTransactionRepository_GetOrderPayment_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetOrderPayment_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID) (*model.Transaction, error)) *MockTransactionRepository_GetOrderPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaymentStats provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) GetPaymentStats(ctx context.Context, query model.PaymentStatsQuery) (*model.PaymentStats, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentStats")
+	}
+
+	var r0 *model.PaymentStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PaymentStatsQuery) (*model.PaymentStats, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PaymentStatsQuery) *model.PaymentStats); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PaymentStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.PaymentStatsQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_GetPaymentStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentStats'
+type MockTransactionRepository_GetPaymentStats_Call struct {
+	*mock.Call
+}
+
+// GetPaymentStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query model.PaymentStatsQuery
+func (_e *MockTransactionRepository_Expecter) GetPaymentStats(ctx interface{}, query interface{}) *MockTransactionRepository_GetPaymentStats_Call {
+	return &MockTransactionRepository_GetPaymentStats_Call{Call: _e.mock.On("GetPaymentStats", ctx, query)}
+}
+
+func (_c *MockTransactionRepository_GetPaymentStats_Call) Run(run func(ctx context.Context, query model.PaymentStatsQuery)) *MockTransactionRepository_GetPaymentStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.PaymentStatsQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.PaymentStatsQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetPaymentStats_Call) Return(paymentStats *model.PaymentStats, err error) *MockTransactionRepository_GetPaymentStats_Call {
+	_c.Call.Return(paymentStats, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetPaymentStats_Call) RunAndReturn(run func(ctx context.Context, query model.PaymentStatsQuery) (*model.PaymentStats, error)) *MockTransactionRepository_GetPaymentStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransaction provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) GetTransaction(ctx context.Context, transactionUUID uuid.UUID) (*model.Transaction, error) {
+	ret := _mock.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 *model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Transaction, error)); ok {
+		return returnFunc(ctx, transactionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Transaction); ok {
+		r0 = returnFunc(ctx, transactionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_GetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransaction'
+type MockTransactionRepository_GetTransaction_Call struct {
+	*mock.Call
+}
+
+// GetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID uuid.UUID
+func (_e *MockTransactionRepository_Expecter) GetTransaction(ctx interface{}, transactionUUID interface{}) *MockTransactionRepository_GetTransaction_Call {
+	return &MockTransactionRepository_GetTransaction_Call{Call: _e.mock.On("GetTransaction", ctx, transactionUUID)}
+}
+
+func (_c *MockTransactionRepository_GetTransaction_Call) Run(run func(ctx context.Context, transactionUUID uuid.UUID)) *MockTransactionRepository_GetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetTransaction_Call) Return(transaction *model.Transaction, err error) *MockTransactionRepository_GetTransaction_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetTransaction_Call) RunAndReturn(run func(ctx context.Context, transactionUUID uuid.UUID) (*model.Transaction, error)) *MockTransactionRepository_GetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransactions provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) GetTransactions(ctx context.Context, transactionUUIDs []uuid.UUID) ([]*model.Transaction, error) {
+	ret := _mock.Called(ctx, transactionUUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactions")
+	}
+
+	var r0 []*model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*model.Transaction, error)); ok {
+		return returnFunc(ctx, transactionUUIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*model.Transaction); ok {
+		r0 = returnFunc(ctx, transactionUUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, transactionUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_GetTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransactions'
+type MockTransactionRepository_GetTransactions_Call struct {
+	*mock.Call
+}
+
+// GetTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUIDs []uuid.UUID
+func (_e *MockTransactionRepository_Expecter) GetTransactions(ctx interface{}, transactionUUIDs interface{}) *MockTransactionRepository_GetTransactions_Call {
+	return &MockTransactionRepository_GetTransactions_Call{Call: _e.mock.On("GetTransactions", ctx, transactionUUIDs)}
+}
+
+func (_c *MockTransactionRepository_GetTransactions_Call) Run(run func(ctx context.Context, transactionUUIDs []uuid.UUID)) *MockTransactionRepository_GetTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetTransactions_Call) Return(transactions []*model.Transaction, err error) *MockTransactionRepository_GetTransactions_Call {
+	_c.Call.Return(transactions, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetTransactions_Call) RunAndReturn(run func(ctx context.Context, transactionUUIDs []uuid.UUID) ([]*model.Transaction, error)) *MockTransactionRepository_GetTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSettledPayments provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) ListSettledPayments(ctx context.Context, window model.ReconciliationWindow) ([]*model.Transaction, error) {
+	ret := _mock.Called(ctx, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSettledPayments")
+	}
+
+	var r0 []*model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ReconciliationWindow) ([]*model.Transaction, error)); ok {
+		return returnFunc(ctx, window)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ReconciliationWindow) []*model.Transaction); ok {
+		r0 = returnFunc(ctx, window)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.ReconciliationWindow) error); ok {
+		r1 = returnFunc(ctx, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_ListSettledPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSettledPayments'
+type MockTransactionRepository_ListSettledPayments_Call struct {
+	*mock.Call
+}
+
+// ListSettledPayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window model.ReconciliationWindow
+func (_e *MockTransactionRepository_Expecter) ListSettledPayments(ctx interface{}, window interface{}) *MockTransactionRepository_ListSettledPayments_Call {
+	return &MockTransactionRepository_ListSettledPayments_Call{Call: _e.mock.On("ListSettledPayments", ctx, window)}
+}
+
+func (_c *MockTransactionRepository_ListSettledPayments_Call) Run(run func(ctx context.Context, window model.ReconciliationWindow)) *MockTransactionRepository_ListSettledPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ReconciliationWindow
+		if args[1] != nil {
+			arg1 = args[1].(model.ReconciliationWindow)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_ListSettledPayments_Call) Return(transactions []*model.Transaction, err error) *MockTransactionRepository_ListSettledPayments_Call {
+	_c.Call.Return(transactions, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_ListSettledPayments_Call) RunAndReturn(run func(ctx context.Context, window model.ReconciliationWindow) ([]*model.Transaction, error)) *MockTransactionRepository_ListSettledPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTransactions provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) ListTransactions(ctx context.Context, filter model.TransactionFilter, cursor *model.TransactionCursor, limit int) ([]*model.Transaction, error) {
+	ret := _mock.Called(ctx, filter, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTransactions")
+	}
+
+	var r0 []*model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.TransactionFilter, *model.TransactionCursor, int) ([]*model.Transaction, error)); ok {
+		return returnFunc(ctx, filter, cursor, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.TransactionFilter, *model.TransactionCursor, int) []*model.Transaction); ok {
+		r0 = returnFunc(ctx, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.TransactionFilter, *model.TransactionCursor, int) error); ok {
+		r1 = returnFunc(ctx, filter, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_ListTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransactions'
+type MockTransactionRepository_ListTransactions_Call struct {
+	*mock.Call
+}
+
+// ListTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.TransactionFilter
+//   - cursor *model.TransactionCursor
+//   - limit int
+func (_e *MockTransactionRepository_Expecter) ListTransactions(ctx interface{}, filter interface{}, cursor interface{}, limit interface{}) *MockTransactionRepository_ListTransactions_Call {
+	return &MockTransactionRepository_ListTransactions_Call{Call: _e.mock.On("ListTransactions", ctx, filter, cursor, limit)}
+}
+
+func (_c *MockTransactionRepository_ListTransactions_Call) Run(run func(ctx context.Context, filter model.TransactionFilter, cursor *model.TransactionCursor, limit int)) *MockTransactionRepository_ListTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.TransactionFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.TransactionFilter)
+		}
+		var arg2 *model.TransactionCursor
+		if args[2] != nil {
+			arg2 = args[2].(*model.TransactionCursor)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_ListTransactions_Call) Return(transactions []*model.Transaction, err error) *MockTransactionRepository_ListTransactions_Call {
+	_c.Call.Return(transactions, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_ListTransactions_Call) RunAndReturn(run func(ctx context.Context, filter model.TransactionFilter, cursor *model.TransactionCursor, limit int) ([]*model.Transaction, error)) *MockTransactionRepository_ListTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundTransaction provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) RefundTransaction(ctx context.Context, paymentUUID uuid.UUID, refund *model.Transaction) (*model.Transaction, error) {
+	ret := _mock.Called(ctx, paymentUUID, refund)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundTransaction")
+	}
+
+	var r0 *model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *model.Transaction) (*model.Transaction, error)); ok {
+		return returnFunc(ctx, paymentUUID, refund)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *model.Transaction) *model.Transaction); ok {
+		r0 = returnFunc(ctx, paymentUUID, refund)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *model.Transaction) error); ok {
+		r1 = returnFunc(ctx, paymentUUID, refund)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_RefundTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundTransaction'
+type MockTransactionRepository_RefundTransaction_Call struct {
+	*mock.Call
+}
+
+// RefundTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentUUID uuid.UUID
+//   - refund *model.Transaction
+func (_e *MockTransactionRepository_Expecter) RefundTransaction(ctx interface{}, paymentUUID interface{}, refund interface{}) *MockTransactionRepository_RefundTransaction_Call {
+	return &MockTransactionRepository_RefundTransaction_Call{Call: _e.mock.On("RefundTransaction", ctx, paymentUUID, refund)}
+}
+
+func (_c *MockTransactionRepository_RefundTransaction_Call) Run(run func(ctx context.Context, paymentUUID uuid.UUID, refund *model.Transaction)) *MockTransactionRepository_RefundTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *model.Transaction
+		if args[2] != nil {
+			arg2 = args[2].(*model.Transaction)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_RefundTransaction_Call) Return(transaction *model.Transaction, err error) *MockTransactionRepository_RefundTransaction_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_RefundTransaction_Call) RunAndReturn(run func(ctx context.Context, paymentUUID uuid.UUID, refund *model.Transaction) (*model.Transaction, error)) *MockTransactionRepository_RefundTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReservePayment provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) ReservePayment(ctx context.Context, payment *model.Transaction, check repository.PaymentCheck) (*model.Transaction, error) {
+	ret := _mock.Called(ctx, payment, check)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservePayment")
+	}
+
+	var r0 *model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Transaction, repository.PaymentCheck) (*model.Transaction, error)); ok {
+		return returnFunc(ctx, payment, check)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Transaction, repository.PaymentCheck) *model.Transaction); ok {
+		r0 = returnFunc(ctx, payment, check)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Transaction, repository.PaymentCheck) error); ok {
+		r1 = returnFunc(ctx, payment, check)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_ReservePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReservePayment'
+type MockTransactionRepository_ReservePayment_Call struct {
+	*mock.Call
+}
+
+// ReservePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *model.Transaction
+//   - check repository.PaymentCheck
+func (_e *MockTransactionRepository_Expecter) ReservePayment(ctx interface{}, payment interface{}, check interface{}) *MockTransactionRepository_ReservePayment_Call {
+	return &MockTransactionRepository_ReservePayment_Call{Call: _e.mock.On("ReservePayment", ctx, payment, check)}
+}
+
+func (_c *MockTransactionRepository_ReservePayment_Call) Run(run func(ctx context.Context, payment *model.Transaction, check repository.PaymentCheck)) *MockTransactionRepository_ReservePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(*model.Transaction)
+		}
+		var arg2 repository.PaymentCheck
+		if args[2] != nil {
+			arg2 = args[2].(repository.PaymentCheck)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_ReservePayment_Call) Return(transaction *model.Transaction, err error) *MockTransactionRepository_ReservePayment_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_ReservePayment_Call) RunAndReturn(run func(ctx context.Context, payment *model.Transaction, check repository.PaymentCheck) (*model.Transaction, error)) *MockTransactionRepository_ReservePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
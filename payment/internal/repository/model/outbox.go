@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage представляет запись таблицы outbox в repository слое
+type OutboxMessage struct {
+	UUID          uuid.UUID
+	AggregateUUID uuid.UUID
+	EventType     string
+	Key           []byte
+	Payload       []byte
+	TraceContext  map[string]string
+	CreatedAt     time.Time
+}
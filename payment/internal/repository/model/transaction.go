@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Transaction представляет запись таблицы transactions в repository слое
+type Transaction struct {
+	TransactionUUID       uuid.UUID
+	OrderUUID             uuid.UUID
+	UserUUID              uuid.UUID
+	Type                  string
+	Status                string
+	PaymentMethod         string
+	Amount                int64
+	Currency              string
+	FailureCode           *string
+	ParentTransactionUUID *uuid.UUID
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
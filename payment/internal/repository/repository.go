@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+// TransactionRepository представляет интерфейс журнала транзакций в repository слое
+type TransactionRepository interface {
+	// ReservePayment под блокировками пользователя и заказа оплаты вызывает check и сохраняет оплату
+	// в статусе PENDING до обращения к провайдеру. Оплаты одного пользователя проходят check
+	// и сохранение по очереди. Если у заказа уже есть оплата, возвращает ее без вызова check.
+	// Возвращает ErrOrderAlreadyPaid, если оплата заказа сохранена в обход блокировки
+	ReservePayment(ctx context.Context, payment *model.Transaction, check PaymentCheck) (*model.Transaction, error)
+	// GetOrderPayment возвращает транзакцию оплаты заказа или ErrTransactionNotFound
+	GetOrderPayment(ctx context.Context, orderUUID uuid.UUID) (*model.Transaction, error)
+	// GetTransaction возвращает транзакцию по UUID или ErrTransactionNotFound
+	GetTransaction(ctx context.Context, transactionUUID uuid.UUID) (*model.Transaction, error)
+	// ListTransactions возвращает до limit транзакций по фильтру, начиная после курсора
+	ListTransactions(ctx context.Context, filter model.TransactionFilter, cursor *model.TransactionCursor, limit int) ([]*model.Transaction, error)
+	// ListSettledPayments возвращает оплаты в статусах SUCCEEDED и REFUNDED, созданные в окне window
+	ListSettledPayments(ctx context.Context, window model.ReconciliationWindow) ([]*model.Transaction, error)
+	// GetTransactions возвращает найденные транзакции по списку UUID; отсутствующие пропускаются
+	GetTransactions(ctx context.Context, transactionUUIDs []uuid.UUID) ([]*model.Transaction, error)
+	// GetPaymentStats возвращает суммы и число оплат пользователя за окна из query
+	GetPaymentStats(ctx context.Context, query model.PaymentStatsQuery) (*model.PaymentStats, error)
+	// RefundTransaction в одной транзакции переводит оплату в статус REFUNDED и сохраняет refund.
+	// Если оплата уже возвращена, возвращает существующую транзакцию возврата
+	RefundTransaction(ctx context.Context, paymentUUID uuid.UUID, refund *model.Transaction) (*model.Transaction, error)
+	// FinalizePayment в одной транзакции переводит оплату из PENDING в итоговый статус
+	// и сохраняет событие из handler в outbox.
+	// Если оплата уже не в статусе PENDING, возвращает ErrPaymentAlreadyFinalized
+	FinalizePayment(ctx context.Context, paymentUUID uuid.UUID, status model.TransactionStatus, failureCode model.DeclineCode, handler PaymentResultHandler) (*model.Transaction, error)
+	// FailExpiredPayments блокирует до limit оплат в статусе PENDING, созданных раньше createdBefore,
+	// переводит их в FAILED с кодом failureCode и сохраняет события из handler в outbox.
+	// Возвращает завершенные оплаты
+	FailExpiredPayments(ctx context.Context, createdBefore time.Time, limit int, failureCode model.DeclineCode, handler PaymentResultHandler) ([]*model.Transaction, error)
+}
+
+// PaymentCheck проверяет новую оплату перед сохранением в журнал
+type PaymentCheck func(ctx context.Context, payment *model.Transaction) error
+
+// PaymentResultHandler возвращает событие об итоге асинхронной оплаты для outbox
+type PaymentResultHandler func(payment *model.Transaction) (*model.OutboxMessage, error)
+
+// OutboxHandler обрабатывает одно событие из outbox
+type OutboxHandler func(ctx context.Context, message *model.OutboxMessage) error
+
+// OutboxRepository представляет интерфейс для работы с outbox в repository слое
+type OutboxRepository interface {
+	// ProcessPending блокирует до limit неотправленных событий, передает их в handler
+	// и помечает успешно обработанные как отправленные. Возвращает число отправленных событий
+	ProcessPending(ctx context.Context, limit int, handler OutboxHandler) (int, error)
+}
@@ -0,0 +1,104 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository/converter"
+	repoModel "github.com/radiophysiker/microservices-homework/payment/internal/repository/model"
+)
+
+// FailExpiredPayments блокирует до limit оплат в статусе PENDING, созданных раньше createdBefore,
+// и в одной транзакции сохраняет их статус FAILED и события из handler.
+// Строки выбираются через FOR UPDATE SKIP LOCKED, поэтому оплата, callback которой
+// обрабатывается прямо сейчас, пропускается до следующего запуска
+func (r *Repository) FailExpiredPayments(
+	ctx context.Context,
+	createdBefore time.Time,
+	limit int,
+	failureCode model.DeclineCode,
+	handler repository.PaymentResultHandler,
+) ([]*model.Transaction, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer r.rollbackTx(ctx, tx)
+
+	repoPayments, err := r.lockExpired(ctx, tx, createdBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	failed := make([]*model.Transaction, 0, len(repoPayments))
+
+	for _, repoPayment := range repoPayments {
+		payment := converter.ToServiceTransaction(repoPayment)
+		payment.Status = model.TransactionStatusFailed
+		payment.FailureCode = failureCode
+		payment.UpdatedAt = now
+
+		message, err := handler(payment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to handle expired payment %s: %w", payment.TransactionUUID, err)
+		}
+
+		if err := r.saveFinalizedPayment(ctx, tx, payment, message); err != nil {
+			return nil, err
+		}
+
+		failed = append(failed, payment)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return failed, nil
+}
+
+// lockExpired выбирает и блокирует оплаты в статусе PENDING, созданные раньше createdBefore
+func (r *Repository) lockExpired(ctx context.Context, tx pgx.Tx, createdBefore time.Time, limit int) ([]*repoModel.Transaction, error) {
+	query, args, err := sq.Select(transactionColumns...).
+		From("transactions").
+		Where(sq.Eq{"status": model.TransactionStatusPending.String()}).
+		Where(sq.Lt{"created_at": createdBefore}).
+		OrderBy("created_at").
+		Limit(uint64(limit)). //nolint:gosec // limit задается конфигурацией и всегда положителен
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select expired payments query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select expired payments: %w", err)
+	}
+	defer rows.Close()
+
+	payments := make([]*repoModel.Transaction, 0, limit)
+
+	for rows.Next() {
+		payment, err := scanTransaction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan expired payment: %w", err)
+		}
+
+		payments = append(payments, payment)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate expired payments: %w", rows.Err())
+	}
+
+	return payments, nil
+}
@@ -0,0 +1,115 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository/converter"
+	repoModel "github.com/radiophysiker/microservices-homework/payment/internal/repository/model"
+)
+
+// FinalizePayment переводит оплату из PENDING в итоговый статус и в той же транзакции
+// сохраняет событие из handler. Строка оплаты блокируется, поэтому callback провайдера
+// и отмена по таймауту не могут завершить одну оплату дважды
+func (r *Repository) FinalizePayment(
+	ctx context.Context,
+	paymentUUID uuid.UUID,
+	status model.TransactionStatus,
+	failureCode model.DeclineCode,
+	handler repository.PaymentResultHandler,
+) (*model.Transaction, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer r.rollbackTx(ctx, tx)
+
+	repoPayment, err := r.lockPayment(ctx, tx, paymentUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if repoPayment.Status != model.TransactionStatusPending.String() {
+		return nil, fmt.Errorf("%w: payment %s is %s", model.ErrPaymentAlreadyFinalized, paymentUUID, repoPayment.Status)
+	}
+
+	payment := converter.ToServiceTransaction(repoPayment)
+	payment.Status = status
+	payment.FailureCode = failureCode
+	payment.UpdatedAt = time.Now()
+
+	message, err := handler(payment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to handle finalized payment %s: %w", paymentUUID, err)
+	}
+
+	if err := r.saveFinalizedPayment(ctx, tx, payment, message); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return payment, nil
+}
+
+// lockPayment блокирует строку оплаты и возвращает ее
+func (r *Repository) lockPayment(ctx context.Context, tx pgx.Tx, paymentUUID uuid.UUID) (*repoModel.Transaction, error) {
+	query, args, err := sq.Select(transactionColumns...).
+		From("transactions").
+		Where(sq.Eq{"uuid": paymentUUID, "type": model.TransactionTypePayment.String()}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build lock payment query: %w", err)
+	}
+
+	payment, err := scanTransaction(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrTransactionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to lock payment: %w", err)
+	}
+
+	return payment, nil
+}
+
+// saveFinalizedPayment сохраняет итоговый статус заблокированной оплаты и событие
+func (r *Repository) saveFinalizedPayment(ctx context.Context, tx pgx.Tx, payment *model.Transaction, message *model.OutboxMessage) error {
+	repoPayment := converter.ToRepoTransaction(payment)
+
+	query, args, err := sq.Update("transactions").
+		Set("status", repoPayment.Status).
+		Set("failure_code", repoPayment.FailureCode).
+		Set("updated_at", repoPayment.UpdatedAt).
+		Where(sq.Eq{"uuid": repoPayment.TransactionUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build finalize payment query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to finalize payment: %w", err)
+	}
+
+	if message != nil {
+		if err := r.insertOutboxMessage(ctx, tx, converter.ToRepoOutboxMessage(message)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository/converter"
+)
+
+// GetTransaction возвращает транзакцию по UUID
+func (r *Repository) GetTransaction(ctx context.Context, transactionUUID uuid.UUID) (*model.Transaction, error) {
+	query, args, err := sq.Select(transactionColumns...).
+		From("transactions").
+		Where(sq.Eq{"uuid": transactionUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get transaction query: %w", err)
+	}
+
+	transaction, err := scanTransaction(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrTransactionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return converter.ToServiceTransaction(transaction), nil
+}
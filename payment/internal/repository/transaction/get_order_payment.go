@@ -0,0 +1,49 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository/converter"
+)
+
+// GetOrderPayment возвращает транзакцию оплаты заказа.
+// Оплаты в статусе FAILED не учитываются: после отказа заказ можно оплатить снова
+func (r *Repository) GetOrderPayment(ctx context.Context, orderUUID uuid.UUID) (*model.Transaction, error) {
+	return getOrderPayment(ctx, r.pool, orderUUID)
+}
+
+// rowQuerier выполняет запрос, возвращающий одну строку: пул соединений или транзакция
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// getOrderPayment читает оплату заказа через querier
+func getOrderPayment(ctx context.Context, querier rowQuerier, orderUUID uuid.UUID) (*model.Transaction, error) {
+	query, args, err := sq.Select(transactionColumns...).
+		From("transactions").
+		Where(sq.Eq{"order_uuid": orderUUID, "type": model.TransactionTypePayment.String()}).
+		Where(sq.NotEq{"status": model.TransactionStatusFailed.String()}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get order payment query: %w", err)
+	}
+
+	transaction, err := scanTransaction(querier.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrTransactionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get order payment: %w", err)
+	}
+
+	return converter.ToServiceTransaction(transaction), nil
+}
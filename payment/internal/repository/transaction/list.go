@@ -0,0 +1,61 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository/converter"
+)
+
+// ListTransactions возвращает до limit транзакций, подходящих под фильтр, начиная после курсора.
+// Транзакции упорядочены по убыванию (created_at, uuid)
+func (r *Repository) ListTransactions(ctx context.Context, filter model.TransactionFilter, cursor *model.TransactionCursor, limit int) ([]*model.Transaction, error) {
+	builder := sq.Select(transactionColumns...).
+		From("transactions").
+		OrderBy("created_at DESC", "uuid DESC").
+		Limit(uint64(limit)). //nolint:gosec // limit ограничивается сервисным слоем и всегда положителен
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.UserUUID != nil {
+		builder = builder.Where(sq.Eq{"user_uuid": *filter.UserUUID})
+	}
+
+	if filter.OrderUUID != nil {
+		builder = builder.Where(sq.Eq{"order_uuid": *filter.OrderUUID})
+	}
+
+	if cursor != nil {
+		builder = builder.Where(sq.Expr("(created_at, uuid) < (?, ?)", cursor.CreatedAt, cursor.TransactionUUID))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build list transactions query: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+	defer rows.Close()
+
+	transactions := make([]*model.Transaction, 0, limit)
+
+	for rows.Next() {
+		transaction, err := scanTransaction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan transaction: %w", err)
+		}
+
+		transactions = append(transactions, converter.ToServiceTransaction(transaction))
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate transactions: %w", rows.Err())
+	}
+
+	return transactions, nil
+}
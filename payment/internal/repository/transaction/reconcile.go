@@ -0,0 +1,77 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository/converter"
+)
+
+// ListSettledPayments возвращает оплаты в статусах SUCCEEDED и REFUNDED, созданные в окне window.
+// Оплаты в статусе PENDING еще не завершены, а FAILED не списывали средства, поэтому не сверяются
+func (r *Repository) ListSettledPayments(ctx context.Context, window model.ReconciliationWindow) ([]*model.Transaction, error) {
+	query, args, err := sq.Select(transactionColumns...).
+		From("transactions").
+		Where(sq.Eq{
+			"type":   model.TransactionTypePayment.String(),
+			"status": []string{model.TransactionStatusSucceeded.String(), model.TransactionStatusRefunded.String()},
+		}).
+		Where(sq.GtOrEq{"created_at": window.Since}).
+		Where(sq.Lt{"created_at": window.Until}).
+		OrderBy("created_at", "uuid").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build list settled payments query: %w", err)
+	}
+
+	return r.queryTransactions(ctx, query, args...)
+}
+
+// GetTransactions возвращает найденные транзакции по списку UUID; отсутствующие пропускаются
+func (r *Repository) GetTransactions(ctx context.Context, transactionUUIDs []uuid.UUID) ([]*model.Transaction, error) {
+	if len(transactionUUIDs) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sq.Select(transactionColumns...).
+		From("transactions").
+		Where(sq.Eq{"uuid": transactionUUIDs}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get transactions query: %w", err)
+	}
+
+	return r.queryTransactions(ctx, query, args...)
+}
+
+// queryTransactions выполняет запрос, выбирающий transactionColumns, и конвертирует строки в модели service
+func (r *Repository) queryTransactions(ctx context.Context, query string, args ...any) ([]*model.Transaction, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var transactions []*model.Transaction
+
+	for rows.Next() {
+		transaction, err := scanTransaction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan transaction: %w", err)
+		}
+
+		transactions = append(transactions, converter.ToServiceTransaction(transaction))
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate transactions: %w", rows.Err())
+	}
+
+	return transactions, nil
+}
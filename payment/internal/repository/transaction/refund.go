@@ -0,0 +1,114 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository/converter"
+	repoModel "github.com/radiophysiker/microservices-homework/payment/internal/repository/model"
+)
+
+// RefundTransaction переводит оплату в статус REFUNDED и сохраняет транзакцию возврата.
+// Строка оплаты блокируется, поэтому параллельные возвраты не создают второй refund:
+// повторный вызов возвращает уже сохраненный возврат
+func (r *Repository) RefundTransaction(ctx context.Context, paymentUUID uuid.UUID, refund *model.Transaction) (*model.Transaction, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer r.rollbackTx(ctx, tx)
+
+	status, err := r.lockStatus(ctx, tx, paymentUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == model.TransactionStatusRefunded.String() {
+		existing, err := r.getRefund(ctx, tx, paymentUUID)
+		if err != nil {
+			return nil, err
+		}
+
+		return converter.ToServiceTransaction(existing), nil
+	}
+
+	repoRefund := converter.ToRepoTransaction(refund)
+
+	query, args, err := insertTransactionQuery(repoRefund)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to insert refund transaction: %w", err)
+	}
+
+	query, args, err = sq.Update("transactions").
+		Set("status", model.TransactionStatusRefunded.String()).
+		Set("updated_at", repoRefund.CreatedAt).
+		Where(sq.Eq{"uuid": paymentUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update payment status query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to update payment status: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return refund, nil
+}
+
+// lockStatus блокирует строку оплаты и возвращает ее статус
+func (r *Repository) lockStatus(ctx context.Context, tx pgx.Tx, paymentUUID uuid.UUID) (string, error) {
+	query, args, err := sq.Select("status").
+		From("transactions").
+		Where(sq.Eq{"uuid": paymentUUID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("failed to build lock payment query: %w", err)
+	}
+
+	var status string
+	if err := tx.QueryRow(ctx, query, args...).Scan(&status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", model.ErrTransactionNotFound
+		}
+
+		return "", fmt.Errorf("failed to lock payment: %w", err)
+	}
+
+	return status, nil
+}
+
+// getRefund возвращает транзакцию возврата оплаты
+func (r *Repository) getRefund(ctx context.Context, tx pgx.Tx, paymentUUID uuid.UUID) (*repoModel.Transaction, error) {
+	query, args, err := sq.Select(transactionColumns...).
+		From("transactions").
+		Where(sq.Eq{"parent_uuid": paymentUUID, "type": model.TransactionTypeRefund.String()}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get refund query: %w", err)
+	}
+
+	refund, err := scanTransaction(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refund transaction: %w", err)
+	}
+
+	return refund, nil
+}
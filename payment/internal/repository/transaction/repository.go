@@ -0,0 +1,72 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	repoModel "github.com/radiophysiker/microservices-homework/payment/internal/repository/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// transactionColumns - колонки таблицы transactions в порядке сканирования scanTransaction
+var transactionColumns = []string{
+	"uuid",
+	"order_uuid",
+	"user_uuid",
+	"type",
+	"status",
+	"payment_method",
+	"amount",
+	"currency",
+	"failure_code",
+	"parent_uuid",
+	"created_at",
+	"updated_at",
+}
+
+// Repository реализует интерфейс TransactionRepository
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRepository создает новый экземпляр Repository
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		pool: pool,
+	}
+}
+
+// scanTransaction читает строку, выбранную по transactionColumns
+func scanTransaction(row pgx.Row) (*repoModel.Transaction, error) {
+	var transaction repoModel.Transaction
+
+	if err := row.Scan(
+		&transaction.TransactionUUID,
+		&transaction.OrderUUID,
+		&transaction.UserUUID,
+		&transaction.Type,
+		&transaction.Status,
+		&transaction.PaymentMethod,
+		&transaction.Amount,
+		&transaction.Currency,
+		&transaction.FailureCode,
+		&transaction.ParentTransactionUUID,
+		&transaction.CreatedAt,
+		&transaction.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+// rollbackTx откатывает транзакцию, если она не была закоммичена
+func (r *Repository) rollbackTx(ctx context.Context, tx pgx.Tx) {
+	if err := tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+		logger.Error(ctx, "failed to rollback transaction", zap.Error(err))
+	}
+}
@@ -0,0 +1,112 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository/converter"
+	repoModel "github.com/radiophysiker/microservices-homework/payment/internal/repository/model"
+)
+
+const (
+	// uniqueViolationCode - код ошибки PostgreSQL при нарушении уникальности
+	uniqueViolationCode = "23505"
+	// orderPaymentIndex - уникальный индекс, допускающий одну оплату на заказ не в статусе FAILED
+	orderPaymentIndex = "idx_transactions_order_payment"
+	// userPaymentLockPrefix - префикс ключа advisory-блокировки оплат пользователя
+	userPaymentLockPrefix = "payment:user:"
+	// orderPaymentLockPrefix - префикс ключа advisory-блокировки оплаты заказа
+	orderPaymentLockPrefix = "payment:order:"
+)
+
+// ReservePayment сохраняет новую оплату в статусе PENDING в короткой транзакции, которая сначала
+// берет advisory-блокировки пользователя и заказа и вызывает check. Блокировки держатся до коммита,
+// поэтому следующая оплата пользователя проходит check только после сохранения предыдущей,
+// и правила риска в check видят все уже принятые оплаты. Оплата заказа, сохраненная параллельным
+// запросом, проверяется под блокировкой и возвращается вместо новой.
+// Провайдер вызывается уже после коммита, так что блокировка и соединение не ждут его ответа
+func (r *Repository) ReservePayment(ctx context.Context, payment *model.Transaction, check repository.PaymentCheck) (*model.Transaction, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer r.rollbackTx(ctx, tx)
+
+	// Блокировки берутся всегда в одном порядке: сначала пользователь, затем заказ
+	for _, lockKey := range []string{
+		userPaymentLockPrefix + payment.UserUUID.String(),
+		orderPaymentLockPrefix + payment.OrderUUID.String(),
+	} {
+		if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", lockKey); err != nil {
+			return nil, fmt.Errorf("failed to lock payments: %w", err)
+		}
+	}
+
+	existing, err := getOrderPayment(ctx, tx, payment.OrderUUID)
+	if err == nil {
+		return existing, nil
+	}
+
+	if !errors.Is(err, model.ErrTransactionNotFound) {
+		return nil, err
+	}
+
+	if err := check(ctx, payment); err != nil {
+		return nil, err
+	}
+
+	payment.Status = model.TransactionStatusPending
+
+	query, args, err := insertTransactionQuery(converter.ToRepoTransaction(payment))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == orderPaymentIndex {
+			return nil, model.ErrOrderAlreadyPaid
+		}
+
+		return nil, fmt.Errorf("failed to insert transaction: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil, nil
+}
+
+// insertTransactionQuery строит запрос вставки транзакции
+func insertTransactionQuery(transaction *repoModel.Transaction) (string, []any, error) {
+	query, args, err := sq.Insert("transactions").
+		Columns(transactionColumns...).
+		Values(
+			transaction.TransactionUUID,
+			transaction.OrderUUID,
+			transaction.UserUUID,
+			transaction.Type,
+			transaction.Status,
+			transaction.PaymentMethod,
+			transaction.Amount,
+			transaction.Currency,
+			transaction.FailureCode,
+			transaction.ParentTransactionUUID,
+			transaction.CreatedAt,
+			transaction.UpdatedAt,
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to build insert transaction query: %w", err)
+	}
+
+	return query, args, nil
+}
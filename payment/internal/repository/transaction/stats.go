@@ -0,0 +1,53 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+// GetPaymentStats считает суммы и число оплат пользователя за окна из query одним запросом.
+// В суммы попадают оплаты в статусах SUCCEEDED и PENDING, а в число попыток - оплаты в любом статусе,
+// включая отклоненные провайдером (FAILED)
+func (r *Repository) GetPaymentStats(ctx context.Context, query model.PaymentStatsQuery) (*model.PaymentStats, error) {
+	since := query.MonthSince
+	if query.VelocitySince.Before(since) {
+		since = query.VelocitySince
+	}
+
+	charged := []string{model.TransactionStatusSucceeded.String(), model.TransactionStatusPending.String()}
+	method := query.PaymentMethod.String()
+
+	sql, args, err := sq.Select().
+		Column(sq.Expr("COALESCE(SUM(amount) FILTER (WHERE status = ANY(?) AND currency = ? AND created_at >= ?), 0)::BIGINT", charged, query.Currency, query.DaySince)).
+		Column(sq.Expr("COALESCE(SUM(amount) FILTER (WHERE status = ANY(?) AND currency = ? AND created_at >= ?), 0)::BIGINT", charged, query.Currency, query.MonthSince)).
+		Column(sq.Expr("COALESCE(SUM(amount) FILTER (WHERE status = ANY(?) AND currency = ? AND created_at >= ? AND payment_method = ?), 0)::BIGINT", charged, query.Currency, query.DaySince, method)).
+		Column(sq.Expr("COALESCE(SUM(amount) FILTER (WHERE status = ANY(?) AND currency = ? AND created_at >= ? AND payment_method = ?), 0)::BIGINT", charged, query.Currency, query.MonthSince, method)).
+		Column(sq.Expr("COUNT(*) FILTER (WHERE created_at >= ?)", query.VelocitySince)).
+		From("transactions").
+		Where(sq.Eq{"user_uuid": query.UserUUID, "type": model.TransactionTypePayment.String()}).
+		Where(sq.GtOrEq{"created_at": since}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build payment stats query: %w", err)
+	}
+
+	var stats model.PaymentStats
+
+	err = r.pool.QueryRow(ctx, sql, args...).Scan(
+		&stats.DailyAmount,
+		&stats.MonthlyAmount,
+		&stats.MethodDailyAmount,
+		&stats.MethodMonthlyAmount,
+		&stats.RecentPayments,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment stats: %w", err)
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,140 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package service
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockPaymentExpiryService creates a new instance of MockPaymentExpiryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPaymentExpiryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPaymentExpiryService {
+	mock := &MockPaymentExpiryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPaymentExpiryService is an autogenerated mock type for the PaymentExpiryService type
+type MockPaymentExpiryService struct {
+	mock.Mock
+}
+
+type MockPaymentExpiryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPaymentExpiryService) EXPECT() *MockPaymentExpiryService_Expecter {
+	return &MockPaymentExpiryService_Expecter{mock: &_m.Mock}
+}
+
+// Run provides a mock function for the type MockPaymentExpiryService
+func (_mock *MockPaymentExpiryService) Run(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPaymentExpiryService_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type MockPaymentExpiryService_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPaymentExpiryService_Expecter) Run(ctx interface{}) *MockPaymentExpiryService_Run_Call {
+	return &MockPaymentExpiryService_Run_Call{Call: _e.mock.On("Run", ctx)}
+}
+
+func (_c *MockPaymentExpiryService_Run_Call) Run(run func(ctx context.Context)) *MockPaymentExpiryService_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentExpiryService_Run_Call) Return(err error) *MockPaymentExpiryService_Run_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPaymentExpiryService_Run_Call) RunAndReturn(run func(ctx context.Context) error) *MockPaymentExpiryService_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function for the type MockPaymentExpiryService
+func (_mock *MockPaymentExpiryService) Stop(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPaymentExpiryService_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockPaymentExpiryService_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPaymentExpiryService_Expecter) Stop(ctx interface{}) *MockPaymentExpiryService_Stop_Call {
+	return &MockPaymentExpiryService_Stop_Call{Call: _e.mock.On("Stop", ctx)}
+}
+
+func (_c *MockPaymentExpiryService_Stop_Call) Run(run func(ctx context.Context)) *MockPaymentExpiryService_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentExpiryService_Stop_Call) Return(err error) *MockPaymentExpiryService_Stop_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPaymentExpiryService_Stop_Call) RunAndReturn(run func(ctx context.Context) error) *MockPaymentExpiryService_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
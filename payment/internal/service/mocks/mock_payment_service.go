@@ -6,8 +6,9 @@ package service
 
 import (
 	"context"
+	"time"
 
-	"github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,26 +39,328 @@ func (_m *MockPaymentService) EXPECT() *MockPaymentService_Expecter {
 	return &MockPaymentService_Expecter{mock: &_m.Mock}
 }
 
+// CompletePayment provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) CompletePayment(ctx context.Context, transactionUUID string, status model.TransactionStatus, declineCode model.DeclineCode) (*model.Transaction, error) {
+	ret := _mock.Called(ctx, transactionUUID, status, declineCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompletePayment")
+	}
+
+	var r0 *model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.TransactionStatus, model.DeclineCode) (*model.Transaction, error)); ok {
+		return returnFunc(ctx, transactionUUID, status, declineCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.TransactionStatus, model.DeclineCode) *model.Transaction); ok {
+		r0 = returnFunc(ctx, transactionUUID, status, declineCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.TransactionStatus, model.DeclineCode) error); ok {
+		r1 = returnFunc(ctx, transactionUUID, status, declineCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentService_CompletePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompletePayment'
+type MockPaymentService_CompletePayment_Call struct {
+	*mock.Call
+}
+
+// CompletePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - status model.TransactionStatus
+//   - declineCode model.DeclineCode
+func (_e *MockPaymentService_Expecter) CompletePayment(ctx interface{}, transactionUUID interface{}, status interface{}, declineCode interface{}) *MockPaymentService_CompletePayment_Call {
+	return &MockPaymentService_CompletePayment_Call{Call: _e.mock.On("CompletePayment", ctx, transactionUUID, status, declineCode)}
+}
+
+func (_c *MockPaymentService_CompletePayment_Call) Run(run func(ctx context.Context, transactionUUID string, status model.TransactionStatus, declineCode model.DeclineCode)) *MockPaymentService_CompletePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.TransactionStatus
+		if args[2] != nil {
+			arg2 = args[2].(model.TransactionStatus)
+		}
+		var arg3 model.DeclineCode
+		if args[3] != nil {
+			arg3 = args[3].(model.DeclineCode)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_CompletePayment_Call) Return(transaction *model.Transaction, err error) *MockPaymentService_CompletePayment_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockPaymentService_CompletePayment_Call) RunAndReturn(run func(ctx context.Context, transactionUUID string, status model.TransactionStatus, declineCode model.DeclineCode) (*model.Transaction, error)) *MockPaymentService_CompletePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpirePendingPayments provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) ExpirePendingPayments(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	ret := _mock.Called(ctx, createdBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpirePendingPayments")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return returnFunc(ctx, createdBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = returnFunc(ctx, createdBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, createdBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentService_ExpirePendingPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpirePendingPayments'
+type MockPaymentService_ExpirePendingPayments_Call struct {
+	*mock.Call
+}
+
+// ExpirePendingPayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+func (_e *MockPaymentService_Expecter) ExpirePendingPayments(ctx interface{}, createdBefore interface{}, limit interface{}) *MockPaymentService_ExpirePendingPayments_Call {
+	return &MockPaymentService_ExpirePendingPayments_Call{Call: _e.mock.On("ExpirePendingPayments", ctx, createdBefore, limit)}
+}
+
+func (_c *MockPaymentService_ExpirePendingPayments_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int)) *MockPaymentService_ExpirePendingPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_ExpirePendingPayments_Call) Return(n int, err error) *MockPaymentService_ExpirePendingPayments_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPaymentService_ExpirePendingPayments_Call) RunAndReturn(run func(ctx context.Context, createdBefore time.Time, limit int) (int, error)) *MockPaymentService_ExpirePendingPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransaction provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) GetTransaction(ctx context.Context, transactionUUID string) (*model.Transaction, error) {
+	ret := _mock.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 *model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.Transaction, error)); ok {
+		return returnFunc(ctx, transactionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.Transaction); ok {
+		r0 = returnFunc(ctx, transactionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentService_GetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransaction'
+type MockPaymentService_GetTransaction_Call struct {
+	*mock.Call
+}
+
+// GetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *MockPaymentService_Expecter) GetTransaction(ctx interface{}, transactionUUID interface{}) *MockPaymentService_GetTransaction_Call {
+	return &MockPaymentService_GetTransaction_Call{Call: _e.mock.On("GetTransaction", ctx, transactionUUID)}
+}
+
+func (_c *MockPaymentService_GetTransaction_Call) Run(run func(ctx context.Context, transactionUUID string)) *MockPaymentService_GetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_GetTransaction_Call) Return(transaction *model.Transaction, err error) *MockPaymentService_GetTransaction_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockPaymentService_GetTransaction_Call) RunAndReturn(run func(ctx context.Context, transactionUUID string) (*model.Transaction, error)) *MockPaymentService_GetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTransactions provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) ListTransactions(ctx context.Context, filter model.TransactionFilter, cursor *model.TransactionCursor, pageSize int) (*model.TransactionPage, error) {
+	ret := _mock.Called(ctx, filter, cursor, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTransactions")
+	}
+
+	var r0 *model.TransactionPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.TransactionFilter, *model.TransactionCursor, int) (*model.TransactionPage, error)); ok {
+		return returnFunc(ctx, filter, cursor, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.TransactionFilter, *model.TransactionCursor, int) *model.TransactionPage); ok {
+		r0 = returnFunc(ctx, filter, cursor, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TransactionPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.TransactionFilter, *model.TransactionCursor, int) error); ok {
+		r1 = returnFunc(ctx, filter, cursor, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentService_ListTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransactions'
+type MockPaymentService_ListTransactions_Call struct {
+	*mock.Call
+}
+
+// ListTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.TransactionFilter
+//   - cursor *model.TransactionCursor
+//   - pageSize int
+func (_e *MockPaymentService_Expecter) ListTransactions(ctx interface{}, filter interface{}, cursor interface{}, pageSize interface{}) *MockPaymentService_ListTransactions_Call {
+	return &MockPaymentService_ListTransactions_Call{Call: _e.mock.On("ListTransactions", ctx, filter, cursor, pageSize)}
+}
+
+func (_c *MockPaymentService_ListTransactions_Call) Run(run func(ctx context.Context, filter model.TransactionFilter, cursor *model.TransactionCursor, pageSize int)) *MockPaymentService_ListTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.TransactionFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.TransactionFilter)
+		}
+		var arg2 *model.TransactionCursor
+		if args[2] != nil {
+			arg2 = args[2].(*model.TransactionCursor)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_ListTransactions_Call) Return(transactionPage *model.TransactionPage, err error) *MockPaymentService_ListTransactions_Call {
+	_c.Call.Return(transactionPage, err)
+	return _c
+}
+
+func (_c *MockPaymentService_ListTransactions_Call) RunAndReturn(run func(ctx context.Context, filter model.TransactionFilter, cursor *model.TransactionCursor, pageSize int) (*model.TransactionPage, error)) *MockPaymentService_ListTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PayOrder provides a mock function for the type MockPaymentService
-func (_mock *MockPaymentService) PayOrder(ctx context.Context, userUUID string, orderUUID string, paymentMethod v1.PaymentMethod) (string, error) {
-	ret := _mock.Called(ctx, userUUID, orderUUID, paymentMethod)
+func (_mock *MockPaymentService) PayOrder(ctx context.Context, userUUID string, orderUUID string, paymentMethod model.PaymentMethod, amount int64, currency string, cardToken string) (*model.Transaction, error) {
+	ret := _mock.Called(ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
 	}
 
-	var r0 string
+	var r0 *model.Transaction
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, v1.PaymentMethod) (string, error)); ok {
-		return returnFunc(ctx, userUUID, orderUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod, int64, string, string) (*model.Transaction, error)); ok {
+		return returnFunc(ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, v1.PaymentMethod) string); ok {
-		r0 = returnFunc(ctx, userUUID, orderUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod, int64, string, string) *model.Transaction); ok {
+		r0 = returnFunc(ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, v1.PaymentMethod) error); ok {
-		r1 = returnFunc(ctx, userUUID, orderUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.PaymentMethod, int64, string, string) error); ok {
+		r1 = returnFunc(ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,12 +376,108 @@ type MockPaymentService_PayOrder_Call struct {
 //   - ctx context.Context
 //   - userUUID string
 //   - orderUUID string
-//   - paymentMethod v1.PaymentMethod
-func (_e *MockPaymentService_Expecter) PayOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}) *MockPaymentService_PayOrder_Call {
-	return &MockPaymentService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, orderUUID, paymentMethod)}
+//   - paymentMethod model.PaymentMethod
+//   - amount int64
+//   - currency string
+//   - cardToken string
+func (_e *MockPaymentService_Expecter) PayOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}, amount interface{}, currency interface{}, cardToken interface{}) *MockPaymentService_PayOrder_Call {
+	return &MockPaymentService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, orderUUID, paymentMethod, amount, currency, cardToken)}
+}
+
+func (_c *MockPaymentService_PayOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod model.PaymentMethod, amount int64, currency string, cardToken string)) *MockPaymentService_PayOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 model.PaymentMethod
+		if args[3] != nil {
+			arg3 = args[3].(model.PaymentMethod)
+		}
+		var arg4 int64
+		if args[4] != nil {
+			arg4 = args[4].(int64)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		var arg6 string
+		if args[6] != nil {
+			arg6 = args[6].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_PayOrder_Call) Return(transaction *model.Transaction, err error) *MockPaymentService_PayOrder_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockPaymentService_PayOrder_Call) RunAndReturn(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod model.PaymentMethod, amount int64, currency string, cardToken string) (*model.Transaction, error)) *MockPaymentService_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) RefundPayment(ctx context.Context, userUUID string, orderUUID string, transactionUUID string) (string, error) {
+	ret := _mock.Called(ctx, userUUID, orderUUID, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return returnFunc(ctx, userUUID, orderUUID, transactionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = returnFunc(ctx, userUUID, orderUUID, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, userUUID, orderUUID, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentService_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type MockPaymentService_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - orderUUID string
+//   - transactionUUID string
+func (_e *MockPaymentService_Expecter) RefundPayment(ctx interface{}, userUUID interface{}, orderUUID interface{}, transactionUUID interface{}) *MockPaymentService_RefundPayment_Call {
+	return &MockPaymentService_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, userUUID, orderUUID, transactionUUID)}
 }
 
-func (_c *MockPaymentService_PayOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod v1.PaymentMethod)) *MockPaymentService_PayOrder_Call {
+func (_c *MockPaymentService_RefundPayment_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, transactionUUID string)) *MockPaymentService_RefundPayment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -92,9 +491,9 @@ func (_c *MockPaymentService_PayOrder_Call) Run(run func(ctx context.Context, us
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 v1.PaymentMethod
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].(v1.PaymentMethod)
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
@@ -106,12 +505,12 @@ func (_c *MockPaymentService_PayOrder_Call) Run(run func(ctx context.Context, us
 	return _c
 }
 
-func (_c *MockPaymentService_PayOrder_Call) Return(s string, err error) *MockPaymentService_PayOrder_Call {
+func (_c *MockPaymentService_RefundPayment_Call) Return(s string, err error) *MockPaymentService_RefundPayment_Call {
 	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockPaymentService_PayOrder_Call) RunAndReturn(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod v1.PaymentMethod) (string, error)) *MockPaymentService_PayOrder_Call {
+func (_c *MockPaymentService_RefundPayment_Call) RunAndReturn(run func(ctx context.Context, userUUID string, orderUUID string, transactionUUID string) (string, error)) *MockPaymentService_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
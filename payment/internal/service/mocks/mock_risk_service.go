@@ -0,0 +1,96 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package service
+
+import (
+	"context"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockRiskService creates a new instance of MockRiskService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRiskService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRiskService {
+	mock := &MockRiskService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRiskService is an autogenerated mock type for the RiskService type
+type MockRiskService struct {
+	mock.Mock
+}
+
+type MockRiskService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRiskService) EXPECT() *MockRiskService_Expecter {
+	return &MockRiskService_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function for the type MockRiskService
+func (_mock *MockRiskService) Check(ctx context.Context, payment *model.Transaction) error {
+	ret := _mock.Called(ctx, payment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Transaction) error); ok {
+		r0 = returnFunc(ctx, payment)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRiskService_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockRiskService_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *model.Transaction
+func (_e *MockRiskService_Expecter) Check(ctx interface{}, payment interface{}) *MockRiskService_Check_Call {
+	return &MockRiskService_Check_Call{Call: _e.mock.On("Check", ctx, payment)}
+}
+
+func (_c *MockRiskService_Check_Call) Run(run func(ctx context.Context, payment *model.Transaction)) *MockRiskService_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(*model.Transaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRiskService_Check_Call) Return(err error) *MockRiskService_Check_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRiskService_Check_Call) RunAndReturn(run func(ctx context.Context, payment *model.Transaction) error) *MockRiskService_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
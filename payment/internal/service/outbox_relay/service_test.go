@@ -0,0 +1,170 @@
+package outbox_relay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	repomocks "github.com/radiophysiker/microservices-homework/payment/internal/repository/mocks"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
+)
+
+// fakeProducer запоминает отправленные сообщения и trace ID контекста отправки
+type fakeProducer struct {
+	sent     [][]byte
+	traceIDs []string
+	err      error
+}
+
+func (p *fakeProducer) Send(ctx context.Context, _, value []byte, _ map[string][]byte) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.sent = append(p.sent, value)
+	p.traceIDs = append(p.traceIDs, tracing.TraceIDFromContext(ctx))
+
+	return nil
+}
+
+// ServiceTestSuite содержит общее окружение для тестов outbox relay
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx      context.Context
+	repo     *repomocks.MockOutboxRepository
+	producer *fakeProducer
+	service  *Service
+}
+
+// SetupTest запускается перед каждым тестом
+func (s *ServiceTestSuite) SetupTest() {
+	logger.SetNopLogger()
+
+	s.ctx = context.Background()
+	s.repo = repomocks.NewMockOutboxRepository(s.T())
+	s.producer = &fakeProducer{}
+	s.service = NewService(
+		s.repo,
+		map[string]kafka.Producer{model.EventTypePaymentCompleted: s.producer},
+		time.Second,
+		2,
+	)
+}
+
+// processWith возвращает реализацию ProcessPending, передающую сообщения в handler
+func processWith(messages ...*model.OutboxMessage) func(context.Context, int, repository.OutboxHandler) (int, error) {
+	return func(ctx context.Context, _ int, handler repository.OutboxHandler) (int, error) {
+		sent := 0
+
+		for _, msg := range messages {
+			if err := handler(ctx, msg); err != nil {
+				return sent, err
+			}
+
+			sent++
+		}
+
+		return sent, nil
+	}
+}
+
+func newMessage(eventType string) *model.OutboxMessage {
+	return &model.OutboxMessage{
+		UUID:          uuid.New(),
+		AggregateUUID: uuid.New(),
+		EventType:     eventType,
+		Payload:       []byte(eventType),
+	}
+}
+
+func (s *ServiceTestSuite) TestRelayPending() {
+	tests := []struct {
+		name        string
+		producerErr error
+		setupMock   func(repo *repomocks.MockOutboxRepository)
+		wantSent    int
+	}{
+		{
+			name: "single_batch",
+			setupMock: func(repo *repomocks.MockOutboxRepository) {
+				repo.EXPECT().ProcessPending(s.ctx, 2, mock.Anything).
+					RunAndReturn(processWith(newMessage(model.EventTypePaymentCompleted))).Once()
+			},
+			wantSent: 1,
+		},
+		{
+			name: "drains_full_batches",
+			setupMock: func(repo *repomocks.MockOutboxRepository) {
+				repo.EXPECT().ProcessPending(s.ctx, 2, mock.Anything).
+					RunAndReturn(processWith(newMessage(model.EventTypePaymentCompleted), newMessage(model.EventTypePaymentCompleted))).Once()
+				repo.EXPECT().ProcessPending(s.ctx, 2, mock.Anything).
+					RunAndReturn(processWith()).Once()
+			},
+			wantSent: 2,
+		},
+		{
+			name:        "producer_error_stops_relay",
+			producerErr: errors.New("kafka unavailable"),
+			setupMock: func(repo *repomocks.MockOutboxRepository) {
+				repo.EXPECT().ProcessPending(s.ctx, 2, mock.Anything).
+					RunAndReturn(processWith(newMessage(model.EventTypePaymentCompleted), newMessage(model.EventTypePaymentCompleted))).Once()
+			},
+			wantSent: 0,
+		},
+		{
+			name: "unknown_event_type_is_not_published",
+			setupMock: func(repo *repomocks.MockOutboxRepository) {
+				repo.EXPECT().ProcessPending(s.ctx, 2, mock.Anything).
+					RunAndReturn(processWith(newMessage("Unknown"))).Once()
+			},
+			wantSent: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			s.producer.err = tt.producerErr
+			tt.setupMock(s.repo)
+
+			s.service.relayPending(s.ctx)
+
+			require.Len(s.T(), s.producer.sent, tt.wantSent)
+		})
+	}
+}
+
+// TestPublishContinuesStoredTrace проверяет, что событие публикуется в трейсе запроса, создавшего его
+func (s *ServiceTestSuite) TestPublishContinuesStoredTrace() {
+	prevPropagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	s.T().Cleanup(func() { otel.SetTextMapPropagator(prevPropagator) })
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	message := newMessage(model.EventTypePaymentCompleted)
+	message.TraceContext = map[string]string{
+		"traceparent": "00-" + traceID + "-00f067aa0ba902b7-01",
+	}
+
+	err := s.service.publish(s.ctx, message)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []string{traceID}, s.producer.traceIDs)
+}
+
+// TestServiceSuite запускает все тесты suite
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
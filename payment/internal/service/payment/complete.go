@@ -0,0 +1,82 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// CompletePayment завершает асинхронную оплату по callback'у провайдера.
+// Итог сохраняется вместе с событием PaymentCompleted или PaymentFailed в outbox.
+// Повторный callback с тем же итогом возвращает транзакцию без нового события,
+// а callback с другим итогом - ErrPaymentAlreadyFinalized
+func (s *Service) CompletePayment(ctx context.Context, transactionUUID string, status model.TransactionStatus, declineCode model.DeclineCode) (*model.Transaction, error) {
+	parsedTransactionUUID, err := uuid.Parse(transactionUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid transaction_uuid %q", model.ErrInvalidCallbackRequest, transactionUUID)
+	}
+
+	switch status {
+	case model.TransactionStatusSucceeded:
+		if declineCode != "" {
+			return nil, fmt.Errorf("%w: decline code is set for succeeded payment", model.ErrInvalidCallbackRequest)
+		}
+	case model.TransactionStatusFailed:
+		if declineCode == "" {
+			return nil, fmt.Errorf("%w: decline code is required for failed payment", model.ErrInvalidCallbackRequest)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported status %s", model.ErrInvalidCallbackRequest, status)
+	}
+
+	payment, err := s.transactionRepository.FinalizePayment(ctx, parsedTransactionUUID, status, declineCode, func(payment *model.Transaction) (*model.OutboxMessage, error) {
+		return newPaymentResultOutboxMessage(ctx, payment)
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrPaymentAlreadyFinalized) {
+			return s.replayCallback(ctx, parsedTransactionUUID, status, declineCode, err)
+		}
+
+		if errors.Is(err, model.ErrTransactionNotFound) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to finalize payment: %w", err)
+	}
+
+	logger.Info(ctx, "Асинхронная оплата завершена",
+		zap.String("transaction_uuid", transactionUUID),
+		zap.String("order_uuid", payment.OrderUUID.String()),
+		zap.String("status", status.String()),
+		zap.String("decline_code", string(declineCode)))
+
+	return payment, nil
+}
+
+// replayCallback возвращает уже завершенную оплату, если повторный callback сообщает тот же итог
+func (s *Service) replayCallback(ctx context.Context, transactionUUID uuid.UUID, status model.TransactionStatus, declineCode model.DeclineCode, finalizedErr error) (*model.Transaction, error) {
+	existing, err := s.transactionRepository.GetTransaction(ctx, transactionUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finalized payment: %w", err)
+	}
+
+	// Проведенная оплата могла быть возвращена до повторного callback'а
+	sameStatus := existing.Status == status ||
+		(status == model.TransactionStatusSucceeded && existing.Status == model.TransactionStatusRefunded)
+
+	if !sameStatus || existing.FailureCode != declineCode {
+		return nil, finalizedErr
+	}
+
+	logger.Info(ctx, "Повторный callback оплаты, возвращаем существующую транзакцию",
+		zap.String("transaction_uuid", transactionUUID.String()),
+		zap.String("status", existing.Status.String()))
+
+	return existing, nil
+}
@@ -0,0 +1,202 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+// finalizePending возвращает реализацию FinalizePayment, завершающую ожидающую оплату payment.
+// Событие из handler сохраняется в *message
+func finalizePending(payment *model.Transaction, message **model.OutboxMessage) func(context.Context, uuid.UUID, model.TransactionStatus, model.DeclineCode, repository.PaymentResultHandler) (*model.Transaction, error) {
+	return func(_ context.Context, _ uuid.UUID, status model.TransactionStatus, code model.DeclineCode, handler repository.PaymentResultHandler) (*model.Transaction, error) {
+		payment.Status = status
+		payment.FailureCode = code
+
+		msg, err := handler(payment)
+		if err != nil {
+			return nil, err
+		}
+
+		*message = msg
+
+		return payment, nil
+	}
+}
+
+func (s *ServiceSuite) TestCompletePayment() {
+	paymentUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440007")
+
+	payment := func(status model.TransactionStatus, code model.DeclineCode) *model.Transaction {
+		return &model.Transaction{
+			TransactionUUID: paymentUUID,
+			OrderUUID:       s.orderUUID,
+			UserUUID:        s.userUUID,
+			Type:            model.TransactionTypePayment,
+			Status:          status,
+			PaymentMethod:   model.PaymentMethodSBP,
+			Amount:          paymentAmount,
+			Currency:        "RUB",
+			FailureCode:     code,
+		}
+	}
+
+	alreadyFinalized := fmt.Errorf("%w: payment %s is SUCCEEDED", model.ErrPaymentAlreadyFinalized, paymentUUID)
+
+	tests := []struct {
+		name            string
+		transactionUUID string
+		status          model.TransactionStatus
+		declineCode     model.DeclineCode
+		setupMock       func(message **model.OutboxMessage)
+		wantStatus      model.TransactionStatus
+		wantEventType   string
+		wantErr         error
+		wantErrSubstr   string
+	}{
+		{
+			name:            "succeeded_publishes_payment_completed",
+			transactionUUID: paymentUUID.String(),
+			status:          model.TransactionStatusSucceeded,
+			setupMock: func(message **model.OutboxMessage) {
+				s.repo.EXPECT().FinalizePayment(s.ctx, paymentUUID, model.TransactionStatusSucceeded, model.DeclineCode(""), mock.Anything).
+					RunAndReturn(finalizePending(payment(model.TransactionStatusPending, ""), message)).Once()
+			},
+			wantStatus:    model.TransactionStatusSucceeded,
+			wantEventType: model.EventTypePaymentCompleted,
+		},
+		{
+			name:            "failed_publishes_payment_failed",
+			transactionUUID: paymentUUID.String(),
+			status:          model.TransactionStatusFailed,
+			declineCode:     model.DeclineCodeInsufficientFunds,
+			setupMock: func(message **model.OutboxMessage) {
+				s.repo.EXPECT().FinalizePayment(s.ctx, paymentUUID, model.TransactionStatusFailed, model.DeclineCodeInsufficientFunds, mock.Anything).
+					RunAndReturn(finalizePending(payment(model.TransactionStatusPending, ""), message)).Once()
+			},
+			wantStatus:    model.TransactionStatusFailed,
+			wantEventType: model.EventTypePaymentFailed,
+		},
+		{
+			name:            "replay_with_same_result",
+			transactionUUID: paymentUUID.String(),
+			status:          model.TransactionStatusSucceeded,
+			setupMock: func(_ **model.OutboxMessage) {
+				s.repo.EXPECT().FinalizePayment(s.ctx, paymentUUID, model.TransactionStatusSucceeded, model.DeclineCode(""), mock.Anything).
+					Return(nil, alreadyFinalized).Once()
+				s.repo.EXPECT().GetTransaction(s.ctx, paymentUUID).Return(payment(model.TransactionStatusSucceeded, ""), nil).Once()
+			},
+			wantStatus: model.TransactionStatusSucceeded,
+		},
+		{
+			name:            "replay_after_refund",
+			transactionUUID: paymentUUID.String(),
+			status:          model.TransactionStatusSucceeded,
+			setupMock: func(_ **model.OutboxMessage) {
+				s.repo.EXPECT().FinalizePayment(s.ctx, paymentUUID, model.TransactionStatusSucceeded, model.DeclineCode(""), mock.Anything).
+					Return(nil, alreadyFinalized).Once()
+				s.repo.EXPECT().GetTransaction(s.ctx, paymentUUID).Return(payment(model.TransactionStatusRefunded, ""), nil).Once()
+			},
+			wantStatus: model.TransactionStatusRefunded,
+		},
+		{
+			name:            "conflicting_result",
+			transactionUUID: paymentUUID.String(),
+			status:          model.TransactionStatusFailed,
+			declineCode:     model.DeclineCodeDoNotHonor,
+			setupMock: func(_ **model.OutboxMessage) {
+				s.repo.EXPECT().FinalizePayment(s.ctx, paymentUUID, model.TransactionStatusFailed, model.DeclineCodeDoNotHonor, mock.Anything).
+					Return(nil, alreadyFinalized).Once()
+				s.repo.EXPECT().GetTransaction(s.ctx, paymentUUID).Return(payment(model.TransactionStatusSucceeded, ""), nil).Once()
+			},
+			wantErr:       model.ErrPaymentAlreadyFinalized,
+			wantErrSubstr: "payment already finalized",
+		},
+		{
+			name:            "not_found",
+			transactionUUID: paymentUUID.String(),
+			status:          model.TransactionStatusSucceeded,
+			setupMock: func(_ **model.OutboxMessage) {
+				s.repo.EXPECT().FinalizePayment(s.ctx, paymentUUID, model.TransactionStatusSucceeded, model.DeclineCode(""), mock.Anything).
+					Return(nil, model.ErrTransactionNotFound).Once()
+			},
+			wantErr:       model.ErrTransactionNotFound,
+			wantErrSubstr: "transaction not found",
+		},
+		{
+			name:            "repository_error",
+			transactionUUID: paymentUUID.String(),
+			status:          model.TransactionStatusSucceeded,
+			setupMock: func(_ **model.OutboxMessage) {
+				s.repo.EXPECT().FinalizePayment(s.ctx, paymentUUID, model.TransactionStatusSucceeded, model.DeclineCode(""), mock.Anything).
+					Return(nil, errors.New("database error")).Once()
+			},
+			wantErrSubstr: "failed to finalize payment",
+		},
+
+		{name: "invalid_transaction_uuid", transactionUUID: "tx-1", status: model.TransactionStatusSucceeded, wantErr: model.ErrInvalidCallbackRequest, wantErrSubstr: "invalid transaction_uuid"},
+		{name: "succeeded_with_decline_code", transactionUUID: paymentUUID.String(), status: model.TransactionStatusSucceeded, declineCode: model.DeclineCodeDoNotHonor, wantErr: model.ErrInvalidCallbackRequest, wantErrSubstr: "decline code is set"},
+		{name: "failed_without_decline_code", transactionUUID: paymentUUID.String(), status: model.TransactionStatusFailed, wantErr: model.ErrInvalidCallbackRequest, wantErrSubstr: "decline code is required"},
+		{name: "unsupported_status", transactionUUID: paymentUUID.String(), status: model.TransactionStatusPending, wantErr: model.ErrInvalidCallbackRequest, wantErrSubstr: "unsupported status"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+
+			var message *model.OutboxMessage
+
+			if tt.setupMock != nil {
+				tt.setupMock(&message)
+			}
+
+			transaction, err := s.svc.CompletePayment(s.ctx, tt.transactionUUID, tt.status, tt.declineCode)
+
+			if tt.wantErrSubstr != "" {
+				require.Error(s.T(), err)
+				require.Contains(s.T(), err.Error(), tt.wantErrSubstr)
+
+				if tt.wantErr != nil {
+					require.ErrorIs(s.T(), err, tt.wantErr)
+				}
+
+				return
+			}
+
+			require.NoError(s.T(), err)
+			require.Equal(s.T(), tt.wantStatus, transaction.Status)
+
+			if tt.wantEventType == "" {
+				require.Nil(s.T(), message)
+				return
+			}
+
+			require.NotNil(s.T(), message)
+			require.Equal(s.T(), tt.wantEventType, message.EventType)
+			require.Equal(s.T(), paymentUUID, message.AggregateUUID)
+			require.Equal(s.T(), []byte(s.orderUUID.String()), message.Key)
+
+			switch tt.wantEventType {
+			case model.EventTypePaymentCompleted:
+				var event eventspb.PaymentCompleted
+				require.NoError(s.T(), proto.Unmarshal(message.Payload, &event))
+				require.Equal(s.T(), s.orderUUID.String(), event.GetOrderUuid())
+				require.Equal(s.T(), int64(paymentAmount), event.GetAmount())
+			case model.EventTypePaymentFailed:
+				var event eventspb.PaymentFailed
+				require.NoError(s.T(), proto.Unmarshal(message.Payload, &event))
+				require.Equal(s.T(), s.orderUUID.String(), event.GetOrderUuid())
+				require.Equal(s.T(), string(tt.declineCode), event.GetDeclineCode())
+			}
+		})
+	}
+}
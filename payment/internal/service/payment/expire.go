@@ -0,0 +1,23 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+// ExpirePendingPayments завершает отказом с кодом TIMEOUT до limit асинхронных оплат,
+// созданных раньше createdBefore и не подтвержденных провайдером.
+// По каждой оплате в outbox сохраняется событие PaymentFailed
+func (s *Service) ExpirePendingPayments(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	expired, err := s.transactionRepository.FailExpiredPayments(ctx, createdBefore, limit, model.DeclineCodeTimeout, func(payment *model.Transaction) (*model.OutboxMessage, error) {
+		return newPaymentResultOutboxMessage(ctx, payment)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail expired payments: %w", err)
+	}
+
+	return len(expired), nil
+}
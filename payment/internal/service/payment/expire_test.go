@@ -0,0 +1,68 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+func (s *ServiceSuite) TestExpirePendingPayments() {
+	createdBefore := time.Now().Add(-time.Hour)
+
+	s.Run("publishes_payment_failed_with_timeout", func() {
+		s.SetupTest()
+
+		var messages []*model.OutboxMessage
+
+		s.repo.EXPECT().FailExpiredPayments(s.ctx, createdBefore, 10, model.DeclineCodeTimeout, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ time.Time, _ int, code model.DeclineCode, handler repository.PaymentResultHandler) ([]*model.Transaction, error) {
+				payment := &model.Transaction{
+					TransactionUUID: uuid.New(),
+					OrderUUID:       s.orderUUID,
+					UserUUID:        s.userUUID,
+					Type:            model.TransactionTypePayment,
+					Status:          model.TransactionStatusFailed,
+					PaymentMethod:   model.PaymentMethodInvestorMoney,
+					FailureCode:     code,
+				}
+
+				message, err := handler(payment)
+				if err != nil {
+					return nil, err
+				}
+
+				messages = append(messages, message)
+
+				return []*model.Transaction{payment}, nil
+			}).Once()
+
+		expired, err := s.svc.ExpirePendingPayments(s.ctx, createdBefore, 10)
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), 1, expired)
+		require.Len(s.T(), messages, 1)
+		require.Equal(s.T(), model.EventTypePaymentFailed, messages[0].EventType)
+
+		var event eventspb.PaymentFailed
+		require.NoError(s.T(), proto.Unmarshal(messages[0].Payload, &event))
+		require.Equal(s.T(), string(model.DeclineCodeTimeout), event.GetDeclineCode())
+	})
+
+	s.Run("repository_error", func() {
+		s.SetupTest()
+
+		s.repo.EXPECT().FailExpiredPayments(s.ctx, createdBefore, 10, model.DeclineCodeTimeout, mock.Anything).
+			Return(nil, errors.New("database error")).Once()
+
+		_, err := s.svc.ExpirePendingPayments(s.ctx, createdBefore, 10)
+		require.ErrorContains(s.T(), err, "failed to fail expired payments")
+	})
+}
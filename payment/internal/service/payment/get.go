@@ -0,0 +1,30 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+// GetTransaction возвращает транзакцию по UUID
+func (s *Service) GetTransaction(ctx context.Context, transactionUUID string) (*model.Transaction, error) {
+	parsedUUID, err := uuid.Parse(transactionUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid transaction_uuid %q", model.ErrInvalidTransactionRequest, transactionUUID)
+	}
+
+	transaction, err := s.transactionRepository.GetTransaction(ctx, parsedUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrTransactionNotFound) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return transaction, nil
+}
@@ -0,0 +1,80 @@
+package payment
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestGetTransaction() {
+	transactionUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440003")
+	transaction := &model.Transaction{TransactionUUID: transactionUUID, Type: model.TransactionTypePayment}
+
+	tests := []struct {
+		name            string
+		transactionUUID string
+		setupMock       func()
+		wantErr         error
+		wantErrSubstr   string
+	}{
+		{
+			name:            "success",
+			transactionUUID: transactionUUID.String(),
+			setupMock: func() {
+				s.repo.EXPECT().GetTransaction(s.ctx, transactionUUID).Return(transaction, nil).Once()
+			},
+		},
+		{
+			name:            "invalid_transaction_uuid",
+			transactionUUID: "txn-789",
+			wantErr:         model.ErrInvalidTransactionRequest,
+			wantErrSubstr:   "invalid transaction_uuid",
+		},
+		{
+			name:            "not_found",
+			transactionUUID: transactionUUID.String(),
+			setupMock: func() {
+				s.repo.EXPECT().GetTransaction(s.ctx, transactionUUID).Return(nil, model.ErrTransactionNotFound).Once()
+			},
+			wantErr:       model.ErrTransactionNotFound,
+			wantErrSubstr: "transaction not found",
+		},
+		{
+			name:            "repository_error",
+			transactionUUID: transactionUUID.String(),
+			setupMock: func() {
+				s.repo.EXPECT().GetTransaction(s.ctx, transactionUUID).Return(nil, errors.New("database error")).Once()
+			},
+			wantErrSubstr: "failed to get transaction",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+
+			if tt.setupMock != nil {
+				tt.setupMock()
+			}
+
+			got, err := s.svc.GetTransaction(s.ctx, tt.transactionUUID)
+
+			if tt.wantErrSubstr != "" {
+				require.Error(s.T(), err)
+				require.Contains(s.T(), err.Error(), tt.wantErrSubstr)
+
+				if tt.wantErr != nil {
+					require.ErrorIs(s.T(), err, tt.wantErr)
+				}
+
+				return
+			}
+
+			require.NoError(s.T(), err)
+			require.Equal(s.T(), transaction, got)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+const (
+	// defaultListPageSize размер страницы списка транзакций по умолчанию
+	defaultListPageSize = 20
+	// maxListPageSize максимальный размер страницы списка транзакций
+	maxListPageSize = 100
+)
+
+// ListTransactions возвращает страницу транзакций пользователя и/или заказа
+func (s *Service) ListTransactions(ctx context.Context, filter model.TransactionFilter, cursor *model.TransactionCursor, pageSize int) (*model.TransactionPage, error) {
+	if filter.UserUUID == nil && filter.OrderUUID == nil {
+		return nil, fmt.Errorf("%w: user_uuid or order_uuid is required", model.ErrInvalidTransactionRequest)
+	}
+
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultListPageSize
+	case pageSize > maxListPageSize:
+		pageSize = maxListPageSize
+	}
+
+	// Запрашиваем на одну транзакцию больше, чтобы понять, есть ли следующая страница
+	transactions, err := s.transactionRepository.ListTransactions(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	page := &model.TransactionPage{Transactions: transactions}
+
+	if len(transactions) > pageSize {
+		page.Transactions = transactions[:pageSize]
+		last := page.Transactions[pageSize-1]
+		page.NextCursor = &model.TransactionCursor{
+			CreatedAt:       last.CreatedAt,
+			TransactionUUID: last.TransactionUUID,
+		}
+	}
+
+	return page, nil
+}
@@ -0,0 +1,120 @@
+package payment
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestListTransactions() {
+	newTransactions := func(n int) []*model.Transaction {
+		transactions := make([]*model.Transaction, 0, n)
+		for i := range n {
+			transactions = append(transactions, &model.Transaction{
+				TransactionUUID: uuid.New(),
+				UserUUID:        s.userUUID,
+				CreatedAt:       time.Date(2025, 12, 8, 12, 0, 0, 0, time.UTC).Add(-time.Duration(i) * time.Minute),
+			})
+		}
+
+		return transactions
+	}
+
+	byUser := model.TransactionFilter{UserUUID: &s.userUUID}
+
+	tests := []struct {
+		name          string
+		filter        model.TransactionFilter
+		pageSize      int
+		setupMock     func()
+		wantLen       int
+		wantNext      bool
+		wantErr       error
+		wantErrSubstr string
+	}{
+		{
+			name:     "last_page",
+			filter:   byUser,
+			pageSize: 2,
+			setupMock: func() {
+				s.repo.EXPECT().ListTransactions(s.ctx, byUser, (*model.TransactionCursor)(nil), 3).Return(newTransactions(2), nil).Once()
+			},
+			wantLen: 2,
+		},
+		{
+			name:     "has_next_page",
+			filter:   byUser,
+			pageSize: 2,
+			setupMock: func() {
+				s.repo.EXPECT().ListTransactions(s.ctx, byUser, (*model.TransactionCursor)(nil), 3).Return(newTransactions(3), nil).Once()
+			},
+			wantLen:  2,
+			wantNext: true,
+		},
+		{
+			name:     "default_page_size",
+			filter:   model.TransactionFilter{OrderUUID: &s.orderUUID},
+			pageSize: 0,
+			setupMock: func() {
+				s.repo.EXPECT().ListTransactions(s.ctx, model.TransactionFilter{OrderUUID: &s.orderUUID}, (*model.TransactionCursor)(nil), defaultListPageSize+1).
+					Return(newTransactions(1), nil).Once()
+			},
+			wantLen: 1,
+		},
+		{
+			name:          "empty_filter",
+			filter:        model.TransactionFilter{},
+			wantErr:       model.ErrInvalidTransactionRequest,
+			wantErrSubstr: "user_uuid or order_uuid is required",
+		},
+		{
+			name:     "repository_error",
+			filter:   byUser,
+			pageSize: 2,
+			setupMock: func() {
+				s.repo.EXPECT().ListTransactions(s.ctx, byUser, (*model.TransactionCursor)(nil), 3).Return(nil, errors.New("database error")).Once()
+			},
+			wantErrSubstr: "failed to list transactions",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+
+			if tt.setupMock != nil {
+				tt.setupMock()
+			}
+
+			page, err := s.svc.ListTransactions(s.ctx, tt.filter, nil, tt.pageSize)
+
+			if tt.wantErrSubstr != "" {
+				require.Error(s.T(), err)
+				require.Contains(s.T(), err.Error(), tt.wantErrSubstr)
+
+				if tt.wantErr != nil {
+					require.ErrorIs(s.T(), err, tt.wantErr)
+				}
+
+				return
+			}
+
+			require.NoError(s.T(), err)
+			require.Len(s.T(), page.Transactions, tt.wantLen)
+
+			if !tt.wantNext {
+				require.Nil(s.T(), page.NextCursor)
+				return
+			}
+
+			last := page.Transactions[len(page.Transactions)-1]
+			require.NotNil(s.T(), page.NextCursor)
+			require.Equal(s.T(), last.TransactionUUID, page.NextCursor.TransactionUUID)
+			require.Equal(s.T(), last.CreatedAt, page.NextCursor.CreatedAt)
+		})
+	}
+}
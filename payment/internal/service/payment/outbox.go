@@ -0,0 +1,71 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/converter/kafka/encoder"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
+)
+
+// outboxTraceContext сохраняет контекст трассировки запроса вместе с событием,
+// чтобы outbox relay опубликовал его в том же трейсе
+func outboxTraceContext(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	tracing.Inject(ctx, carrier)
+
+	return carrier
+}
+
+// newPaymentResultOutboxMessage формирует outbox-сообщение с итогом асинхронной оплаты:
+// PaymentCompleted для проведенной оплаты и PaymentFailed для отказа
+func newPaymentResultOutboxMessage(ctx context.Context, payment *model.Transaction) (*model.OutboxMessage, error) {
+	eventUUID := uuid.New()
+
+	var (
+		eventType string
+		payload   []byte
+		err       error
+	)
+
+	if payment.Status == model.TransactionStatusSucceeded {
+		eventType = model.EventTypePaymentCompleted
+		payload, err = encoder.EncodePaymentCompleted(model.PaymentCompleted{
+			EventUUID:       eventUUID,
+			TransactionUUID: payment.TransactionUUID,
+			OrderUUID:       payment.OrderUUID,
+			UserUUID:        payment.UserUUID,
+			PaymentMethod:   payment.PaymentMethod,
+			Amount:          payment.Amount,
+			Currency:        payment.Currency,
+		})
+	} else {
+		eventType = model.EventTypePaymentFailed
+		payload, err = encoder.EncodePaymentFailed(model.PaymentFailed{
+			EventUUID:       eventUUID,
+			TransactionUUID: payment.TransactionUUID,
+			OrderUUID:       payment.OrderUUID,
+			UserUUID:        payment.UserUUID,
+			PaymentMethod:   payment.PaymentMethod,
+			DeclineCode:     payment.FailureCode,
+		})
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", eventType, err)
+	}
+
+	// Ключ - UUID заказа, чтобы события одного заказа попадали в одну партицию
+	return &model.OutboxMessage{
+		UUID:          eventUUID,
+		AggregateUUID: payment.TransactionUUID,
+		EventType:     eventType,
+		Key:           []byte(payment.OrderUUID.String()),
+		Payload:       payload,
+		TraceContext:  outboxTraceContext(ctx),
+	}, nil
+}
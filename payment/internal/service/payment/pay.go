@@ -2,37 +2,229 @@ package payment
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/radiophysiker/microservices-homework/payment/internal/model"
 	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
-	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
 )
 
-// PayOrder проводит оплату заказа
-func (s *Service) PayOrder(ctx context.Context, userUUID, orderUUID string, paymentMethod pb.PaymentMethod) (string, error) {
-	if userUUID == "" {
-		return "", fmt.Errorf("%w: user_uuid is empty", model.ErrInvalidPaymentRequest)
+// currencyCodePattern - формат кода валюты по ISO 4217
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// PayOrder сохраняет оплату в журнал, списывает средства через провайдера способа оплаты
+// и сохраняет итог списания.
+// Заказ оплачивается не более одного раза: повторный запрос с теми же параметрами
+// возвращает существующую оплату, а запрос с другими - ErrOrderAlreadyPaid.
+// Новый платеж до списания проверяется правилами риска.
+// Асинхронная оплата сохраняется в статусе PENDING и завершается callback'ом провайдера
+// или по таймауту. Сумма amount задается в минимальных единицах валюты currency.
+// Токен карты cardToken передается провайдеру оплаты картой и не сохраняется в журнале
+func (s *Service) PayOrder(ctx context.Context, userUUID, orderUUID string, paymentMethod model.PaymentMethod, amount int64, currency, cardToken string) (*model.Transaction, error) {
+	parsedUserUUID, err := uuid.Parse(userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid user_uuid %q", model.ErrInvalidPaymentRequest, userUUID)
+	}
+
+	parsedOrderUUID, err := uuid.Parse(orderUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid order_uuid %q", model.ErrInvalidPaymentRequest, orderUUID)
+	}
+
+	if paymentMethod == model.PaymentMethodUnspecified {
+		return nil, fmt.Errorf("%w: unspecified payment method", model.ErrInvalidPaymentRequest)
+	}
+
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w: amount must be positive, got %d", model.ErrInvalidPaymentRequest, amount)
 	}
 
-	if orderUUID == "" {
-		return "", fmt.Errorf("%w: order_uuid is empty", model.ErrInvalidPaymentRequest)
+	if !currencyCodePattern.MatchString(currency) {
+		return nil, fmt.Errorf("%w: invalid currency %q", model.ErrInvalidPaymentRequest, currency)
 	}
 
-	if paymentMethod == pb.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED {
-		return "", fmt.Errorf("%w: unspecified payment method", model.ErrInvalidPaymentRequest)
+	paymentProvider, ok := s.providers[paymentMethod]
+	if !ok {
+		return nil, fmt.Errorf("%w: payment method %s is not supported", model.ErrInvalidPaymentRequest, paymentMethod)
 	}
 
-	transactionUUID := uuid.New().String()
+	now := time.Now()
+	transaction := &model.Transaction{
+		TransactionUUID: uuid.New(),
+		OrderUUID:       parsedOrderUUID,
+		UserUUID:        parsedUserUUID,
+		Type:            model.TransactionTypePayment,
+		PaymentMethod:   paymentMethod,
+		Amount:          amount,
+		Currency:        currency,
+		CardToken:       cardToken,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	// Повторный запрос получает существующую оплату без второго списания
+	existing, err := s.transactionRepository.GetOrderPayment(ctx, parsedOrderUUID)
+	if err == nil {
+		return s.replayPayment(ctx, existing, transaction)
+	}
+
+	if !errors.Is(err, model.ErrTransactionNotFound) {
+		return nil, fmt.Errorf("failed to get existing order payment: %w", err)
+	}
+
+	// Проверка правил риска и сохранение оплаты в статусе PENDING выполняются под блокировкой
+	// пользователя, чтобы параллельные оплаты не прошли лимиты по одним и тем же счетчикам.
+	// Оплата, сохраненная параллельным запросом до блокировки, возвращается без второго списания
+	var checkErr error
+
+	existing, err = s.transactionRepository.ReservePayment(ctx, transaction, func(ctx context.Context, payment *model.Transaction) error {
+		checkErr = s.checkRisk(ctx, payment)
+
+		return checkErr
+	})
+	if checkErr != nil {
+		return nil, checkErr
+	}
+
+	if errors.Is(err, model.ErrOrderAlreadyPaid) {
+		return s.replayConcurrentPayment(ctx, transaction)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to save payment transaction: %w", err)
+	}
+
+	if existing != nil {
+		return s.replayPayment(ctx, existing, transaction)
+	}
+
+	// Провайдер вызывается вне транзакции журнала: сохраненная оплата уже закрывает заказ
+	// от повторного списания, а итог фиксируется отдельной транзакцией
+	status, err := paymentProvider.Charge(ctx, transaction)
+	if err != nil {
+		return nil, s.failCharge(ctx, transaction, err)
+	}
+
+	message := "Оплата ожидает подтверждения провайдера"
+
+	if status == model.TransactionStatusPending {
+		// Callback с итогом может прийти только по транзакции, которая уже есть в журнале
+		paymentProvider.Accept(ctx, transaction)
+	} else {
+		message = "Оплата прошла успешно"
+
+		if err := s.finalizeCharge(ctx, transaction, status, ""); err != nil {
+			logger.Error(ctx, "Списание проведено, но итог оплаты не сохранен, требуется сверка",
+				zap.String("order_uuid", orderUUID),
+				zap.String("transaction_uuid", transaction.TransactionUUID.String()),
+				zap.Error(err))
+
+			return nil, fmt.Errorf("failed to save payment transaction: %w", err)
+		}
+	}
 
-	logger.Info(ctx, "Оплата прошла успешно",
+	logger.Info(ctx, message,
 		zap.String("user_uuid", userUUID),
 		zap.String("order_uuid", orderUUID),
 		zap.String("payment_method", paymentMethod.String()),
-		zap.String("transaction_uuid", transactionUUID))
+		zap.Int64("amount", amount),
+		zap.String("currency", currency),
+		zap.String("transaction_uuid", transaction.TransactionUUID.String()))
+
+	return transaction, nil
+}
+
+// checkRisk проверяет новый платеж правилами риска
+func (s *Service) checkRisk(ctx context.Context, payment *model.Transaction) error {
+	err := s.riskService.Check(ctx, payment)
+	if errors.Is(err, model.ErrPaymentRejected) {
+		logger.Info(ctx, "Платеж отклонен правилами риска",
+			zap.String("user_uuid", payment.UserUUID.String()),
+			zap.String("order_uuid", payment.OrderUUID.String()),
+			zap.String("payment_method", payment.PaymentMethod.String()),
+			zap.Int64("amount", payment.Amount),
+			zap.String("currency", payment.Currency),
+			zap.Error(err))
+
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to check risk rules: %w", err)
+	}
+
+	return nil
+}
+
+// failCharge обрабатывает ошибку списания сохраненной оплаты.
+// Отказ провайдера сохраняется в статусе FAILED с кодом причины, поэтому заказ можно оплатить
+// снова, а правила частоты учитывают отклоненные попытки. При другой ошибке итог списания
+// неизвестен: оплата остается в PENDING и завершается по таймауту, до этого заказ повторно не списывается
+func (s *Service) failCharge(ctx context.Context, payment *model.Transaction, chargeErr error) error {
+	var declined *model.PaymentDeclinedError
+	if !errors.As(chargeErr, &declined) {
+		logger.Warn(ctx, "Итог списания неизвестен, оплата завершится по таймауту",
+			zap.String("order_uuid", payment.OrderUUID.String()),
+			zap.String("transaction_uuid", payment.TransactionUUID.String()),
+			zap.Error(chargeErr))
+
+		return fmt.Errorf("failed to charge payment: %w", chargeErr)
+	}
+
+	if err := s.finalizeCharge(ctx, payment, model.TransactionStatusFailed, declined.Code); err != nil {
+		logger.Warn(ctx, "Не удалось сохранить отказ провайдера, оплата завершится по таймауту",
+			zap.String("order_uuid", payment.OrderUUID.String()),
+			zap.String("transaction_uuid", payment.TransactionUUID.String()),
+			zap.Error(err))
+	}
+
+	return fmt.Errorf("failed to charge payment: %w", chargeErr)
+}
+
+// finalizeCharge сохраняет итог синхронного списания оплаты в статусе PENDING.
+// Событие в outbox не пишется: итог возвращается вызывающему сервису в ответе
+func (s *Service) finalizeCharge(ctx context.Context, payment *model.Transaction, status model.TransactionStatus, declineCode model.DeclineCode) error {
+	if _, err := s.transactionRepository.FinalizePayment(ctx, payment.TransactionUUID, status, declineCode, func(*model.Transaction) (*model.OutboxMessage, error) {
+		return nil, nil
+	}); err != nil {
+		return err
+	}
+
+	payment.Status = status
+	payment.FailureCode = declineCode
+
+	return nil
+}
+
+// replayPayment возвращает существующую оплату заказа, если она совпадает с повторным запросом
+func (s *Service) replayPayment(ctx context.Context, existing, requested *model.Transaction) (*model.Transaction, error) {
+	if existing.UserUUID != requested.UserUUID ||
+		existing.PaymentMethod != requested.PaymentMethod ||
+		existing.Amount != requested.Amount ||
+		existing.Currency != requested.Currency {
+		return nil, fmt.Errorf("%w: order %s paid by transaction %s",
+			model.ErrOrderAlreadyPaid, requested.OrderUUID, existing.TransactionUUID)
+	}
+
+	logger.Info(ctx, "Повторный запрос оплаты заказа, возвращаем существующую транзакцию",
+		zap.String("order_uuid", requested.OrderUUID.String()),
+		zap.String("transaction_uuid", existing.TransactionUUID.String()))
+
+	return existing, nil
+}
+
+// replayConcurrentPayment обрабатывает оплату заказа, сохраненную в обход блокировки.
+// Провайдер по requested еще не вызывался, поэтому возвращается существующая оплата
+func (s *Service) replayConcurrentPayment(ctx context.Context, requested *model.Transaction) (*model.Transaction, error) {
+	existing, err := s.transactionRepository.GetOrderPayment(ctx, requested.OrderUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing order payment: %w", err)
+	}
 
-	return transactionUUID, nil
+	return s.replayPayment(ctx, existing, requested)
 }
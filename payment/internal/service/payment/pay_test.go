@@ -2,71 +2,465 @@ package payment
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/radiophysiker/microservices-homework/payment/internal/model"
-	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider"
+	providerMocks "github.com/radiophysiker/microservices-homework/payment/internal/provider/mocks"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	repositoryMocks "github.com/radiophysiker/microservices-homework/payment/internal/repository/mocks"
+	serviceMocks "github.com/radiophysiker/microservices-homework/payment/internal/service/mocks"
 )
 
 type ServiceSuite struct {
 	suite.Suite
-	svc *Service
-	ctx context.Context
+	repo     *repositoryMocks.MockTransactionRepository
+	provider *providerMocks.MockProvider
+	risk     *serviceMocks.MockRiskService
+	svc      *Service
+	ctx      context.Context
+
+	userUUID  uuid.UUID
+	orderUUID uuid.UUID
 }
 
 func (s *ServiceSuite) SetupTest() {
-	s.svc = NewService()
+	s.repo = repositoryMocks.NewMockTransactionRepository(s.T())
+	s.provider = providerMocks.NewMockProvider(s.T())
+	s.risk = serviceMocks.NewMockRiskService(s.T())
+	s.svc = NewService(s.repo, map[model.PaymentMethod]provider.Provider{
+		model.PaymentMethodCard:          s.provider,
+		model.PaymentMethodSBP:           s.provider,
+		model.PaymentMethodCreditCard:    s.provider,
+		model.PaymentMethodInvestorMoney: s.provider,
+	}, s.risk)
 	s.ctx = context.Background()
+
+	s.userUUID = uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")
+	s.orderUUID = uuid.MustParse("550e8400-e29b-41d4-a716-446655440002")
 }
 
+const (
+	// paymentAmount - сумма оплаты заказа в копейках
+	paymentAmount = 150_000
+	// paymentCardToken - токен карты, переданный в запросе оплаты
+	paymentCardToken = "tok_4242"
+)
+
 func (s *ServiceSuite) TestPayOrder() {
+	userUUID := s.userUUID.String()
+	orderUUID := s.orderUUID.String()
+	existingPaymentUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440005")
+
+	existingPayment := func(userUUID uuid.UUID, method model.PaymentMethod) *model.Transaction {
+		return &model.Transaction{
+			TransactionUUID: existingPaymentUUID,
+			OrderUUID:       s.orderUUID,
+			UserUUID:        userUUID,
+			Type:            model.TransactionTypePayment,
+			Status:          model.TransactionStatusSucceeded,
+			PaymentMethod:   method,
+			Amount:          paymentAmount,
+			Currency:        "RUB",
+		}
+	}
+
 	tests := []struct {
 		name          string
 		userUUID      string
 		orderUUID     string
-		method        pb.PaymentMethod
+		method        model.PaymentMethod
+		amount        int64
+		currency      string
+		setupMock     func()
+		wantID        string
+		wantStatus    model.TransactionStatus
 		wantErr       error
 		wantErrSubstr string
 	}{
-		{name: "success_card", userUUID: "user-123", orderUUID: "order-456", method: pb.PaymentMethod_PAYMENT_METHOD_CARD},
-		{name: "success_sbp", userUUID: "user-789", orderUUID: "order-012", method: pb.PaymentMethod_PAYMENT_METHOD_SBP},
-		{name: "success_credit_card", userUUID: "user-111", orderUUID: "order-222", method: pb.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD},
-		{name: "success_investor_money", userUUID: "user-333", orderUUID: "order-444", method: pb.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY},
-
-		{name: "invalid_user_uuid_empty", userUUID: "", orderUUID: "order-456", method: pb.PaymentMethod_PAYMENT_METHOD_CARD, wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "user_uuid is empty"},
-		{name: "invalid_order_uuid_empty", userUUID: "user-123", orderUUID: "", method: pb.PaymentMethod_PAYMENT_METHOD_CARD, wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "order_uuid is empty"},
-		{name: "invalid_payment_method_unspecified", userUUID: "user-123", orderUUID: "order-456", method: pb.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED, wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "unspecified payment method"},
+		{name: "success_card", userUUID: userUUID, orderUUID: orderUUID, method: model.PaymentMethodCard, amount: paymentAmount, currency: "RUB", setupMock: s.expectPaymentSaved(model.PaymentMethodCard, model.TransactionStatusSucceeded), wantStatus: model.TransactionStatusSucceeded},
+		{name: "success_sbp", userUUID: userUUID, orderUUID: orderUUID, method: model.PaymentMethodSBP, amount: paymentAmount, currency: "RUB", setupMock: s.expectPaymentSaved(model.PaymentMethodSBP, model.TransactionStatusSucceeded), wantStatus: model.TransactionStatusSucceeded},
+		{name: "success_credit_card", userUUID: userUUID, orderUUID: orderUUID, method: model.PaymentMethodCreditCard, amount: paymentAmount, currency: "RUB", setupMock: s.expectPaymentSaved(model.PaymentMethodCreditCard, model.TransactionStatusSucceeded), wantStatus: model.TransactionStatusSucceeded},
+		{name: "success_investor_money", userUUID: userUUID, orderUUID: orderUUID, method: model.PaymentMethodInvestorMoney, amount: paymentAmount, currency: "RUB", setupMock: s.expectPaymentSaved(model.PaymentMethodInvestorMoney, model.TransactionStatusSucceeded), wantStatus: model.TransactionStatusSucceeded},
+		{
+			name:       "pending_async_payment",
+			userUUID:   userUUID,
+			orderUUID:  orderUUID,
+			method:     model.PaymentMethodSBP,
+			amount:     paymentAmount,
+			currency:   "RUB",
+			setupMock:  s.expectPaymentSaved(model.PaymentMethodSBP, model.TransactionStatusPending),
+			wantStatus: model.TransactionStatusPending,
+		},
+
+		{name: "invalid_user_uuid_empty", userUUID: "", orderUUID: orderUUID, method: model.PaymentMethodCard, amount: paymentAmount, currency: "RUB", wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "invalid user_uuid"},
+		{name: "invalid_user_uuid_format", userUUID: "user-123", orderUUID: orderUUID, method: model.PaymentMethodCard, amount: paymentAmount, currency: "RUB", wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "invalid user_uuid"},
+		{name: "invalid_order_uuid_empty", userUUID: userUUID, orderUUID: "", method: model.PaymentMethodCard, amount: paymentAmount, currency: "RUB", wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "invalid order_uuid"},
+		{name: "invalid_payment_method_unspecified", userUUID: userUUID, orderUUID: orderUUID, method: model.PaymentMethodUnspecified, amount: paymentAmount, currency: "RUB", wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "unspecified payment method"},
+		{name: "invalid_amount_zero", userUUID: userUUID, orderUUID: orderUUID, method: model.PaymentMethodCard, amount: 0, currency: "RUB", wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "amount must be positive"},
+		{name: "invalid_amount_negative", userUUID: userUUID, orderUUID: orderUUID, method: model.PaymentMethodCard, amount: -100, currency: "RUB", wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "amount must be positive"},
+		{name: "invalid_currency_empty", userUUID: userUUID, orderUUID: orderUUID, method: model.PaymentMethodCard, amount: paymentAmount, currency: "", wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "invalid currency"},
+		{name: "invalid_currency_lowercase", userUUID: userUUID, orderUUID: orderUUID, method: model.PaymentMethodCard, amount: paymentAmount, currency: "rub", wantErr: model.ErrInvalidPaymentRequest, wantErrSubstr: "invalid currency"},
+		{
+			name:      "repository_error",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				// Оплата не сохранена, поэтому провайдер не вызывается
+				s.expectNewPayment(errors.New("database error"))
+			},
+			wantErrSubstr: "failed to save payment transaction",
+		},
+		{
+			name:      "finalize_error",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.expectNewPayment(nil)
+				s.provider.EXPECT().Charge(s.ctx, mock.AnythingOfType("*model.Transaction")).Return(model.TransactionStatusSucceeded, nil).Once()
+				s.expectFinalizePayment(model.TransactionStatusSucceeded, "", errors.New("database error"))
+			},
+			wantErrSubstr: "failed to save payment transaction",
+		},
+		{
+			name:          "unsupported_payment_method",
+			userUUID:      userUUID,
+			orderUUID:     orderUUID,
+			method:        model.PaymentMethod(42),
+			amount:        paymentAmount,
+			currency:      "RUB",
+			wantErr:       model.ErrInvalidPaymentRequest,
+			wantErrSubstr: "is not supported",
+		},
+		{
+			name:      "provider_declined",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCreditCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.expectNewPayment(nil)
+				s.provider.EXPECT().Charge(s.ctx, mock.AnythingOfType("*model.Transaction")).
+					Return(model.TransactionStatusUnspecified, &model.PaymentDeclinedError{PaymentMethod: model.PaymentMethodCreditCard, Code: model.DeclineCodeLimitExceeded}).Once()
+				// Отказ сохраняется, чтобы его учитывали правила частоты
+				s.expectFinalizePayment(model.TransactionStatusFailed, model.DeclineCodeLimitExceeded, nil)
+			},
+			wantErr:       model.ErrPaymentDeclined,
+			wantErrSubstr: string(model.DeclineCodeLimitExceeded),
+		},
+		{
+			name:      "provider_error",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.expectNewPayment(nil)
+				// Итог списания неизвестен: оплата остается в PENDING до таймаута
+				s.provider.EXPECT().Charge(s.ctx, mock.AnythingOfType("*model.Transaction")).Return(model.TransactionStatusUnspecified, context.DeadlineExceeded).Once()
+			},
+			wantErr:       context.DeadlineExceeded,
+			wantErrSubstr: "failed to charge payment",
+		},
+		{
+			name:      "rejected_by_risk_rules",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.expectOrderNotPaid()
+				s.expectReservePayment(nil)
+				// Отклоненный правилами платеж не доходит до провайдера
+				s.risk.EXPECT().Check(s.ctx, mock.AnythingOfType("*model.Transaction")).
+					Return(&model.PaymentRejectedError{Reason: model.RiskReasonDailyLimitExceeded, Detail: "daily limit"}).Once()
+			},
+			wantErr:       model.ErrPaymentRejected,
+			wantErrSubstr: string(model.RiskReasonDailyLimitExceeded),
+		},
+		{
+			name:      "risk_check_error",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.expectOrderNotPaid()
+				s.expectReservePayment(nil)
+				s.risk.EXPECT().Check(s.ctx, mock.AnythingOfType("*model.Transaction")).Return(errors.New("database error")).Once()
+			},
+			wantErrSubstr: "failed to check risk rules",
+		},
+		{
+			name:      "concurrent_payment_saved_first",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.expectNewPayment(model.ErrOrderAlreadyPaid)
+				s.repo.EXPECT().GetOrderPayment(s.ctx, s.orderUUID).Return(existingPayment(s.userUUID, model.PaymentMethodCard), nil).Once()
+			},
+			wantID: existingPaymentUUID.String(),
+		},
+		{
+			name:      "concurrent_payment_found_under_lock",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.expectOrderNotPaid()
+				// Оплата сохранена параллельным запросом: repository возвращает ее, не вызывая check
+				s.repo.EXPECT().ReservePayment(s.ctx, mock.AnythingOfType("*model.Transaction"), mock.Anything).
+					Return(existingPayment(s.userUUID, model.PaymentMethodCard), nil).Once()
+			},
+			wantID: existingPaymentUUID.String(),
+		},
+		{
+			name:      "concurrent_payment_found_under_lock_differs",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodSBP,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.expectOrderNotPaid()
+				s.repo.EXPECT().ReservePayment(s.ctx, mock.AnythingOfType("*model.Transaction"), mock.Anything).
+					Return(existingPayment(s.userUUID, model.PaymentMethodCard), nil).Once()
+			},
+			wantErr:       model.ErrOrderAlreadyPaid,
+			wantErrSubstr: existingPaymentUUID.String(),
+		},
+		{
+			name:      "replay_returns_existing_payment",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.repo.EXPECT().GetOrderPayment(s.ctx, s.orderUUID).Return(existingPayment(s.userUUID, model.PaymentMethodCard), nil).Once()
+			},
+			wantID: existingPaymentUUID.String(),
+		},
+		{
+			name:      "replay_returns_pending_payment",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodSBP,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				payment := existingPayment(s.userUUID, model.PaymentMethodSBP)
+				payment.Status = model.TransactionStatusPending
+
+				s.repo.EXPECT().GetOrderPayment(s.ctx, s.orderUUID).Return(payment, nil).Once()
+			},
+			wantID:     existingPaymentUUID.String(),
+			wantStatus: model.TransactionStatusPending,
+		},
+		{
+			name:      "replay_returns_refunded_payment",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodSBP,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				payment := existingPayment(s.userUUID, model.PaymentMethodSBP)
+				payment.Status = model.TransactionStatusRefunded
+
+				s.repo.EXPECT().GetOrderPayment(s.ctx, s.orderUUID).Return(payment, nil).Once()
+			},
+			wantID: existingPaymentUUID.String(),
+		},
+		{
+			name:      "replay_with_other_payment_method",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodSBP,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.repo.EXPECT().GetOrderPayment(s.ctx, s.orderUUID).Return(existingPayment(s.userUUID, model.PaymentMethodCard), nil).Once()
+			},
+			wantErr:       model.ErrOrderAlreadyPaid,
+			wantErrSubstr: existingPaymentUUID.String(),
+		},
+		{
+			name:      "replay_with_other_amount",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount + 100,
+			currency:  "RUB",
+			setupMock: func() {
+				s.repo.EXPECT().GetOrderPayment(s.ctx, s.orderUUID).Return(existingPayment(s.userUUID, model.PaymentMethodCard), nil).Once()
+			},
+			wantErr:       model.ErrOrderAlreadyPaid,
+			wantErrSubstr: "order already paid",
+		},
+		{
+			name:      "replay_by_other_user",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				otherUserUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440006")
+
+				s.repo.EXPECT().GetOrderPayment(s.ctx, s.orderUUID).Return(existingPayment(otherUserUUID, model.PaymentMethodCard), nil).Once()
+			},
+			wantErr:       model.ErrOrderAlreadyPaid,
+			wantErrSubstr: "order already paid",
+		},
+		{
+			name:      "replay_existing_payment_lookup_error",
+			userUUID:  userUUID,
+			orderUUID: orderUUID,
+			method:    model.PaymentMethodCard,
+			amount:    paymentAmount,
+			currency:  "RUB",
+			setupMock: func() {
+				s.repo.EXPECT().GetOrderPayment(s.ctx, s.orderUUID).Return(nil, errors.New("database error")).Once()
+			},
+			wantErrSubstr: "failed to get existing order payment",
+		},
 	}
 
 	for _, tt := range tests {
-		tt := tt
 		s.Run(tt.name, func() {
-			id, err := s.svc.PayOrder(s.ctx, tt.userUUID, tt.orderUUID, tt.method)
+			s.SetupTest()
 
-			if tt.wantErr != nil {
+			if tt.setupMock != nil {
+				tt.setupMock()
+			}
+
+			transaction, err := s.svc.PayOrder(s.ctx, tt.userUUID, tt.orderUUID, tt.method, tt.amount, tt.currency, paymentCardToken)
+
+			if tt.wantErrSubstr != "" {
 				require.Error(s.T(), err)
-				require.ErrorIs(s.T(), err, tt.wantErr)
+				require.Contains(s.T(), err.Error(), tt.wantErrSubstr)
 
-				if tt.wantErrSubstr != "" {
-					require.Contains(s.T(), err.Error(), tt.wantErrSubstr)
+				if tt.wantErr != nil {
+					require.ErrorIs(s.T(), err, tt.wantErr)
 				}
 
 				return
 			}
 
 			require.NoError(s.T(), err)
-			require.NotEmpty(s.T(), id)
 
-			_, perr := uuid.Parse(id)
-			require.NoError(s.T(), perr)
+			if tt.wantStatus != model.TransactionStatusUnspecified {
+				require.Equal(s.T(), tt.wantStatus, transaction.Status)
+			}
+
+			if tt.wantID != "" {
+				require.Equal(s.T(), tt.wantID, transaction.TransactionUUID.String())
+				return
+			}
+
+			require.NotEqual(s.T(), uuid.Nil, transaction.TransactionUUID)
 		})
 	}
 }
 
+// expectPaymentSaved ожидает списание через провайдера и сохранение оплаты заказа выбранным способом
+// в статусе, который вернул провайдер
+func (s *ServiceSuite) expectPaymentSaved(method model.PaymentMethod, status model.TransactionStatus) func() {
+	return func() {
+		matchPayment := func(tx *model.Transaction) bool {
+			return tx.UserUUID == s.userUUID &&
+				tx.OrderUUID == s.orderUUID &&
+				tx.Type == model.TransactionTypePayment &&
+				tx.PaymentMethod == method &&
+				tx.Amount == paymentAmount &&
+				tx.Currency == "RUB" &&
+				tx.CardToken == paymentCardToken
+		}
+
+		s.expectNewPayment(nil)
+		s.provider.EXPECT().Charge(s.ctx, mock.MatchedBy(matchPayment)).Return(status, nil).Once()
+
+		if status == model.TransactionStatusPending {
+			s.provider.EXPECT().Accept(s.ctx, mock.MatchedBy(matchPayment)).Once()
+			return
+		}
+
+		s.expectFinalizePayment(status, "", nil)
+	}
+}
+
+// expectOrderNotPaid ожидает проверку, что у заказа еще нет оплаты
+func (s *ServiceSuite) expectOrderNotPaid() {
+	s.repo.EXPECT().GetOrderPayment(s.ctx, s.orderUUID).Return(nil, model.ErrTransactionNotFound).Once()
+}
+
+// expectNewPayment ожидает новую оплату заказа, пропущенную правилами риска.
+// Сохранение оплаты завершается ошибкой saveErr
+func (s *ServiceSuite) expectNewPayment(saveErr error) {
+	s.expectOrderNotPaid()
+	s.expectReservePayment(saveErr)
+	s.risk.EXPECT().Check(s.ctx, mock.AnythingOfType("*model.Transaction")).Return(nil).Once()
+}
+
+// expectReservePayment ожидает сохранение оплаты до списания: repository вызывает check под блокировкой
+// пользователя и, если check прошел, сохраняет оплату в статусе PENDING или возвращает saveErr
+func (s *ServiceSuite) expectReservePayment(saveErr error) {
+	s.repo.EXPECT().ReservePayment(s.ctx, mock.AnythingOfType("*model.Transaction"), mock.Anything).
+		RunAndReturn(func(ctx context.Context, payment *model.Transaction, check repository.PaymentCheck) (*model.Transaction, error) {
+			if err := check(ctx, payment); err != nil {
+				return nil, err
+			}
+
+			if saveErr != nil {
+				return nil, saveErr
+			}
+
+			payment.Status = model.TransactionStatusPending
+
+			return nil, nil
+		}).Once()
+}
+
+// expectFinalizePayment ожидает сохранение итога синхронного списания без события в outbox
+func (s *ServiceSuite) expectFinalizePayment(status model.TransactionStatus, declineCode model.DeclineCode, finalizeErr error) {
+	s.repo.EXPECT().FinalizePayment(s.ctx, mock.AnythingOfType("uuid.UUID"), status, declineCode, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ uuid.UUID, _ model.TransactionStatus, _ model.DeclineCode, handler repository.PaymentResultHandler) (*model.Transaction, error) {
+			if finalizeErr != nil {
+				return nil, finalizeErr
+			}
+
+			payment := &model.Transaction{Status: status, FailureCode: declineCode}
+
+			message, err := handler(payment)
+			s.Require().NoError(err)
+			s.Require().Nil(message)
+
+			return payment, nil
+		}).Once()
+}
+
 func TestServiceSuite(t *testing.T) {
 	suite.Run(t, new(ServiceSuite))
 }
@@ -0,0 +1,83 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// RefundPayment возвращает средства по транзакции оплаты заказа.
+// Повторный возврат той же оплаты возвращает UUID уже проведенного возврата
+func (s *Service) RefundPayment(ctx context.Context, userUUID, orderUUID, transactionUUID string) (string, error) {
+	parsedUserUUID, err := uuid.Parse(userUUID)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid user_uuid %q", model.ErrInvalidRefundRequest, userUUID)
+	}
+
+	parsedOrderUUID, err := uuid.Parse(orderUUID)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid order_uuid %q", model.ErrInvalidRefundRequest, orderUUID)
+	}
+
+	parsedTransactionUUID, err := uuid.Parse(transactionUUID)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid transaction_uuid %q", model.ErrInvalidRefundRequest, transactionUUID)
+	}
+
+	payment, err := s.transactionRepository.GetTransaction(ctx, parsedTransactionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrTransactionNotFound) {
+			return "", err
+		}
+
+		return "", fmt.Errorf("failed to get payment transaction: %w", err)
+	}
+
+	if payment.Type != model.TransactionTypePayment {
+		return "", fmt.Errorf("%w: transaction %s is not a payment", model.ErrInvalidRefundRequest, transactionUUID)
+	}
+
+	if payment.OrderUUID != parsedOrderUUID || payment.UserUUID != parsedUserUUID {
+		return "", fmt.Errorf("%w: transaction %s belongs to another order or user", model.ErrInvalidRefundRequest, transactionUUID)
+	}
+
+	// Вернуть можно только проведенную оплату: по оплатам в статусах PENDING и FAILED средства не списаны
+	if payment.Status != model.TransactionStatusSucceeded && payment.Status != model.TransactionStatusRefunded {
+		return "", fmt.Errorf("%w: payment %s is %s", model.ErrInvalidRefundRequest, transactionUUID, payment.Status)
+	}
+
+	now := time.Now()
+	refund := &model.Transaction{
+		TransactionUUID:       uuid.New(),
+		OrderUUID:             payment.OrderUUID,
+		UserUUID:              payment.UserUUID,
+		Type:                  model.TransactionTypeRefund,
+		Status:                model.TransactionStatusSucceeded,
+		PaymentMethod:         payment.PaymentMethod,
+		Amount:                payment.Amount,
+		Currency:              payment.Currency,
+		ParentTransactionUUID: &payment.TransactionUUID,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+
+	refund, err = s.transactionRepository.RefundTransaction(ctx, payment.TransactionUUID, refund)
+	if err != nil {
+		return "", fmt.Errorf("failed to save refund transaction: %w", err)
+	}
+
+	logger.Info(ctx, "Возврат средств выполнен",
+		zap.String("user_uuid", userUUID),
+		zap.String("order_uuid", orderUUID),
+		zap.String("transaction_uuid", transactionUUID),
+		zap.String("refund_uuid", refund.TransactionUUID.String()))
+
+	return refund.TransactionUUID.String(), nil
+}
@@ -0,0 +1,169 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestRefundPayment() {
+	paymentUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440003")
+	existingRefundUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440004")
+
+	payment := func() *model.Transaction {
+		return &model.Transaction{
+			TransactionUUID: paymentUUID,
+			OrderUUID:       s.orderUUID,
+			UserUUID:        s.userUUID,
+			Type:            model.TransactionTypePayment,
+			Status:          model.TransactionStatusSucceeded,
+			PaymentMethod:   model.PaymentMethodCard,
+			Amount:          150,
+			Currency:        "RUB",
+		}
+	}
+
+	tests := []struct {
+		name            string
+		userUUID        string
+		orderUUID       string
+		transactionUUID string
+		setupMock       func()
+		wantRefundUUID  *uuid.UUID
+		wantErr         error
+		wantErrSubstr   string
+	}{
+		{
+			name:            "success",
+			userUUID:        s.userUUID.String(),
+			orderUUID:       s.orderUUID.String(),
+			transactionUUID: paymentUUID.String(),
+			setupMock: func() {
+				s.repo.EXPECT().GetTransaction(s.ctx, paymentUUID).Return(payment(), nil).Once()
+				s.repo.EXPECT().RefundTransaction(s.ctx, paymentUUID, mock.MatchedBy(func(tx *model.Transaction) bool {
+					return tx.Type == model.TransactionTypeRefund &&
+						tx.Amount == 150 &&
+						tx.Currency == "RUB" &&
+						tx.ParentTransactionUUID != nil && *tx.ParentTransactionUUID == paymentUUID
+				})).RunAndReturn(func(_ context.Context, _ uuid.UUID, refund *model.Transaction) (*model.Transaction, error) {
+					return refund, nil
+				}).Once()
+			},
+		},
+		{
+			name:            "already_refunded_returns_existing_refund",
+			userUUID:        s.userUUID.String(),
+			orderUUID:       s.orderUUID.String(),
+			transactionUUID: paymentUUID.String(),
+			setupMock: func() {
+				refunded := payment()
+				refunded.Status = model.TransactionStatusRefunded
+				s.repo.EXPECT().GetTransaction(s.ctx, paymentUUID).Return(refunded, nil).Once()
+				s.repo.EXPECT().RefundTransaction(s.ctx, paymentUUID, mock.AnythingOfType("*model.Transaction")).
+					Return(&model.Transaction{TransactionUUID: existingRefundUUID}, nil).Once()
+			},
+			wantRefundUUID: &existingRefundUUID,
+		},
+		{
+			name:            "pending_payment_is_not_refunded",
+			userUUID:        s.userUUID.String(),
+			orderUUID:       s.orderUUID.String(),
+			transactionUUID: paymentUUID.String(),
+			setupMock: func() {
+				pending := payment()
+				pending.Status = model.TransactionStatusPending
+				s.repo.EXPECT().GetTransaction(s.ctx, paymentUUID).Return(pending, nil).Once()
+			},
+			wantErr:       model.ErrInvalidRefundRequest,
+			wantErrSubstr: "is PENDING",
+		},
+
+		{name: "invalid_user_uuid_empty", userUUID: "", orderUUID: s.orderUUID.String(), transactionUUID: paymentUUID.String(), wantErr: model.ErrInvalidRefundRequest, wantErrSubstr: "invalid user_uuid"},
+		{name: "invalid_order_uuid_empty", userUUID: s.userUUID.String(), orderUUID: "", transactionUUID: paymentUUID.String(), wantErr: model.ErrInvalidRefundRequest, wantErrSubstr: "invalid order_uuid"},
+		{name: "invalid_transaction_uuid_empty", userUUID: s.userUUID.String(), orderUUID: s.orderUUID.String(), transactionUUID: "", wantErr: model.ErrInvalidRefundRequest, wantErrSubstr: "invalid transaction_uuid"},
+		{
+			name:            "transaction_not_found",
+			userUUID:        s.userUUID.String(),
+			orderUUID:       s.orderUUID.String(),
+			transactionUUID: paymentUUID.String(),
+			setupMock: func() {
+				s.repo.EXPECT().GetTransaction(s.ctx, paymentUUID).Return(nil, model.ErrTransactionNotFound).Once()
+			},
+			wantErr:       model.ErrTransactionNotFound,
+			wantErrSubstr: "transaction not found",
+		},
+		{
+			name:            "transaction_of_another_order",
+			userUUID:        s.userUUID.String(),
+			orderUUID:       uuid.New().String(),
+			transactionUUID: paymentUUID.String(),
+			setupMock: func() {
+				s.repo.EXPECT().GetTransaction(s.ctx, paymentUUID).Return(payment(), nil).Once()
+			},
+			wantErr:       model.ErrInvalidRefundRequest,
+			wantErrSubstr: "belongs to another order or user",
+		},
+		{
+			name:            "transaction_is_refund",
+			userUUID:        s.userUUID.String(),
+			orderUUID:       s.orderUUID.String(),
+			transactionUUID: paymentUUID.String(),
+			setupMock: func() {
+				refund := payment()
+				refund.Type = model.TransactionTypeRefund
+				s.repo.EXPECT().GetTransaction(s.ctx, paymentUUID).Return(refund, nil).Once()
+			},
+			wantErr:       model.ErrInvalidRefundRequest,
+			wantErrSubstr: "is not a payment",
+		},
+		{
+			name:            "repository_error",
+			userUUID:        s.userUUID.String(),
+			orderUUID:       s.orderUUID.String(),
+			transactionUUID: paymentUUID.String(),
+			setupMock: func() {
+				s.repo.EXPECT().GetTransaction(s.ctx, paymentUUID).Return(payment(), nil).Once()
+				s.repo.EXPECT().RefundTransaction(s.ctx, paymentUUID, mock.AnythingOfType("*model.Transaction")).
+					Return(nil, errors.New("database error")).Once()
+			},
+			wantErrSubstr: "failed to save refund transaction",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+
+			if tt.setupMock != nil {
+				tt.setupMock()
+			}
+
+			id, err := s.svc.RefundPayment(s.ctx, tt.userUUID, tt.orderUUID, tt.transactionUUID)
+
+			if tt.wantErrSubstr != "" {
+				require.Error(s.T(), err)
+				require.Contains(s.T(), err.Error(), tt.wantErrSubstr)
+
+				if tt.wantErr != nil {
+					require.ErrorIs(s.T(), err, tt.wantErr)
+				}
+
+				return
+			}
+
+			require.NoError(s.T(), err)
+
+			refundUUID, perr := uuid.Parse(id)
+			require.NoError(s.T(), perr)
+
+			if tt.wantRefundUUID != nil {
+				require.Equal(s.T(), *tt.wantRefundUUID, refundUUID)
+			}
+		})
+	}
+}
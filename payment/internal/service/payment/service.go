@@ -1,9 +1,30 @@
 package payment
 
+import (
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/provider"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	"github.com/radiophysiker/microservices-homework/payment/internal/service"
+)
+
 // Service реализует интерфейс PaymentService
-type Service struct{}
+type Service struct {
+	transactionRepository repository.TransactionRepository
+	providers             map[model.PaymentMethod]provider.Provider
+	riskService           service.RiskService
+}
 
-// NewService создает новый экземпляр Service
-func NewService() *Service {
-	return &Service{}
+// NewService создает новый экземпляр Service.
+// providers задает провайдера для каждого поддерживаемого способа оплаты,
+// riskService проверяет новые платежи до списания средств
+func NewService(
+	transactionRepository repository.TransactionRepository,
+	providers map[model.PaymentMethod]provider.Provider,
+	riskService service.RiskService,
+) *Service {
+	return &Service{
+		transactionRepository: transactionRepository,
+		providers:             providers,
+		riskService:           riskService,
+	}
 }
@@ -0,0 +1,113 @@
+package payment_expiry
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/service"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// Service периодически завершает отказом асинхронные оплаты, не подтвержденные провайдером в течение ttl
+type Service struct {
+	paymentService service.PaymentService
+	ttl            time.Duration
+	interval       time.Duration
+	batchSize      int
+
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	stopped chan struct{}
+}
+
+// NewService создает новый экземпляр Service
+func NewService(
+	paymentService service.PaymentService,
+	ttl time.Duration,
+	interval time.Duration,
+	batchSize int,
+) *Service {
+	return &Service{
+		paymentService: paymentService,
+		ttl:            ttl,
+		interval:       interval,
+		batchSize:      batchSize,
+	}
+}
+
+// Run запускает цикл завершения просроченных оплат до отмены контекста
+func (s *Service) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+
+	s.mu.Lock()
+	s.cancel = cancel
+	s.stopped = stopped
+	s.mu.Unlock()
+
+	defer close(stopped)
+	defer cancel()
+
+	logger.Info(ctx, "Starting payment expiry job",
+		zap.Duration("ttl", s.ttl),
+		zap.Duration("interval", s.interval),
+		zap.Int("batch_size", s.batchSize),
+	)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.expirePending(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop останавливает цикл и ждет, пока завершится обработка текущей пачки
+func (s *Service) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	cancel, stopped := s.cancel, s.stopped
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// expirePending завершает просроченные оплаты пачками, пока они не закончатся
+func (s *Service) expirePending(ctx context.Context) {
+	createdBefore := time.Now().Add(-s.ttl)
+
+	for ctx.Err() == nil {
+		expired, err := s.paymentService.ExpirePendingPayments(ctx, createdBefore, s.batchSize)
+		if err != nil {
+			logger.Error(ctx, "Failed to expire pending payments", zap.Error(err))
+			return
+		}
+
+		if expired > 0 {
+			logger.Info(ctx, "Expired pending payments failed", zap.Int("count", expired))
+		}
+
+		if expired < s.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	grpcClient "github.com/radiophysiker/microservices-homework/payment/internal/client/grpc"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// Service реализует интерфейс ReconciliationService
+type Service struct {
+	transactionRepository repository.TransactionRepository
+	orderClient           grpcClient.OrderClient
+	mismatchesCounter     metric.Int64Counter
+}
+
+// NewService создает новый экземпляр Service
+func NewService(
+	ctx context.Context,
+	transactionRepository repository.TransactionRepository,
+	orderClient grpcClient.OrderClient,
+) *Service {
+	meter := otel.Meter("payment-service")
+
+	mismatchesCounter, err := meter.Int64Counter(
+		"payment_reconciliation_mismatches_total",
+		metric.WithDescription("Total number of mismatches between payment ledger and orders"),
+	)
+	if err != nil {
+		logger.Error(ctx, "Failed to create payment_reconciliation_mismatches_total counter", zap.Error(err))
+	}
+
+	return &Service{
+		transactionRepository: transactionRepository,
+		orderClient:           orderClient,
+		mismatchesCounter:     mismatchesCounter,
+	}
+}
+
+// Reconcile сверяет журнал платежей с заказами за окно window.
+// Проверяются проведенные оплаты, созданные в окне, и оплаченные заказы, измененные в окне;
+// вторая сторона каждой пары дочитывается по UUID, поэтому пара, попавшая в окно одной стороной, не считается расхождением
+func (s *Service) Reconcile(ctx context.Context, window model.ReconciliationWindow) (*model.ReconciliationReport, error) {
+	payments, err := s.transactionRepository.ListSettledPayments(ctx, window)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list settled payments: %w", err)
+	}
+
+	paidOrders, err := s.orderClient.ListPaidOrders(ctx, window)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list paid orders: %w", err)
+	}
+
+	ordersByUUID, err := s.ordersByUUID(ctx, payments, paidOrders)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsByUUID, err := s.transactionsByUUID(ctx, payments, paidOrders)
+	if err != nil {
+		return nil, err
+	}
+
+	var mismatches []model.Mismatch
+
+	// Сумма пары проверяется один раз, даже если в окно попали и оплата, и заказ
+	checkedPairs := make(map[uuid.UUID]struct{}, len(payments))
+
+	for _, payment := range payments {
+		order := ordersByUUID[payment.OrderUUID]
+		if order == nil || order.TransactionUUID == nil || *order.TransactionUUID != payment.TransactionUUID {
+			mismatches = append(mismatches, orphanTransaction(payment, order))
+			continue
+		}
+
+		checkedPairs[payment.TransactionUUID] = struct{}{}
+
+		if mismatch, ok := amountMismatch(payment, order); ok {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+
+	for _, order := range paidOrders {
+		var payment *model.Transaction
+		if order.TransactionUUID != nil {
+			payment = transactionsByUUID[*order.TransactionUUID]
+		}
+
+		if payment == nil || payment.Type != model.TransactionTypePayment || payment.Status != model.TransactionStatusSucceeded {
+			mismatches = append(mismatches, paidOrderWithoutTransaction(order, payment))
+			continue
+		}
+
+		if _, checked := checkedPairs[payment.TransactionUUID]; checked {
+			continue
+		}
+
+		if mismatch, ok := amountMismatch(payment, order); ok {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+
+	s.recordMismatches(ctx, mismatches)
+
+	return &model.ReconciliationReport{
+		Window:          window,
+		CheckedPayments: len(payments),
+		CheckedOrders:   len(paidOrders),
+		Mismatches:      mismatches,
+	}, nil
+}
+
+// ordersByUUID собирает заказы из окна и дочитывает заказы оплат, которые в окно не попали
+func (s *Service) ordersByUUID(ctx context.Context, payments []*model.Transaction, paidOrders []*model.OrderPayment) (map[uuid.UUID]*model.OrderPayment, error) {
+	orders := make(map[uuid.UUID]*model.OrderPayment, len(paidOrders))
+	for _, order := range paidOrders {
+		orders[order.OrderUUID] = order
+	}
+
+	var missing []uuid.UUID
+
+	for _, payment := range payments {
+		if _, ok := orders[payment.OrderUUID]; !ok {
+			missing = append(missing, payment.OrderUUID)
+		}
+	}
+
+	found, err := s.orderClient.GetOrders(ctx, missing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	for _, order := range found {
+		orders[order.OrderUUID] = order
+	}
+
+	return orders, nil
+}
+
+// transactionsByUUID собирает оплаты из окна и дочитывает транзакции заказов, которые в окно не попали
+func (s *Service) transactionsByUUID(ctx context.Context, payments []*model.Transaction, paidOrders []*model.OrderPayment) (map[uuid.UUID]*model.Transaction, error) {
+	transactions := make(map[uuid.UUID]*model.Transaction, len(payments))
+	for _, payment := range payments {
+		transactions[payment.TransactionUUID] = payment
+	}
+
+	var missing []uuid.UUID
+
+	for _, order := range paidOrders {
+		if order.TransactionUUID == nil {
+			continue
+		}
+
+		if _, ok := transactions[*order.TransactionUUID]; !ok {
+			missing = append(missing, *order.TransactionUUID)
+		}
+	}
+
+	found, err := s.transactionRepository.GetTransactions(ctx, missing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	for _, transaction := range found {
+		transactions[transaction.TransactionUUID] = transaction
+	}
+
+	return transactions, nil
+}
+
+// recordMismatches логирует расхождения и учитывает их в метрике по видам
+func (s *Service) recordMismatches(ctx context.Context, mismatches []model.Mismatch) {
+	for _, mismatch := range mismatches {
+		logger.Warn(ctx, "Расхождение журнала платежей с заказом",
+			zap.String("kind", string(mismatch.Kind)),
+			zap.String("order_uuid", mismatch.OrderUUID.String()),
+		)
+
+		if s.mismatchesCounter != nil {
+			s.mismatchesCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("kind", string(mismatch.Kind))))
+		}
+	}
+}
+
+// orphanTransaction описывает оплату, на которую не ссылается ее заказ. order может быть nil
+func orphanTransaction(payment *model.Transaction, order *model.OrderPayment) model.Mismatch {
+	mismatch := model.Mismatch{
+		Kind:              model.MismatchKindOrphanTransaction,
+		OrderUUID:         payment.OrderUUID,
+		TransactionUUID:   &payment.TransactionUUID,
+		TransactionStatus: payment.Status.String(),
+		LedgerAmount:      payment.Amount,
+		LedgerCurrency:    payment.Currency,
+	}
+
+	if order != nil {
+		mismatch.OrderStatus = order.Status
+		mismatch.OrderAmount = order.Amount
+		mismatch.OrderCurrency = order.Currency
+	}
+
+	return mismatch
+}
+
+// paidOrderWithoutTransaction описывает оплаченный заказ без проведенной оплаты. payment может быть nil
+func paidOrderWithoutTransaction(order *model.OrderPayment, payment *model.Transaction) model.Mismatch {
+	mismatch := model.Mismatch{
+		Kind:            model.MismatchKindPaidOrderWithoutTransaction,
+		OrderUUID:       order.OrderUUID,
+		TransactionUUID: order.TransactionUUID,
+		OrderStatus:     order.Status,
+		OrderAmount:     order.Amount,
+		OrderCurrency:   order.Currency,
+	}
+
+	if payment != nil {
+		mismatch.TransactionStatus = payment.Status.String()
+		mismatch.LedgerAmount = payment.Amount
+		mismatch.LedgerCurrency = payment.Currency
+	}
+
+	return mismatch
+}
+
+// amountMismatch сравнивает сумму и валюту оплаты с заказом
+func amountMismatch(payment *model.Transaction, order *model.OrderPayment) (model.Mismatch, bool) {
+	if payment.Amount == order.Amount && payment.Currency == order.Currency {
+		return model.Mismatch{}, false
+	}
+
+	return model.Mismatch{
+		Kind:              model.MismatchKindAmountMismatch,
+		OrderUUID:         order.OrderUUID,
+		TransactionUUID:   &payment.TransactionUUID,
+		OrderStatus:       order.Status,
+		TransactionStatus: payment.Status.String(),
+		OrderAmount:       order.Amount,
+		LedgerAmount:      payment.Amount,
+		OrderCurrency:     order.Currency,
+		LedgerCurrency:    payment.Currency,
+	}, true
+}
@@ -0,0 +1,243 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientMocks "github.com/radiophysiker/microservices-homework/payment/internal/client/grpc/mocks"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	repositoryMocks "github.com/radiophysiker/microservices-homework/payment/internal/repository/mocks"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+func TestServiceReconcile(t *testing.T) {
+	logger.SetNopLogger()
+
+	ctx := context.Background()
+	window := model.ReconciliationWindow{
+		Since: time.Date(2025, 12, 11, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2025, 12, 12, 0, 0, 0, 0, time.UTC),
+	}
+
+	newPayment := func(status model.TransactionStatus, amount int64) *model.Transaction {
+		return &model.Transaction{
+			TransactionUUID: uuid.New(),
+			OrderUUID:       uuid.New(),
+			Type:            model.TransactionTypePayment,
+			Status:          status,
+			Amount:          amount,
+			Currency:        "RUB",
+		}
+	}
+	orderFor := func(payment *model.Transaction, status string, amount int64) *model.OrderPayment {
+		return &model.OrderPayment{
+			OrderUUID:       payment.OrderUUID,
+			Status:          status,
+			TransactionUUID: &payment.TransactionUUID,
+			Amount:          amount,
+			Currency:        "RUB",
+		}
+	}
+
+	matched := newPayment(model.TransactionStatusSucceeded, 10_000)
+	matchedOrder := orderFor(matched, "PAID", 10_000)
+
+	refunded := newPayment(model.TransactionStatusRefunded, 5_000)
+	refundedOrder := orderFor(refunded, "CANCELLED", 5_000)
+
+	orphan := newPayment(model.TransactionStatusSucceeded, 7_000)
+
+	foreign := newPayment(model.TransactionStatusSucceeded, 3_000)
+	foreignOrder := &model.OrderPayment{OrderUUID: foreign.OrderUUID, Status: "PENDING_PAYMENT", Amount: 3_000, Currency: "RUB"}
+
+	mismatched := newPayment(model.TransactionStatusSucceeded, 9_000)
+	mismatchedOrder := orderFor(mismatched, "ASSEMBLED", 9_900)
+
+	// Оплата создана до окна, заказ изменен в окне
+	earlyPayment := newPayment(model.TransactionStatusSucceeded, 4_000)
+	earlyOrder := orderFor(earlyPayment, "PAID", 4_500)
+
+	unpaidOrder := &model.OrderPayment{OrderUUID: uuid.New(), Status: "PAID", Amount: 1_000, Currency: "RUB"}
+
+	missingTransactionUUID := uuid.New()
+	lostOrder := &model.OrderPayment{OrderUUID: uuid.New(), Status: "PAID", TransactionUUID: &missingTransactionUUID, Amount: 2_000, Currency: "RUB"}
+
+	refundedPaidPayment := newPayment(model.TransactionStatusRefunded, 6_000)
+	refundedPaidOrder := orderFor(refundedPaidPayment, "PAID", 6_000)
+
+	tests := []struct {
+		name          string
+		payments      []*model.Transaction
+		paidOrders    []*model.OrderPayment
+		foundOrders   []*model.OrderPayment
+		foundPayments []*model.Transaction
+		repoErr       error
+		want          []model.Mismatch
+		wantErr       string
+	}{
+		{
+			name:        "no_mismatches",
+			payments:    []*model.Transaction{matched, refunded},
+			paidOrders:  []*model.OrderPayment{matchedOrder},
+			foundOrders: []*model.OrderPayment{refundedOrder},
+		},
+		{
+			name:        "orphan_transactions",
+			payments:    []*model.Transaction{orphan, foreign},
+			foundOrders: []*model.OrderPayment{foreignOrder},
+			want: []model.Mismatch{
+				{
+					Kind:              model.MismatchKindOrphanTransaction,
+					OrderUUID:         orphan.OrderUUID,
+					TransactionUUID:   &orphan.TransactionUUID,
+					TransactionStatus: "SUCCEEDED",
+					LedgerAmount:      7_000,
+					LedgerCurrency:    "RUB",
+				},
+				{
+					Kind:              model.MismatchKindOrphanTransaction,
+					OrderUUID:         foreign.OrderUUID,
+					TransactionUUID:   &foreign.TransactionUUID,
+					OrderStatus:       "PENDING_PAYMENT",
+					TransactionStatus: "SUCCEEDED",
+					OrderAmount:       3_000,
+					LedgerAmount:      3_000,
+					OrderCurrency:     "RUB",
+					LedgerCurrency:    "RUB",
+				},
+			},
+		},
+		{
+			name:          "paid_orders_without_transaction",
+			paidOrders:    []*model.OrderPayment{unpaidOrder, lostOrder, refundedPaidOrder},
+			foundPayments: []*model.Transaction{refundedPaidPayment},
+			want: []model.Mismatch{
+				{
+					Kind:          model.MismatchKindPaidOrderWithoutTransaction,
+					OrderUUID:     unpaidOrder.OrderUUID,
+					OrderStatus:   "PAID",
+					OrderAmount:   1_000,
+					OrderCurrency: "RUB",
+				},
+				{
+					Kind:            model.MismatchKindPaidOrderWithoutTransaction,
+					OrderUUID:       lostOrder.OrderUUID,
+					TransactionUUID: &missingTransactionUUID,
+					OrderStatus:     "PAID",
+					OrderAmount:     2_000,
+					OrderCurrency:   "RUB",
+				},
+				{
+					Kind:              model.MismatchKindPaidOrderWithoutTransaction,
+					OrderUUID:         refundedPaidOrder.OrderUUID,
+					TransactionUUID:   &refundedPaidPayment.TransactionUUID,
+					OrderStatus:       "PAID",
+					TransactionStatus: "REFUNDED",
+					OrderAmount:       6_000,
+					LedgerAmount:      6_000,
+					OrderCurrency:     "RUB",
+					LedgerCurrency:    "RUB",
+				},
+			},
+		},
+		{
+			name:          "amount_mismatch_reported_once",
+			payments:      []*model.Transaction{mismatched},
+			paidOrders:    []*model.OrderPayment{mismatchedOrder, earlyOrder},
+			foundPayments: []*model.Transaction{earlyPayment},
+			want: []model.Mismatch{
+				{
+					Kind:              model.MismatchKindAmountMismatch,
+					OrderUUID:         mismatched.OrderUUID,
+					TransactionUUID:   &mismatched.TransactionUUID,
+					OrderStatus:       "ASSEMBLED",
+					TransactionStatus: "SUCCEEDED",
+					OrderAmount:       9_900,
+					LedgerAmount:      9_000,
+					OrderCurrency:     "RUB",
+					LedgerCurrency:    "RUB",
+				},
+				{
+					Kind:              model.MismatchKindAmountMismatch,
+					OrderUUID:         earlyPayment.OrderUUID,
+					TransactionUUID:   &earlyPayment.TransactionUUID,
+					OrderStatus:       "PAID",
+					TransactionStatus: "SUCCEEDED",
+					OrderAmount:       4_500,
+					LedgerAmount:      4_000,
+					OrderCurrency:     "RUB",
+					LedgerCurrency:    "RUB",
+				},
+			},
+		},
+		{
+			name:    "ledger_error",
+			repoErr: errors.New("database error"),
+			wantErr: "failed to list settled payments",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepository := repositoryMocks.NewMockTransactionRepository(t)
+			orderClient := clientMocks.NewMockOrderClient(t)
+
+			transactionRepository.EXPECT().ListSettledPayments(ctx, window).Return(tt.payments, tt.repoErr).Once()
+
+			if tt.repoErr == nil {
+				orderClient.EXPECT().ListPaidOrders(ctx, window).Return(tt.paidOrders, nil).Once()
+				orderClient.EXPECT().GetOrders(ctx, mock.Anything).Return(tt.foundOrders, nil).Once()
+				transactionRepository.EXPECT().GetTransactions(ctx, mock.Anything).Return(tt.foundPayments, nil).Once()
+			}
+
+			report, err := NewService(ctx, transactionRepository, orderClient).Reconcile(ctx, window)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, window, report.Window)
+			require.Equal(t, len(tt.payments), report.CheckedPayments)
+			require.Equal(t, len(tt.paidOrders), report.CheckedOrders)
+			require.Equal(t, tt.want, report.Mismatches)
+		})
+	}
+}
+
+func TestServiceReconcileFetchesOtherSide(t *testing.T) {
+	logger.SetNopLogger()
+
+	ctx := context.Background()
+	window := model.ReconciliationWindow{
+		Since: time.Date(2025, 12, 11, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2025, 12, 12, 0, 0, 0, 0, time.UTC),
+	}
+
+	inWindowPayment := &model.Transaction{TransactionUUID: uuid.New(), OrderUUID: uuid.New(), Type: model.TransactionTypePayment, Status: model.TransactionStatusSucceeded}
+	pairedTransactionUUID := uuid.New()
+	pairedOrder := &model.OrderPayment{OrderUUID: inWindowPayment.OrderUUID, TransactionUUID: &inWindowPayment.TransactionUUID, Status: "PAID"}
+	otherOrder := &model.OrderPayment{OrderUUID: uuid.New(), TransactionUUID: &pairedTransactionUUID, Status: "PAID"}
+
+	transactionRepository := repositoryMocks.NewMockTransactionRepository(t)
+	orderClient := clientMocks.NewMockOrderClient(t)
+
+	transactionRepository.EXPECT().ListSettledPayments(ctx, window).Return([]*model.Transaction{inWindowPayment}, nil).Once()
+	orderClient.EXPECT().ListPaidOrders(ctx, window).Return([]*model.OrderPayment{pairedOrder, otherOrder}, nil).Once()
+	// Заказ оплаты уже пришел из окна, дочитывать нечего
+	orderClient.EXPECT().GetOrders(ctx, []uuid.UUID(nil)).Return(nil, nil).Once()
+	// Транзакция второго заказа в окно не попала и дочитывается по UUID
+	transactionRepository.EXPECT().GetTransactions(ctx, []uuid.UUID{pairedTransactionUUID}).Return(nil, nil).Once()
+
+	report, err := NewService(ctx, transactionRepository, orderClient).Reconcile(ctx, window)
+	require.NoError(t, err)
+	require.Len(t, report.Mismatches, 1)
+	require.Equal(t, model.MismatchKindPaidOrderWithoutTransaction, report.Mismatches[0].Kind)
+	require.Equal(t, otherOrder.OrderUUID, report.Mismatches[0].OrderUUID)
+}
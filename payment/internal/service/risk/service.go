@@ -0,0 +1,119 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	"github.com/radiophysiker/microservices-homework/payment/internal/repository"
+)
+
+const (
+	// dailyWindow - окно дневного лимита
+	dailyWindow = 24 * time.Hour
+	// monthlyWindow - окно месячного лимита
+	monthlyWindow = 30 * 24 * time.Hour
+)
+
+// Config задает правила риска. Нулевой лимит означает отсутствие ограничения.
+// Лимиты сумм указываются в минимальных единицах валюты платежа
+// и считаются по скользящим окнам: 24 часа и 30 дней
+type Config struct {
+	BlockedUsers          map[uuid.UUID]struct{}
+	DailyLimit            int64
+	MonthlyLimit          int64
+	DailyLimitsByMethod   map[model.PaymentMethod]int64
+	MonthlyLimitsByMethod map[model.PaymentMethod]int64
+	// VelocityMaxPayments - сколько оплат пользователь может провести за VelocityWindow
+	VelocityMaxPayments int
+	VelocityWindow      time.Duration
+}
+
+// hasCounters проверяет, нужны ли правилам счетчики платежей пользователя
+func (c Config) hasCounters() bool {
+	return c.DailyLimit > 0 ||
+		c.MonthlyLimit > 0 ||
+		len(c.DailyLimitsByMethod) > 0 ||
+		len(c.MonthlyLimitsByMethod) > 0 ||
+		c.VelocityMaxPayments > 0
+}
+
+// Service реализует интерфейс RiskService
+type Service struct {
+	transactionRepository repository.TransactionRepository
+	cfg                   Config
+	now                   func() time.Time
+}
+
+// NewService создает новый экземпляр Service
+func NewService(transactionRepository repository.TransactionRepository, cfg Config) *Service {
+	return &Service{
+		transactionRepository: transactionRepository,
+		cfg:                   cfg,
+		now:                   time.Now,
+	}
+}
+
+// Check проверяет платеж по правилам риска: блок-листу, частоте оплат и лимитам сумм.
+// Счетчики берутся из журнала транзакций. Нарушение правила возвращается как PaymentRejectedError
+func (s *Service) Check(ctx context.Context, payment *model.Transaction) error {
+	if _, blocked := s.cfg.BlockedUsers[payment.UserUUID]; blocked {
+		return &model.PaymentRejectedError{
+			Reason: model.RiskReasonUserBlocked,
+			Detail: fmt.Sprintf("user %s is blocked", payment.UserUUID),
+		}
+	}
+
+	if !s.cfg.hasCounters() {
+		return nil
+	}
+
+	now := s.now()
+
+	stats, err := s.transactionRepository.GetPaymentStats(ctx, model.PaymentStatsQuery{
+		UserUUID:      payment.UserUUID,
+		PaymentMethod: payment.PaymentMethod,
+		Currency:      payment.Currency,
+		DaySince:      now.Add(-dailyWindow),
+		MonthSince:    now.Add(-monthlyWindow),
+		VelocitySince: now.Add(-s.cfg.VelocityWindow),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get payment stats: %w", err)
+	}
+
+	if s.cfg.VelocityMaxPayments > 0 && stats.RecentPayments >= s.cfg.VelocityMaxPayments {
+		return &model.PaymentRejectedError{
+			Reason: model.RiskReasonVelocityExceeded,
+			Detail: fmt.Sprintf("%d payments within %s", stats.RecentPayments, s.cfg.VelocityWindow),
+		}
+	}
+
+	method := payment.PaymentMethod.String()
+	limits := []struct {
+		reason model.RiskReason
+		window string
+		spent  int64
+		limit  int64
+	}{
+		{model.RiskReasonDailyLimitExceeded, "daily", stats.DailyAmount, s.cfg.DailyLimit},
+		{model.RiskReasonMethodDailyLimitExceeded, "daily " + method, stats.MethodDailyAmount, s.cfg.DailyLimitsByMethod[payment.PaymentMethod]},
+		{model.RiskReasonMonthlyLimitExceeded, "monthly", stats.MonthlyAmount, s.cfg.MonthlyLimit},
+		{model.RiskReasonMethodMonthlyLimitExceeded, "monthly " + method, stats.MethodMonthlyAmount, s.cfg.MonthlyLimitsByMethod[payment.PaymentMethod]},
+	}
+
+	for _, l := range limits {
+		if l.limit > 0 && l.spent+payment.Amount > l.limit {
+			return &model.PaymentRejectedError{
+				Reason: l.reason,
+				Detail: fmt.Sprintf("%s limit %d %s, already spent %d, requested %d",
+					l.window, l.limit, payment.Currency, l.spent, payment.Amount),
+			}
+		}
+	}
+
+	return nil
+}
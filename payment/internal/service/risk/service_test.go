@@ -0,0 +1,159 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
+	repositoryMocks "github.com/radiophysiker/microservices-homework/payment/internal/repository/mocks"
+)
+
+func TestServiceCheck(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 12, 12, 10, 0, 0, 0, time.UTC)
+	userUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")
+	blockedUserUUID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440009")
+
+	payment := &model.Transaction{
+		UserUUID:      userUUID,
+		PaymentMethod: model.PaymentMethodCard,
+		Amount:        10_000,
+		Currency:      "RUB",
+	}
+
+	limits := Config{
+		DailyLimit:            50_000,
+		MonthlyLimit:          500_000,
+		DailyLimitsByMethod:   map[model.PaymentMethod]int64{model.PaymentMethodCard: 30_000},
+		MonthlyLimitsByMethod: map[model.PaymentMethod]int64{model.PaymentMethodCard: 200_000},
+		VelocityMaxPayments:   3,
+		VelocityWindow:        time.Minute,
+	}
+
+	tests := []struct {
+		name       string
+		cfg        Config
+		payment    *model.Transaction
+		stats      *model.PaymentStats
+		statsErr   error
+		noStats    bool
+		wantReason model.RiskReason
+		wantErr    string
+	}{
+		{
+			name:    "within_limits",
+			cfg:     limits,
+			payment: payment,
+			stats:   &model.PaymentStats{DailyAmount: 20_000, MethodDailyAmount: 20_000, MonthlyAmount: 100_000, MethodMonthlyAmount: 100_000, RecentPayments: 2},
+		},
+		{
+			name:    "payment_exactly_at_limit",
+			cfg:     limits,
+			payment: payment,
+			stats:   &model.PaymentStats{DailyAmount: 20_000, MethodDailyAmount: 20_000},
+		},
+		{
+			name:    "no_rules_skip_stats",
+			cfg:     Config{},
+			payment: payment,
+			noStats: true,
+		},
+		{
+			name:       "blocked_user",
+			cfg:        Config{BlockedUsers: map[uuid.UUID]struct{}{blockedUserUUID: {}}},
+			payment:    &model.Transaction{UserUUID: blockedUserUUID, PaymentMethod: model.PaymentMethodCard, Amount: 100, Currency: "RUB"},
+			noStats:    true,
+			wantReason: model.RiskReasonUserBlocked,
+		},
+		{
+			name:       "velocity_exceeded",
+			cfg:        limits,
+			payment:    payment,
+			stats:      &model.PaymentStats{RecentPayments: 3},
+			wantReason: model.RiskReasonVelocityExceeded,
+		},
+		{
+			name:       "daily_limit_exceeded",
+			cfg:        limits,
+			payment:    payment,
+			stats:      &model.PaymentStats{DailyAmount: 45_000},
+			wantReason: model.RiskReasonDailyLimitExceeded,
+		},
+		{
+			name:       "method_daily_limit_exceeded",
+			cfg:        limits,
+			payment:    payment,
+			stats:      &model.PaymentStats{DailyAmount: 25_000, MethodDailyAmount: 25_000},
+			wantReason: model.RiskReasonMethodDailyLimitExceeded,
+		},
+		{
+			name:       "monthly_limit_exceeded",
+			cfg:        limits,
+			payment:    payment,
+			stats:      &model.PaymentStats{MonthlyAmount: 495_000},
+			wantReason: model.RiskReasonMonthlyLimitExceeded,
+		},
+		{
+			name:       "method_monthly_limit_exceeded",
+			cfg:        limits,
+			payment:    payment,
+			stats:      &model.PaymentStats{MonthlyAmount: 195_000, MethodMonthlyAmount: 195_000},
+			wantReason: model.RiskReasonMethodMonthlyLimitExceeded,
+		},
+		{
+			name:    "method_without_own_limit",
+			cfg:     limits,
+			payment: &model.Transaction{UserUUID: userUUID, PaymentMethod: model.PaymentMethodSBP, Amount: 10_000, Currency: "RUB"},
+			stats:   &model.PaymentStats{DailyAmount: 25_000, MethodDailyAmount: 25_000},
+		},
+		{
+			name:     "stats_error",
+			cfg:      limits,
+			payment:  payment,
+			statsErr: errors.New("database error"),
+			wantErr:  "failed to get payment stats",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := repositoryMocks.NewMockTransactionRepository(t)
+
+			if !tt.noStats {
+				repo.EXPECT().GetPaymentStats(ctx, mock.MatchedBy(func(query model.PaymentStatsQuery) bool {
+					return query.UserUUID == tt.payment.UserUUID &&
+						query.PaymentMethod == tt.payment.PaymentMethod &&
+						query.Currency == tt.payment.Currency &&
+						query.DaySince.Equal(now.Add(-dailyWindow)) &&
+						query.MonthSince.Equal(now.Add(-monthlyWindow)) &&
+						query.VelocitySince.Equal(now.Add(-tt.cfg.VelocityWindow))
+				})).Return(tt.stats, tt.statsErr).Once()
+			}
+
+			svc := NewService(repo, tt.cfg)
+			svc.now = func() time.Time { return now }
+
+			err := svc.Check(ctx, tt.payment)
+
+			switch {
+			case tt.wantErr != "":
+				require.ErrorContains(t, err, tt.wantErr)
+				require.NotErrorIs(t, err, model.ErrPaymentRejected)
+			case tt.wantReason != "":
+				var rejected *model.PaymentRejectedError
+
+				require.ErrorIs(t, err, model.ErrPaymentRejected)
+				require.ErrorAs(t, err, &rejected)
+				require.Equal(t, tt.wantReason, rejected.Reason)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
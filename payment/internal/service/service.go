@@ -2,12 +2,51 @@ package service
 
 import (
 	"context"
+	"time"
 
-	pb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+	"github.com/radiophysiker/microservices-homework/payment/internal/model"
 )
 
 // PaymentService представляет интерфейс для работы с платежами
 type PaymentService interface {
-	// PayOrder проводит оплату заказа
-	PayOrder(ctx context.Context, userUUID, orderUUID string, paymentMethod pb.PaymentMethod) (string, error)
+	// PayOrder проводит оплату заказа; повторный вызов для того же заказа возвращает существующую транзакцию.
+	// Оплата асинхронным способом возвращается в статусе PENDING. cardToken передается провайдеру оплаты картой
+	PayOrder(ctx context.Context, userUUID, orderUUID string, paymentMethod model.PaymentMethod, amount int64, currency, cardToken string) (*model.Transaction, error)
+	// CompletePayment завершает асинхронную оплату по callback'у провайдера
+	CompletePayment(ctx context.Context, transactionUUID string, status model.TransactionStatus, declineCode model.DeclineCode) (*model.Transaction, error)
+	// ExpirePendingPayments завершает отказом до limit асинхронных оплат, созданных раньше createdBefore.
+	// Возвращает число завершенных оплат
+	ExpirePendingPayments(ctx context.Context, createdBefore time.Time, limit int) (int, error)
+	// RefundPayment возвращает средства по транзакции оплаты заказа
+	RefundPayment(ctx context.Context, userUUID, orderUUID, transactionUUID string) (string, error)
+	// GetTransaction возвращает транзакцию по UUID
+	GetTransaction(ctx context.Context, transactionUUID string) (*model.Transaction, error)
+	// ListTransactions возвращает страницу транзакций по фильтру
+	ListTransactions(ctx context.Context, filter model.TransactionFilter, cursor *model.TransactionCursor, pageSize int) (*model.TransactionPage, error)
+}
+
+// RiskService представляет интерфейс правил риска для новых платежей
+type RiskService interface {
+	// Check возвращает PaymentRejectedError, если платеж нарушает правила риска
+	Check(ctx context.Context, payment *model.Transaction) error
+}
+
+// ReconciliationService представляет интерфейс сверки журнала платежей с заказами
+type ReconciliationService interface {
+	// Reconcile сверяет оплаты и оплаченные заказы за окно window и возвращает найденные расхождения
+	Reconcile(ctx context.Context, window model.ReconciliationWindow) (*model.ReconciliationReport, error)
+}
+
+// OutboxRelayService представляет интерфейс для публикации событий из outbox
+type OutboxRelayService interface {
+	// Run запускает публикацию событий из outbox в Kafka
+	Run(ctx context.Context) error
+}
+
+// PaymentExpiryService представляет интерфейс для фоновой отмены неподтвержденных оплат
+type PaymentExpiryService interface {
+	// Run периодически завершает отказом оплаты, не подтвержденные провайдером вовремя
+	Run(ctx context.Context) error
+	// Stop останавливает Run и ждет завершения текущей пачки
+	Stop(ctx context.Context) error
 }
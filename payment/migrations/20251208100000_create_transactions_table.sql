@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS transactions (
+    uuid UUID PRIMARY KEY,
+    order_uuid UUID NOT NULL,
+    user_uuid UUID NOT NULL,
+    type TEXT NOT NULL,
+    status TEXT NOT NULL,
+    payment_method TEXT NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    -- payment refunded by this transaction; set only for refunds
+    parent_uuid UUID REFERENCES transactions (uuid),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+-- transactions are listed newest first by user or by order
+CREATE INDEX IF NOT EXISTS idx_transactions_user_created_at ON transactions (user_uuid, created_at DESC, uuid DESC);
+CREATE INDEX IF NOT EXISTS idx_transactions_order_created_at ON transactions (order_uuid, created_at DESC, uuid DESC);
+-- a payment can be refunded only once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_refund_parent ON transactions (parent_uuid) WHERE type = 'REFUND';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_refund_parent;
+DROP INDEX IF EXISTS idx_transactions_order_created_at;
+DROP INDEX IF EXISTS idx_transactions_user_created_at;
+DROP TABLE IF EXISTS transactions;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- an order can be paid only once; a retried PayOrder gets the existing payment back
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_order_payment ON transactions (order_uuid) WHERE type = 'PAYMENT';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_order_payment;
+-- +goose StatementEnd
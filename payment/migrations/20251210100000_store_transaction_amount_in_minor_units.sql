@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- amount is stored in minor currency units (kopecks) together with its ISO 4217 currency
+ALTER TABLE transactions ALTER COLUMN amount DROP DEFAULT;
+ALTER TABLE transactions ALTER COLUMN amount TYPE BIGINT USING ROUND(amount * 100)::BIGINT;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB';
+ALTER TABLE transactions ALTER COLUMN currency DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE transactions DROP COLUMN IF EXISTS currency;
+ALTER TABLE transactions ALTER COLUMN amount TYPE NUMERIC(12, 2) USING amount / 100.0;
+ALTER TABLE transactions ALTER COLUMN amount SET DEFAULT 0;
+-- +goose StatementEnd
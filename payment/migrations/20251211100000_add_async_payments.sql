@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- provider decline code of a FAILED asynchronous payment
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS failure_code TEXT;
+-- a failed asynchronous payment does not block a new attempt for the order
+DROP INDEX IF EXISTS idx_transactions_order_payment;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_order_payment ON transactions (order_uuid) WHERE type = 'PAYMENT' AND status <> 'FAILED';
+-- pending payments are swept by the timeout job oldest first
+CREATE INDEX IF NOT EXISTS idx_transactions_pending ON transactions (created_at) WHERE status = 'PENDING';
+CREATE TABLE IF NOT EXISTS outbox (
+    uuid UUID PRIMARY KEY,
+    aggregate_uuid UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    event_key BYTEA,
+    payload BYTEA NOT NULL,
+    -- trace context of the request that produced the event, propagated to Kafka headers by the relay
+    trace_context JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMP WITH TIME ZONE
+);
+-- pending events are read in insertion order by the outbox relay
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (created_at) WHERE sent_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_outbox_pending;
+DROP TABLE IF EXISTS outbox;
+DROP INDEX IF EXISTS idx_transactions_pending;
+DROP INDEX IF EXISTS idx_transactions_order_payment;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_order_payment ON transactions (order_uuid) WHERE type = 'PAYMENT';
+ALTER TABLE transactions DROP COLUMN IF EXISTS failure_code;
+-- +goose StatementEnd
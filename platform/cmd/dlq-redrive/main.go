@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	kafkaConsumer "github.com/radiophysiker/microservices-homework/platform/pkg/kafka/consumer"
+)
+
+// main возвращает сообщения из DLQ-топика в исходные топики.
+// Прогресс сохраняется в offset'ах consumer group, поэтому повторный запуск
+// не отправит уже возвращенные сообщения. Работа завершается, когда в топике
+// нет новых сообщений дольше -idle.
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	brokers := flag.String("brokers", envOrDefault("KAFKA_BROKERS", "localhost:9092"), "Kafka brokers, comma separated")
+	topic := flag.String("topic", "", "DLQ topic to re-drive, e.g. order.paid.dlq")
+	group := flag.String("group", "dlq-redrive", "Consumer group used to track re-drive progress")
+	idle := flag.Duration("idle", 10*time.Second, "Stop after no messages were received for this long")
+
+	flag.Parse()
+
+	if !strings.HasSuffix(*topic, kafkaConsumer.DeadLetterSuffix) {
+		fmt.Fprintf(os.Stderr, "-topic must be a dead letter topic ending with %q\n", kafkaConsumer.DeadLetterSuffix)
+		return 2
+	}
+
+	log, err := zap.NewDevelopment()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create logger: %v\n", err)
+		return 1
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	redriven, err := redrive(ctx, log, strings.Split(*brokers, ","), *topic, *group, *idle)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Re-drive failed after %d messages: %v\n", redriven, err)
+		return 1
+	}
+
+	fmt.Printf("✅ Re-driven %d messages from %s\n", redriven, *topic)
+
+	return 0
+}
+
+func redrive(ctx context.Context, log *zap.Logger, brokers []string, topic, groupID string, idle time.Duration) (int64, error) {
+	cfg := sarama.NewConfig()
+	cfg.Version = sarama.V4_0_0_0
+	cfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+	cfg.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(brokers, cfg)
+	if err != nil {
+		return 0, fmt.Errorf("create producer: %w", err)
+	}
+	defer producer.Close() //nolint:errcheck // ошибка закрытия не влияет на результат
+
+	group, err := sarama.NewConsumerGroup(brokers, groupID, cfg)
+	if err != nil {
+		return 0, fmt.Errorf("create consumer group: %w", err)
+	}
+	defer group.Close() //nolint:errcheck // ошибка закрытия не влияет на результат
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		count    atomic.Int64
+		lastSeen atomic.Int64
+		failOnce sync.Once
+		failErr  error
+	)
+
+	// Первая ошибка останавливает утилиту: сообщение остается в DLQ и будет
+	// обработано при следующем запуске
+	fail := func(err error) error {
+		failOnce.Do(func() {
+			failErr = err
+			cancel()
+		})
+
+		return err
+	}
+
+	lastSeen.Store(time.Now().UnixNano())
+
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if time.Since(time.Unix(0, lastSeen.Load())) > idle {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	handler := func(_ context.Context, msg kafka.Message) error {
+		lastSeen.Store(time.Now().UnixNano())
+
+		target, err := kafkaConsumer.RedriveMessage(msg)
+		if err != nil {
+			return fail(err)
+		}
+
+		if _, _, err := producer.SendMessage(target); err != nil {
+			return fail(fmt.Errorf("send to %s: %w", target.Topic, err))
+		}
+
+		count.Add(1)
+
+		return nil
+	}
+
+	consumer := kafkaConsumer.NewConsumer(group, []string{topic}, cliLogger{log: log})
+
+	err = consumer.Consume(ctx, handler)
+	if err != nil && ctx.Err() == nil {
+		return count.Load(), err
+	}
+
+	return count.Load(), failErr
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return fallback
+}
+
+// cliLogger адаптирует zap.Logger к интерфейсу логгера consumer'а.
+type cliLogger struct {
+	log *zap.Logger
+}
+
+func (l cliLogger) Info(_ context.Context, msg string, fields ...zap.Field) {
+	l.log.Info(msg, fields...)
+}
+
+func (l cliLogger) Error(_ context.Context, msg string, fields ...zap.Field) {
+	l.log.Error(msg, fields...)
+}
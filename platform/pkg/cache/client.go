@@ -8,6 +8,7 @@ import (
 type RedisClient interface {
 	Set(ctx context.Context, key string, value any) error
 	SetWithTTL(ctx context.Context, key string, value any, ttl time.Duration) error
+	SetNXWithTTL(ctx context.Context, key string, value any, ttl time.Duration) (bool, error)
 	Get(ctx context.Context, key string) ([]byte, error)
 	HashSet(ctx context.Context, key string, values any) error
 	HGetAll(ctx context.Context, key string) ([]any, error)
@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	redigo "github.com/gomodule/redigo/redis"
@@ -71,6 +72,29 @@ func (c *client) SetWithTTL(ctx context.Context, key string, value any, ttl time
 	})
 }
 
+// SetNXWithTTL устанавливает значение с TTL, только если ключ еще не существует.
+// Возвращает true, если значение было установлено
+func (c *client) SetNXWithTTL(ctx context.Context, key string, value any, ttl time.Duration) (bool, error) {
+	var ok bool
+
+	err := c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		_, err := redigo.String(conn.Do("SET", key, value, "EX", int(ttl.Seconds()), "NX"))
+		if errors.Is(err, redigo.ErrNil) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		ok = true
+
+		return nil
+	})
+
+	return ok, err
+}
+
 func (c *client) Get(ctx context.Context, key string) ([]byte, error) {
 	var result []byte
 
@@ -2,6 +2,7 @@ package consumer
 
 import (
 	"context"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/pkg/errors"
@@ -10,6 +11,11 @@ import (
 	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
 )
 
+// rejoinBackoff — пауза перед повторным подключением к группе после сессии, завершённой
+// ошибкой обработчика. Без неё сообщение, которое не удаётся обработать (например, при
+// недоступном DLQ), перечитывалось бы в цикле без задержки.
+var rejoinBackoff = RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 30 * time.Second}
+
 type Logger interface {
 	Info(ctx context.Context, msg string, fields ...zap.Field)
 	Error(ctx context.Context, msg string, fields ...zap.Field)
@@ -33,8 +39,11 @@ func NewConsumer(group sarama.ConsumerGroup, topics []string, logger Logger, mid
 }
 
 // Consume запускает consumer для списка топиков.
+// После сессии, завершённой ошибкой обработчика, повторное подключение к группе
+// откладывается с экспоненциально растущей паузой; пауза прерывается отменой ctx.
 func (c *consumer) Consume(ctx context.Context, handler kafka.MessageHandler) error {
 	newGroupHandler := NewGroupHandler(handler, c.logger, c.middlewares...)
+	failures := 0
 
 	for {
 		if err := c.group.Consume(ctx, c.topics, newGroupHandler); err != nil {
@@ -51,6 +60,29 @@ func (c *consumer) Consume(ctx context.Context, handler kafka.MessageHandler) er
 			return ctx.Err()
 		}
 
-		c.logger.Info(ctx, "Kafka consumer group rebalancing...")
+		if !newGroupHandler.takeFailure() {
+			failures = 0
+
+			c.logger.Info(ctx, "Kafka consumer group rebalancing...")
+
+			continue
+		}
+
+		failures++
+		backoff := rejoinBackoff.Backoff(failures)
+
+		c.logger.Error(ctx, "Kafka session stopped by handler error, rejoining after backoff",
+			zap.Int("failures", failures),
+			zap.Duration("backoff", backoff),
+		)
+
+		timer := time.NewTimer(backoff)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
 	}
 }
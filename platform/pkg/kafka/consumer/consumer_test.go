@@ -0,0 +1,122 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+// fakeGroup — группа, каждая сессия которой отдаёт обработчику одно сообщение
+type fakeGroup struct {
+	sarama.ConsumerGroup
+	sessions  int
+	maxRounds int
+	cancel    context.CancelFunc
+	startedAt []time.Time
+}
+
+func (g *fakeGroup) Consume(ctx context.Context, _ []string, handler sarama.ConsumerGroupHandler) error {
+	g.sessions++
+	g.startedAt = append(g.startedAt, time.Now())
+
+	messages := make(chan *sarama.ConsumerMessage, 1)
+	messages <- &sarama.ConsumerMessage{Topic: "orders", Value: []byte("payload")}
+	close(messages)
+
+	_ = handler.ConsumeClaim(&fakeSession{ctx: ctx}, &fakeClaim{messages: messages})
+
+	if g.sessions >= g.maxRounds {
+		g.cancel()
+	}
+
+	return nil
+}
+
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	ctx context.Context
+}
+
+func (s *fakeSession) Context() context.Context { return s.ctx }
+
+func (s *fakeSession) MarkMessage(*sarama.ConsumerMessage, string) {}
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+func TestConsumeBacksOffAfterHandlerError(t *testing.T) {
+	logger.SetNopLogger()
+
+	policy := rejoinBackoff
+	rejoinBackoff = RetryPolicy{InitialBackoff: 20 * time.Millisecond, MaxBackoff: 40 * time.Millisecond}
+	t.Cleanup(func() { rejoinBackoff = policy })
+
+	tests := []struct {
+		name       string
+		handlerErr error
+		minPauses  []time.Duration
+	}{
+		{
+			name:       "handler_error_backs_off_exponentially",
+			handlerErr: errors.New("dead letter topic unavailable"),
+			minPauses:  []time.Duration{20 * time.Millisecond, 40 * time.Millisecond},
+		},
+		{
+			name:      "successful_sessions_rejoin_immediately",
+			minPauses: []time.Duration{0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			group := &fakeGroup{maxRounds: len(tt.minPauses) + 1, cancel: cancel}
+			c := NewConsumer(group, []string{"orders"}, logger.Logger())
+
+			err := c.Consume(ctx, func(context.Context, kafka.Message) error {
+				return tt.handlerErr
+			})
+			require.ErrorIs(t, err, context.Canceled)
+			require.Len(t, group.startedAt, len(tt.minPauses)+1)
+
+			for i, minPause := range tt.minPauses {
+				require.GreaterOrEqual(t, group.startedAt[i+1].Sub(group.startedAt[i]), minPause, "session %d", i+1)
+			}
+		})
+	}
+}
+
+func TestConsumeBackoffStopsOnCancel(t *testing.T) {
+	logger.SetNopLogger()
+
+	policy := rejoinBackoff
+	rejoinBackoff = RetryPolicy{InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	t.Cleanup(func() { rejoinBackoff = policy })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	group := &fakeGroup{maxRounds: 100, cancel: func() {}}
+	c := NewConsumer(group, []string{"orders"}, logger.Logger())
+
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	err := c.Consume(ctx, func(context.Context, kafka.Message) error {
+		return errors.New("handler failed")
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, group.sessions)
+}
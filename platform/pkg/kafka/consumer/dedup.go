@@ -0,0 +1,88 @@
+package consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+)
+
+// ProcessedEventStore хранит идентификаторы успешно обработанных событий.
+type ProcessedEventStore interface {
+	// IsProcessed проверяет, было ли событие уже обработано.
+	IsProcessed(ctx context.Context, eventID string) (bool, error)
+	// MarkProcessed отмечает событие обработанным. Повторная отметка не является ошибкой.
+	MarkProcessed(ctx context.Context, eventID string) error
+}
+
+// EventIDFunc извлекает идентификатор события из сообщения.
+type EventIDFunc func(msg kafka.Message) (string, error)
+
+type eventMessage interface {
+	proto.Message
+	GetEventUuid() string
+}
+
+// ProtoEventID возвращает EventIDFunc, читающую поле event_uuid из protobuf-события.
+func ProtoEventID[T eventMessage](newEvent func() T) EventIDFunc {
+	return func(msg kafka.Message) (string, error) {
+		event := newEvent()
+		if err := proto.Unmarshal(msg.Value, event); err != nil {
+			return "", err
+		}
+
+		return event.GetEventUuid(), nil
+	}
+}
+
+// Deduplicate — middleware, пропускающее уже обработанные события.
+// Событие отмечается обработанным только после успешного выполнения обработчика,
+// поэтому при сбое оно будет обработано повторно.
+func Deduplicate(store ProcessedEventStore, eventID EventIDFunc, logger Logger) Middleware {
+	return func(next kafka.MessageHandler) kafka.MessageHandler {
+		return func(ctx context.Context, msg kafka.Message) error {
+			id, err := eventID(msg)
+			if err != nil || id == "" {
+				// Сообщение без идентификатора обрабатываем как есть — ошибку разбора вернет обработчик
+				return next(ctx, msg)
+			}
+
+			processed, err := store.IsProcessed(ctx, id)
+			if err != nil {
+				logger.Error(ctx, "Failed to check processed event",
+					zap.Error(err),
+					zap.String("event_uuid", id),
+				)
+
+				return err
+			}
+
+			if processed {
+				logger.Info(ctx, "Skipping already processed event",
+					zap.String("event_uuid", id),
+					zap.String("topic", msg.Topic),
+					zap.Int32("partition", msg.Partition),
+					zap.Int64("offset", msg.Offset),
+				)
+
+				return nil
+			}
+
+			if err := next(ctx, msg); err != nil {
+				return err
+			}
+
+			// Побочные эффекты уже выполнены, поэтому ошибку отметки только логируем
+			if err := store.MarkProcessed(ctx, id); err != nil {
+				logger.Error(ctx, "Failed to mark event as processed",
+					zap.Error(err),
+					zap.String("event_uuid", id),
+				)
+			}
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+	eventspb "github.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1"
+)
+
+type memoryStore struct {
+	processed map[string]bool
+	checkErr  error
+	markErr   error
+}
+
+func (s *memoryStore) IsProcessed(_ context.Context, eventID string) (bool, error) {
+	if s.checkErr != nil {
+		return false, s.checkErr
+	}
+
+	return s.processed[eventID], nil
+}
+
+func (s *memoryStore) MarkProcessed(_ context.Context, eventID string) error {
+	if s.markErr != nil {
+		return s.markErr
+	}
+
+	s.processed[eventID] = true
+
+	return nil
+}
+
+func keyAsEventID(msg kafka.Message) (string, error) {
+	return string(msg.Key), nil
+}
+
+func TestDeduplicate(t *testing.T) {
+	logger.SetNopLogger()
+
+	handlerErr := errors.New("handler failed")
+
+	tests := []struct {
+		name          string
+		store         *memoryStore
+		handlerErr    error
+		wantErr       error
+		wantCalls     int
+		wantProcessed bool
+	}{
+		{
+			name:          "new_event_processed_and_marked",
+			store:         &memoryStore{processed: map[string]bool{}},
+			wantCalls:     1,
+			wantProcessed: true,
+		},
+		{
+			name:          "duplicate_event_skipped",
+			store:         &memoryStore{processed: map[string]bool{"event": true}},
+			wantCalls:     0,
+			wantProcessed: true,
+		},
+		{
+			name:       "handler_error_not_marked",
+			store:      &memoryStore{processed: map[string]bool{}},
+			handlerErr: handlerErr,
+			wantErr:    handlerErr,
+			wantCalls:  1,
+		},
+		{
+			name:      "store_check_error",
+			store:     &memoryStore{processed: map[string]bool{}, checkErr: errors.New("store down")},
+			wantErr:   errors.New("store down"),
+			wantCalls: 0,
+		},
+		{
+			name:      "store_mark_error_ignored",
+			store:     &memoryStore{processed: map[string]bool{}, markErr: errors.New("store down")},
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			handler := Deduplicate(tt.store, keyAsEventID, logger.Logger())(func(context.Context, kafka.Message) error {
+				calls++
+				return tt.handlerErr
+			})
+
+			err := handler(context.Background(), kafka.Message{Key: []byte("event")})
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.wantCalls, calls)
+			require.Equal(t, tt.wantProcessed, tt.store.processed["event"])
+		})
+	}
+}
+
+func TestDeduplicateWithoutEventID(t *testing.T) {
+	logger.SetNopLogger()
+
+	store := &memoryStore{processed: map[string]bool{}}
+	noID := func(kafka.Message) (string, error) { return "", errors.New("malformed") }
+
+	calls := 0
+	handler := Deduplicate(store, noID, logger.Logger())(func(context.Context, kafka.Message) error {
+		calls++
+		return nil
+	})
+
+	require.NoError(t, handler(context.Background(), kafka.Message{}))
+	require.NoError(t, handler(context.Background(), kafka.Message{}))
+	require.Equal(t, 2, calls)
+	require.Empty(t, store.processed)
+}
+
+func TestProtoEventID(t *testing.T) {
+	eventID := ProtoEventID(func() *eventspb.OrderPaid { return &eventspb.OrderPaid{} })
+
+	data, err := proto.Marshal(&eventspb.OrderPaid{EventUuid: "550e8400-e29b-41d4-a716-446655440000"})
+	require.NoError(t, err)
+
+	id, err := eventID(kafka.Message{Value: data})
+	require.NoError(t, err)
+	require.Equal(t, "550e8400-e29b-41d4-a716-446655440000", id)
+
+	_, err = eventID(kafka.Message{Value: []byte{0xff}})
+	require.Error(t, err)
+}
@@ -0,0 +1,142 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+)
+
+// DeadLetterSuffix — суффикс топика, в который попадают необработанные сообщения.
+const DeadLetterSuffix = ".dlq"
+
+// Заголовки, которыми сопровождается сообщение в DLQ.
+const (
+	HeaderDLQError             = "x-dlq-error"
+	HeaderDLQAttempts          = "x-dlq-attempts"
+	HeaderDLQOriginalTopic     = "x-dlq-original-topic"
+	HeaderDLQOriginalPartition = "x-dlq-original-partition"
+	HeaderDLQOriginalOffset    = "x-dlq-original-offset"
+	HeaderDLQFailedAt          = "x-dlq-failed-at"
+)
+
+const dlqHeaderPrefix = "x-dlq-"
+
+// DeadLetterTopic возвращает имя DLQ-топика для исходного топика.
+func DeadLetterTopic(topic string) string {
+	return topic + DeadLetterSuffix
+}
+
+// DeadLetter — middleware, публикующее сообщение в <topic>.dlq, если обработчик вернул ошибку.
+// После успешной публикации сообщение считается обработанным и будет отмечено.
+// При отмене контекста (остановке сервиса) сообщение в DLQ не отправляется.
+func DeadLetter(producer sarama.SyncProducer, logger Logger) Middleware {
+	return func(next kafka.MessageHandler) kafka.MessageHandler {
+		return func(ctx context.Context, msg kafka.Message) error {
+			err := next(ctx, msg)
+			if err == nil || ctx.Err() != nil {
+				return err
+			}
+
+			dlqMsg := newDeadLetterMessage(msg, err, time.Now())
+
+			if _, _, sendErr := producer.SendMessage(dlqMsg); sendErr != nil {
+				logger.Error(ctx, "Failed to publish message to dead letter topic",
+					zap.Error(sendErr),
+					zap.String("topic", dlqMsg.Topic),
+				)
+
+				return errors.Join(err, fmt.Errorf("publish to dead letter topic: %w", sendErr))
+			}
+
+			logger.Error(ctx, "Message moved to dead letter topic",
+				zap.Error(err),
+				zap.String("topic", msg.Topic),
+				zap.String("dlq_topic", dlqMsg.Topic),
+				zap.Int32("partition", msg.Partition),
+				zap.Int64("offset", msg.Offset),
+			)
+
+			return nil
+		}
+	}
+}
+
+func newDeadLetterMessage(msg kafka.Message, handlerErr error, failedAt time.Time) *sarama.ProducerMessage {
+	attempts := 1
+
+	var exhausted *RetriesExhaustedError
+	if errors.As(handlerErr, &exhausted) {
+		attempts = exhausted.Attempts
+	}
+
+	headers := recordHeaders(msg.Headers)
+	headers = append(headers,
+		sarama.RecordHeader{Key: []byte(HeaderDLQError), Value: []byte(handlerErr.Error())},
+		sarama.RecordHeader{Key: []byte(HeaderDLQAttempts), Value: []byte(strconv.Itoa(attempts))},
+		sarama.RecordHeader{Key: []byte(HeaderDLQOriginalTopic), Value: []byte(msg.Topic)},
+		sarama.RecordHeader{Key: []byte(HeaderDLQOriginalPartition), Value: []byte(strconv.FormatInt(int64(msg.Partition), 10))},
+		sarama.RecordHeader{Key: []byte(HeaderDLQOriginalOffset), Value: []byte(strconv.FormatInt(msg.Offset, 10))},
+		sarama.RecordHeader{Key: []byte(HeaderDLQFailedAt), Value: []byte(failedAt.UTC().Format(time.RFC3339))},
+	)
+
+	return &sarama.ProducerMessage{
+		Topic:   DeadLetterTopic(msg.Topic),
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
+	}
+}
+
+// RedriveMessage формирует сообщение для возврата из DLQ в исходный топик.
+// Служебные заголовки DLQ отбрасываются, остальные сохраняются.
+func RedriveMessage(msg kafka.Message) (*sarama.ProducerMessage, error) {
+	topic := string(msg.Headers[HeaderDLQOriginalTopic])
+	if topic == "" {
+		topic = strings.TrimSuffix(msg.Topic, DeadLetterSuffix)
+	}
+
+	if topic == "" || topic == msg.Topic {
+		return nil, fmt.Errorf("cannot determine source topic for message from %s", msg.Topic)
+	}
+
+	headers := make(map[string][]byte, len(msg.Headers))
+
+	for key, value := range msg.Headers {
+		if !strings.HasPrefix(key, dlqHeaderPrefix) {
+			headers[key] = value
+		}
+	}
+
+	return &sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: recordHeaders(headers),
+	}, nil
+}
+
+// recordHeaders преобразует заголовки в формат sarama в детерминированном порядке.
+func recordHeaders(headers map[string][]byte) []sarama.RecordHeader {
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	result := make([]sarama.RecordHeader, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, sarama.RecordHeader{Key: []byte(key), Value: headers[key]})
+	}
+
+	return result
+}
@@ -0,0 +1,127 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+func headersMap(headers []sarama.RecordHeader) map[string]string {
+	result := make(map[string]string, len(headers))
+	for _, h := range headers {
+		result[string(h.Key)] = string(h.Value)
+	}
+
+	return result
+}
+
+func TestDeadLetter(t *testing.T) {
+	logger.SetNopLogger()
+
+	msg := kafka.Message{
+		Topic:     "order.paid",
+		Partition: 2,
+		Offset:    42,
+		Key:       []byte("order"),
+		Value:     []byte("payload"),
+		Headers:   map[string][]byte{"traceparent": []byte("trace")},
+	}
+
+	t.Run("handler_success_not_published", func(t *testing.T) {
+		producer := mocks.NewSyncProducer(t, nil)
+
+		handler := DeadLetter(producer, logger.Logger())(func(context.Context, kafka.Message) error { return nil })
+
+		require.NoError(t, handler(context.Background(), msg))
+		require.NoError(t, producer.Close())
+	})
+
+	t.Run("handler_error_published", func(t *testing.T) {
+		producer := mocks.NewSyncProducer(t, nil)
+		producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(pm *sarama.ProducerMessage) error {
+			require.Equal(t, "order.paid.dlq", pm.Topic)
+
+			headers := headersMap(pm.Headers)
+			require.Equal(t, "trace", headers["traceparent"])
+			require.Equal(t, "order.paid", headers[HeaderDLQOriginalTopic])
+			require.Equal(t, "2", headers[HeaderDLQOriginalPartition])
+			require.Equal(t, "42", headers[HeaderDLQOriginalOffset])
+			require.Equal(t, "3", headers[HeaderDLQAttempts])
+			require.Contains(t, headers[HeaderDLQError], "poison")
+
+			return nil
+		})
+
+		handler := DeadLetter(producer, logger.Logger())(func(context.Context, kafka.Message) error {
+			return &RetriesExhaustedError{Attempts: 3, Err: errors.New("poison")}
+		})
+
+		require.NoError(t, handler(context.Background(), msg))
+		require.NoError(t, producer.Close())
+	})
+
+	t.Run("publish_error_returned", func(t *testing.T) {
+		producer := mocks.NewSyncProducer(t, nil)
+		producer.ExpectSendMessageAndFail(errors.New("kafka down"))
+
+		handler := DeadLetter(producer, logger.Logger())(func(context.Context, kafka.Message) error {
+			return errors.New("poison")
+		})
+
+		require.Error(t, handler(context.Background(), msg))
+		require.NoError(t, producer.Close())
+	})
+
+	t.Run("context_cancelled_not_published", func(t *testing.T) {
+		producer := mocks.NewSyncProducer(t, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		handler := DeadLetter(producer, logger.Logger())(func(ctx context.Context, _ kafka.Message) error {
+			return ctx.Err()
+		})
+
+		require.ErrorIs(t, handler(ctx, msg), context.Canceled)
+		require.NoError(t, producer.Close())
+	})
+}
+
+func TestRedriveMessage(t *testing.T) {
+	dlqMsg := newDeadLetterMessage(kafka.Message{
+		Topic:   "order.paid",
+		Key:     []byte("order"),
+		Value:   []byte("payload"),
+		Headers: map[string][]byte{"traceparent": []byte("trace")},
+	}, errors.New("poison"), time.Now())
+
+	headers := make(map[string][]byte, len(dlqMsg.Headers))
+	for _, h := range dlqMsg.Headers {
+		headers[string(h.Key)] = h.Value
+	}
+
+	redrive, err := RedriveMessage(kafka.Message{
+		Topic:   dlqMsg.Topic,
+		Key:     []byte("order"),
+		Value:   []byte("payload"),
+		Headers: headers,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "order.paid", redrive.Topic)
+	require.Equal(t, map[string]string{"traceparent": "trace"}, headersMap(redrive.Headers))
+
+	redrive, err = RedriveMessage(kafka.Message{Topic: "order.cancelled.dlq"})
+	require.NoError(t, err)
+	require.Equal(t, "order.cancelled", redrive.Topic)
+
+	_, err = RedriveMessage(kafka.Message{Topic: "order.paid"})
+	require.Error(t, err)
+}
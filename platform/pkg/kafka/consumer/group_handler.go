@@ -1,6 +1,8 @@
 package consumer
 
 import (
+	"sync/atomic"
+
 	"github.com/IBM/sarama"
 	"go.uber.org/zap"
 
@@ -14,6 +16,8 @@ type Middleware func(next kafka.MessageHandler) kafka.MessageHandler
 type groupHandler struct {
 	handler kafka.MessageHandler
 	logger  Logger
+	// failed — claim сессии завершён ошибкой обработчика
+	failed atomic.Bool
 }
 
 // NewGroupHandler создаёт новый groupHandler с middleware цепочкой.
@@ -56,9 +60,19 @@ func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 				Headers:        extractHeaders(message.Headers),
 			}
 
+			// Необработанное сообщение не пропускаем: завершаем claim, чтобы после
+			// переподключения группы оно было прочитано повторно с последнего коммита
 			if err := g.handler(session.Context(), msg); err != nil {
-				g.logger.Error(session.Context(), "Kafka handler error", zap.Error(err))
-				continue
+				g.logger.Error(session.Context(), "Kafka handler error, stopping claim",
+					zap.Error(err),
+					zap.String("topic", message.Topic),
+					zap.Int32("partition", message.Partition),
+					zap.Int64("offset", message.Offset),
+				)
+
+				g.failed.Store(true)
+
+				return err
 			}
 
 			session.MarkMessage(message, "")
@@ -70,6 +84,11 @@ func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 	}
 }
 
+// takeFailure сообщает, завершался ли claim ошибкой обработчика с прошлого вызова, и сбрасывает признак.
+func (g *groupHandler) takeFailure() bool {
+	return g.failed.Swap(false)
+}
+
 func extractHeaders(headers []*sarama.RecordHeader) map[string][]byte {
 	headersMap := make(map[string][]byte)
 
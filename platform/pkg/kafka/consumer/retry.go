@@ -0,0 +1,92 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+)
+
+// RetryPolicy — политика повторной обработки сообщения с экспоненциальной паузой.
+type RetryPolicy struct {
+	// MaxAttempts — общее число попыток, включая первую.
+	MaxAttempts int
+	// InitialBackoff — пауза перед второй попыткой, далее удваивается.
+	InitialBackoff time.Duration
+	// MaxBackoff — верхняя граница паузы между попытками.
+	MaxBackoff time.Duration
+}
+
+// Backoff возвращает паузу после неудачной попытки с номером attempt (начиная с 1).
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || backoff < p.MaxBackoff); i++ {
+		backoff *= 2
+	}
+
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return backoff
+}
+
+// RetriesExhaustedError возвращается Retry, когда все попытки обработки исчерпаны.
+type RetriesExhaustedError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetriesExhaustedError) Error() string {
+	return fmt.Sprintf("handler failed after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RetriesExhaustedError) Unwrap() error {
+	return e.Err
+}
+
+// Retry — middleware, повторяющее обработку сообщения согласно политике.
+// При отмене контекста повторы прекращаются и возвращается последняя ошибка.
+func Retry(policy RetryPolicy, logger Logger) Middleware {
+	return func(next kafka.MessageHandler) kafka.MessageHandler {
+		return func(ctx context.Context, msg kafka.Message) error {
+			for attempt := 1; ; attempt++ {
+				err := next(ctx, msg)
+				if err == nil {
+					return nil
+				}
+
+				if ctx.Err() != nil {
+					return err
+				}
+
+				if attempt >= policy.MaxAttempts {
+					return &RetriesExhaustedError{Attempts: attempt, Err: err}
+				}
+
+				backoff := policy.Backoff(attempt)
+
+				logger.Error(ctx, "Kafka handler failed, retrying",
+					zap.Error(err),
+					zap.String("topic", msg.Topic),
+					zap.Int32("partition", msg.Partition),
+					zap.Int64("offset", msg.Offset),
+					zap.Int("attempt", attempt),
+					zap.Duration("backoff", backoff),
+				)
+
+				timer := time.NewTimer(backoff)
+
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return err
+				case <-timer.C:
+				}
+			}
+		}
+	}
+}
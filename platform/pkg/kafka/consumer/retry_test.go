@@ -0,0 +1,97 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/logger"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: 100 * time.Millisecond},
+		{attempt: 2, want: 200 * time.Millisecond},
+		{attempt: 3, want: 400 * time.Millisecond},
+		{attempt: 4, want: 800 * time.Millisecond},
+		{attempt: 5, want: time.Second},
+		{attempt: 50, want: time.Second},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, policy.Backoff(tt.attempt), "attempt %d", tt.attempt)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	logger.SetNopLogger()
+
+	handlerErr := errors.New("temporary failure")
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	tests := []struct {
+		name      string
+		failures  int
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "success_first_attempt", failures: 0, wantCalls: 1},
+		{name: "success_after_retries", failures: 2, wantCalls: 3},
+		{name: "retries_exhausted", failures: 10, wantCalls: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			handler := Retry(policy, logger.Logger())(func(context.Context, kafka.Message) error {
+				calls++
+				if calls <= tt.failures {
+					return handlerErr
+				}
+
+				return nil
+			})
+
+			err := handler(context.Background(), kafka.Message{})
+			require.Equal(t, tt.wantCalls, calls)
+
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+
+			var exhausted *RetriesExhaustedError
+			require.ErrorAs(t, err, &exhausted)
+			require.Equal(t, tt.wantCalls, exhausted.Attempts)
+			require.ErrorIs(t, err, handlerErr)
+		})
+	}
+}
+
+func TestRetryStopsOnContextCancel(t *testing.T) {
+	logger.SetNopLogger()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Hour}
+
+	calls := 0
+	handler := Retry(policy, logger.Logger())(func(context.Context, kafka.Message) error {
+		calls++
+		cancel()
+
+		return context.Canceled
+	})
+
+	err := handler(ctx, kafka.Message{})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, calls)
+}
@@ -0,0 +1,42 @@
+package consumer
+
+import (
+	"context"
+	"strconv"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
+)
+
+// Tracing — middleware, продолжающее трейс producer'а.
+// Извлекает контекст трассировки из заголовков сообщения и оборачивает обработку
+// в consumer-спан; без заголовков спан становится корневым.
+func Tracing() Middleware {
+	return func(next kafka.MessageHandler) kafka.MessageHandler {
+		return func(ctx context.Context, msg kafka.Message) error {
+			ctx = tracing.Extract(ctx, tracing.KafkaHeadersCarrier(msg.Headers))
+
+			ctx, span := tracing.StartSpan(ctx, msg.Topic+" process",
+				trace.WithSpanKind(trace.SpanKindConsumer),
+				trace.WithAttributes(
+					semconv.MessagingSystemKafka,
+					semconv.MessagingOperationTypeProcess,
+					semconv.MessagingDestinationName(msg.Topic),
+					semconv.MessagingDestinationPartitionID(strconv.FormatInt(int64(msg.Partition), 10)),
+					semconv.MessagingKafkaOffset(int(msg.Offset)),
+				),
+			)
+			defer span.End()
+
+			err := next(ctx, msg)
+			if err != nil {
+				span.RecordError(err)
+			}
+
+			return err
+		}
+	}
+}
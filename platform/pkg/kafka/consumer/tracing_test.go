@@ -0,0 +1,108 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/kafka"
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
+)
+
+// setupTestTracer устанавливает глобальный провайдер с записью спанов в память
+func setupTestTracer(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	prevProvider := otel.GetTracerProvider()
+	prevPropagator := otel.GetTextMapPropagator()
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	return recorder
+}
+
+func TestTracing(t *testing.T) {
+	handlerErr := errors.New("handler failed")
+
+	tests := []struct {
+		name       string
+		withParent bool
+		handlerErr error
+	}{
+		{name: "continues_producer_trace", withParent: true},
+		{name: "root_span_without_headers", withParent: false},
+		{name: "records_handler_error", withParent: true, handlerErr: handlerErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := setupTestTracer(t)
+
+			headers := map[string][]byte{}
+
+			var parent trace.SpanContext
+
+			if tt.withParent {
+				ctx, span := tracing.StartSpan(context.Background(), "order.paid send", trace.WithSpanKind(trace.SpanKindProducer))
+				tracing.Inject(ctx, tracing.KafkaHeadersCarrier(headers))
+				span.End()
+
+				parent = span.SpanContext()
+			}
+
+			var handlerSpan trace.SpanContext
+
+			handler := Tracing()(func(ctx context.Context, _ kafka.Message) error {
+				handlerSpan = trace.SpanContextFromContext(ctx)
+				return tt.handlerErr
+			})
+
+			err := handler(context.Background(), kafka.Message{Topic: "order.paid", Headers: headers})
+			require.ErrorIs(t, err, tt.handlerErr)
+
+			require.True(t, handlerSpan.IsValid())
+
+			if tt.withParent {
+				require.Equal(t, parent.TraceID(), handlerSpan.TraceID())
+			}
+
+			var consumerSpan sdktrace.ReadOnlySpan
+
+			for _, span := range recorder.Ended() {
+				if span.SpanKind() == trace.SpanKindConsumer {
+					consumerSpan = span
+				}
+			}
+
+			require.NotNil(t, consumerSpan)
+			require.Equal(t, "order.paid process", consumerSpan.Name())
+			require.Equal(t, handlerSpan.SpanID(), consumerSpan.SpanContext().SpanID())
+
+			if tt.withParent {
+				require.Equal(t, parent.SpanID(), consumerSpan.Parent().SpanID())
+			} else {
+				require.False(t, consumerSpan.Parent().IsValid())
+			}
+
+			if tt.handlerErr != nil {
+				require.NotEmpty(t, consumerSpan.Events())
+			}
+		})
+	}
+}
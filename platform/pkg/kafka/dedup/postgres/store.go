@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// DB — минимальный интерфейс пула соединений PostgreSQL.
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// Store хранит обработанные события в таблице processed_events.
+// Таблица создается миграцией сервиса:
+//
+//	CREATE TABLE processed_events (
+//	    consumer     TEXT        NOT NULL,
+//	    event_uuid   TEXT        NOT NULL,
+//	    processed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+//	    PRIMARY KEY (consumer, event_uuid)
+//	);
+type Store struct {
+	db       DB
+	consumer string
+}
+
+// NewStore создает хранилище обработанных событий для указанного consumer'а.
+// Идентификатор consumer'а разделяет события разных обработчиков одной таблицы.
+func NewStore(db DB, consumer string) *Store {
+	return &Store{
+		db:       db,
+		consumer: consumer,
+	}
+}
+
+// IsProcessed сообщает, было ли событие eventID уже обработано.
+func (s *Store) IsProcessed(ctx context.Context, eventID string) (bool, error) {
+	var exists bool
+
+	err := s.db.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM processed_events WHERE consumer = $1 AND event_uuid = $2)`,
+		s.consumer, eventID,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check processed event: %w", err)
+	}
+
+	return exists, nil
+}
+
+// MarkProcessed отмечает событие eventID как обработанное. Повторная отметка не считается ошибкой.
+func (s *Store) MarkProcessed(ctx context.Context, eventID string) error {
+	_, err := s.db.Exec(ctx,
+		`INSERT INTO processed_events (consumer, event_uuid) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+		s.consumer, eventID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark event as processed: %w", err)
+	}
+
+	return nil
+}
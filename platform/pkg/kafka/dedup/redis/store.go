@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Client — минимальный интерфейс Redis-клиента, необходимый хранилищу.
+type Client interface {
+	Exists(ctx context.Context, key string) (bool, error)
+	SetNXWithTTL(ctx context.Context, key string, value any, ttl time.Duration) (bool, error)
+}
+
+// Store хранит обработанные события в виде ключей <prefix>:<event_uuid> с TTL.
+type Store struct {
+	client Client
+	prefix string
+	ttl    time.Duration
+}
+
+// NewStore создает хранилище обработанных событий.
+// TTL должен превышать максимальное время, через которое событие может быть доставлено повторно.
+func NewStore(client Client, prefix string, ttl time.Duration) *Store {
+	return &Store{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+// IsProcessed сообщает, было ли событие eventID уже обработано.
+func (s *Store) IsProcessed(ctx context.Context, eventID string) (bool, error) {
+	exists, err := s.client.Exists(ctx, s.key(eventID))
+	if err != nil {
+		return false, fmt.Errorf("failed to check processed event: %w", err)
+	}
+
+	return exists, nil
+}
+
+// MarkProcessed отмечает событие eventID как обработанное. Повторная отметка не считается ошибкой.
+func (s *Store) MarkProcessed(ctx context.Context, eventID string) error {
+	if _, err := s.client.SetNXWithTTL(ctx, s.key(eventID), 1, s.ttl); err != nil {
+		return fmt.Errorf("failed to mark event as processed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) key(eventID string) string {
+	return s.prefix + ":" + eventID
+}
@@ -12,5 +12,7 @@ type Consumer interface {
 }
 
 type Producer interface {
-	Send(ctx context.Context, key, value []byte) error
+	// Send отправляет сообщение с указанными заголовками (headers может быть nil).
+	// Контекст трассировки из ctx добавляется в заголовки автоматически.
+	Send(ctx context.Context, key, value []byte, headers map[string][]byte) error
 }
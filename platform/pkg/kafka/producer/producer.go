@@ -2,9 +2,15 @@ package producer
 
 import (
 	"context"
+	"maps"
+	"sort"
 
 	"github.com/IBM/sarama"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+
+	"github.com/radiophysiker/microservices-homework/platform/pkg/tracing"
 )
 
 type Logger interface {
@@ -26,14 +32,34 @@ func NewProducer(syncProducer sarama.SyncProducer, topic string, logger Logger)
 	}
 }
 
-func (p *producer) Send(ctx context.Context, key, value []byte) error {
+// Send отправляет сообщение в топик в рамках producer-спана.
+// Контекст этого спана внедряется в заголовки, чтобы consumer продолжил тот же трейс.
+func (p *producer) Send(ctx context.Context, key, value []byte, headers map[string][]byte) error {
+	ctx, span := tracing.StartSpan(ctx, p.topic+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeSend,
+			semconv.MessagingDestinationName(p.topic),
+		),
+	)
+	defer span.End()
+
+	// Копируем заголовки, чтобы не изменять map вызывающей стороны
+	messageHeaders := make(map[string][]byte, len(headers))
+	maps.Copy(messageHeaders, headers)
+	tracing.Inject(ctx, tracing.KafkaHeadersCarrier(messageHeaders))
+
 	partition, offset, err := p.syncProducer.SendMessage(&sarama.ProducerMessage{
-		Topic: p.topic,
-		Key:   sarama.ByteEncoder(key),
-		Value: sarama.ByteEncoder(value),
+		Topic:   p.topic,
+		Key:     sarama.ByteEncoder(key),
+		Value:   sarama.ByteEncoder(value),
+		Headers: recordHeaders(messageHeaders),
 	})
 	if err != nil {
+		span.RecordError(err)
 		p.logger.Error(ctx, "Failed to send message", zap.Error(err))
+
 		return err
 	}
 
@@ -47,3 +73,20 @@ func (p *producer) Send(ctx context.Context, key, value []byte) error {
 
 	return nil
 }
+
+// recordHeaders конвертирует заголовки в формат sarama в детерминированном порядке
+func recordHeaders(headers map[string][]byte) []sarama.RecordHeader {
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	result := make([]sarama.RecordHeader, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, sarama.RecordHeader{Key: []byte(key), Value: headers[key]})
+	}
+
+	return result
+}
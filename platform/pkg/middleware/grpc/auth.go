@@ -32,14 +32,20 @@ type IAMClient = authV1.AuthServiceClient
 
 // AuthInterceptor interceptor для аутентификации gRPC запросов
 type AuthInterceptor struct {
-	iamClient IAMClient
+	iamClient    IAMClient
+	serviceToken string
 }
 
 // NewAuthInterceptor создает новый interceptor аутентификации
-func NewAuthInterceptor(iamClient IAMClient) *AuthInterceptor {
-	return &AuthInterceptor{
+func NewAuthInterceptor(iamClient IAMClient, opts ...AuthInterceptorOption) *AuthInterceptor {
+	i := &AuthInterceptor{
 		iamClient: iamClient,
 	}
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	return i
 }
 
 // Unary возвращает unary server interceptor для аутентификации
@@ -67,6 +73,11 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, er
 		return nil, status.Error(codes.Unauthenticated, "missing metadata")
 	}
 
+	// Внутренние вызовы других сервисов (фоновые задачи, консьюмеры) идут без сессии
+	if i.isValidServiceToken(md) {
+		return context.WithValue(ctx, serviceCallContextKey, true), nil
+	}
+
 	// Получаем session UUID из metadata
 	sessionUUIDs := md.Get(SessionUUIDMetadataKey)
 	if len(sessionUUIDs) == 0 {
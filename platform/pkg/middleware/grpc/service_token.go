@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ServiceTokenMetadataKey ключ для передачи сервисного токена в gRPC metadata
+const ServiceTokenMetadataKey = "x-service-token"
+
+// serviceCallContextKey ключ, которым помечается контекст внутреннего вызова между сервисами
+const serviceCallContextKey contextKey = "service-call"
+
+// AuthInterceptorOption настраивает AuthInterceptor
+type AuthInterceptorOption func(*AuthInterceptor)
+
+// WithServiceToken разрешает внутренние вызовы без пользовательской сессии,
+// если в metadata передан совпадающий сервисный токен. Пустой токен ничего не включает
+func WithServiceToken(token string) AuthInterceptorOption {
+	return func(i *AuthInterceptor) {
+		i.serviceToken = token
+	}
+}
+
+// ServiceTokenClientInterceptor возвращает unary client interceptor,
+// который добавляет сервисный токен в исходящие gRPC metadata
+func ServiceTokenClientInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, ServiceTokenMetadataKey, token)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// ServiceTokenInterceptor возвращает unary server interceptor для сервисов, которые вызывают только
+// другие сервисы: запрос без совпадающего сервисного токена отклоняется с Unauthenticated.
+// Методы publicMethods (полные имена gRPC) пропускаются без проверки, их защищает сам обработчик.
+// Пустой токен не пропускает ни одного вызова, кроме publicMethods
+func ServiceTokenInterceptor(token string, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := make(map[string]struct{}, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = struct{}{}
+	}
+
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		if !validServiceToken(md, token) {
+			return nil, status.Error(codes.Unauthenticated, "missing or invalid service token")
+		}
+
+		return handler(context.WithValue(ctx, serviceCallContextKey, true), req)
+	}
+}
+
+// IsServiceCall сообщает, что запрос аутентифицирован сервисным токеном, а не сессией пользователя
+func IsServiceCall(ctx context.Context) bool {
+	ok, _ := ctx.Value(serviceCallContextKey).(bool)
+	return ok
+}
+
+// isValidServiceToken проверяет сервисный токен из metadata за постоянное время
+func (i *AuthInterceptor) isValidServiceToken(md metadata.MD) bool {
+	return validServiceToken(md, i.serviceToken)
+}
+
+// validServiceToken сравнивает сервисный токен из metadata с token за постоянное время.
+// Пустой token не совпадает ни с чем
+func validServiceToken(md metadata.MD, token string) bool {
+	if token == "" {
+		return false
+	}
+
+	tokens := md.Get(ServiceTokenMetadataKey)
+	if len(tokens) == 0 {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(token)) == 1
+}
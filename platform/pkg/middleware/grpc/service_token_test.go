@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	authV1 "github.com/radiophysiker/microservices-homework/shared/pkg/proto/auth/v1"
+	commonV1 "github.com/radiophysiker/microservices-homework/shared/pkg/proto/common/v1"
+)
+
+type stubIAMClient struct {
+	authV1.AuthServiceClient
+	whoamiCalls int
+}
+
+func (c *stubIAMClient) Whoami(_ context.Context, _ *authV1.WhoamiRequest, _ ...grpc.CallOption) (*authV1.WhoamiResponse, error) {
+	c.whoamiCalls++
+	return &authV1.WhoamiResponse{User: &commonV1.User{Uuid: "user"}}, nil
+}
+
+func TestAuthInterceptorServiceToken(t *testing.T) {
+	tests := []struct {
+		name            string
+		serviceToken    string
+		md              metadata.MD
+		wantCode        codes.Code
+		wantServiceCall bool
+		wantWhoamiCalls int
+	}{
+		{
+			name:            "valid_service_token",
+			serviceToken:    "secret",
+			md:              metadata.Pairs(ServiceTokenMetadataKey, "secret"),
+			wantCode:        codes.OK,
+			wantServiceCall: true,
+		},
+		{
+			name:         "wrong_service_token_without_session",
+			serviceToken: "secret",
+			md:           metadata.Pairs(ServiceTokenMetadataKey, "other"),
+			wantCode:     codes.Unauthenticated,
+		},
+		{
+			name:     "service_token_not_configured",
+			md:       metadata.Pairs(ServiceTokenMetadataKey, ""),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:            "session_without_service_token",
+			serviceToken:    "secret",
+			md:              metadata.Pairs(SessionUUIDMetadataKey, "session"),
+			wantCode:        codes.OK,
+			wantWhoamiCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iam := &stubIAMClient{}
+			interceptor := NewAuthInterceptor(iam, WithServiceToken(tt.serviceToken))
+
+			var serviceCall bool
+			handler := func(ctx context.Context, _ any) (any, error) {
+				serviceCall = IsServiceCall(ctx)
+				return nil, nil
+			}
+
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			_, err := interceptor.Unary()(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			require.Equal(t, tt.wantServiceCall, serviceCall)
+			require.Equal(t, tt.wantWhoamiCalls, iam.whoamiCalls)
+		})
+	}
+}
+
+func TestServiceTokenClientInterceptor(t *testing.T) {
+	var got metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		got, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	err := ServiceTokenClientInterceptor("secret")(context.Background(), "/method", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	require.Equal(t, []string{"secret"}, got.Get(ServiceTokenMetadataKey))
+}
+
+func TestServiceTokenInterceptor(t *testing.T) {
+	const publicMethod = "/svc.Service/Callback"
+
+	tests := []struct {
+		name            string
+		token           string
+		method          string
+		md              metadata.MD
+		wantCode        codes.Code
+		wantServiceCall bool
+	}{
+		{
+			name:            "valid_service_token",
+			token:           "secret",
+			method:          "/svc.Service/Pay",
+			md:              metadata.Pairs(ServiceTokenMetadataKey, "secret"),
+			wantCode:        codes.OK,
+			wantServiceCall: true,
+		},
+		{
+			name:     "wrong_service_token",
+			token:    "secret",
+			method:   "/svc.Service/Pay",
+			md:       metadata.Pairs(ServiceTokenMetadataKey, "other"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "session_is_not_enough",
+			token:    "secret",
+			method:   "/svc.Service/Pay",
+			md:       metadata.Pairs(SessionUUIDMetadataKey, "session"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "token_not_configured",
+			method:   "/svc.Service/Pay",
+			md:       metadata.Pairs(ServiceTokenMetadataKey, ""),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "public_method_without_token",
+			token:    "secret",
+			method:   publicMethod,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var serviceCall bool
+			handler := func(ctx context.Context, _ any) (any, error) {
+				serviceCall = IsServiceCall(ctx)
+				return nil, nil
+			}
+
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			interceptor := ServiceTokenInterceptor(tt.token, publicMethod)
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			require.Equal(t, tt.wantServiceCall, serviceCall)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// KafkaHeadersCarrier - это адаптер между заголовками Kafka-сообщения и текстовым отображением OpenTelemetry.
+// Реализует интерфейс TextMapCarrier для пропагации контекста трассировки через Kafka.
+type KafkaHeadersCarrier map[string][]byte
+
+// Get возвращает значение для указанного ключа.
+func (kc KafkaHeadersCarrier) Get(key string) string {
+	return string(kc[key])
+}
+
+// Set устанавливает значение для указанного ключа.
+func (kc KafkaHeadersCarrier) Set(key, value string) {
+	kc[key] = []byte(value)
+}
+
+// Keys возвращает список всех ключей.
+func (kc KafkaHeadersCarrier) Keys() []string {
+	keys := make([]string, 0, len(kc))
+	for k := range kc {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Inject внедряет контекст трассировки из ctx в carrier с помощью глобального пропагатора.
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+}
+
+// Extract извлекает контекст трассировки из carrier и возвращает обогащенный контекст.
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
@@ -9,10 +9,18 @@ type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type GetOrderHistoryRes interface {
+	getOrderHistoryRes()
+}
+
 type GetOrderRes interface {
 	getOrderRes()
 }
 
+type ListOrdersRes interface {
+	listOrdersRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
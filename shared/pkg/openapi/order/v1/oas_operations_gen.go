@@ -6,8 +6,10 @@ package orderv1
 type OperationName = string
 
 const (
-	CancelOrderOperation OperationName = "CancelOrder"
-	CreateOrderOperation OperationName = "CreateOrder"
-	GetOrderOperation    OperationName = "GetOrder"
-	PayOrderOperation    OperationName = "PayOrder"
+	CancelOrderOperation     OperationName = "CancelOrder"
+	CreateOrderOperation     OperationName = "CreateOrder"
+	GetOrderOperation        OperationName = "GetOrder"
+	GetOrderHistoryOperation OperationName = "GetOrderHistory"
+	ListOrdersOperation      OperationName = "ListOrders"
+	PayOrderOperation        OperationName = "PayOrder"
 )
@@ -4,6 +4,7 @@ package orderv1
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -42,10 +43,12 @@ func (s *BadRequestError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*BadRequestError) cancelOrderRes() {}
-func (*BadRequestError) createOrderRes() {}
-func (*BadRequestError) getOrderRes()    {}
-func (*BadRequestError) payOrderRes()    {}
+func (*BadRequestError) cancelOrderRes()     {}
+func (*BadRequestError) createOrderRes()     {}
+func (*BadRequestError) getOrderHistoryRes() {}
+func (*BadRequestError) getOrderRes()        {}
+func (*BadRequestError) listOrdersRes()      {}
+func (*BadRequestError) payOrderRes()        {}
 
 // Merged schema.
 type BadRequestErrorError string
@@ -155,31 +158,47 @@ func (s *ConflictErrorError) UnmarshalText(data []byte) error {
 }
 
 // Ref: #
-type CreateOrderRequest struct {
-	// UUID пользователя.
-	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список UUID деталей для заказа.
-	PartUuids []uuid.UUID `json:"part_uuids"`
+type CreateOrderItem struct {
+	// UUID детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество деталей.
+	Quantity int32 `json:"quantity"`
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *CreateOrderRequest) GetUserUUID() uuid.UUID {
-	return s.UserUUID
+// GetPartUUID returns the value of PartUUID.
+func (s *CreateOrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
 }
 
-// GetPartUuids returns the value of PartUuids.
-func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
-	return s.PartUuids
+// GetQuantity returns the value of Quantity.
+func (s *CreateOrderItem) GetQuantity() int32 {
+	return s.Quantity
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
-	s.UserUUID = val
+// SetPartUUID sets the value of PartUUID.
+func (s *CreateOrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
 }
 
-// SetPartUuids sets the value of PartUuids.
-func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
-	s.PartUuids = val
+// SetQuantity sets the value of Quantity.
+func (s *CreateOrderItem) SetQuantity(val int32) {
+	s.Quantity = val
+}
+
+// Ref: #
+type CreateOrderRequest struct {
+	// Список позиций заказа.
+	Items []CreateOrderItem `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *CreateOrderRequest) GetItems() []CreateOrderItem {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *CreateOrderRequest) SetItems(val []CreateOrderItem) {
+	s.Items = val
 }
 
 // Ref: #
@@ -212,6 +231,76 @@ func (s *CreateOrderResponse) SetTotalPrice(val float64) {
 
 func (*CreateOrderResponse) createOrderRes() {}
 
+// Merged schema.
+// Ref: #
+type ForbiddenError struct {
+	// Merged property.
+	Error ForbiddenErrorError `json:"error"`
+	// Merged property.
+	Message string `json:"message"`
+}
+
+// GetError returns the value of Error.
+func (s *ForbiddenError) GetError() ForbiddenErrorError {
+	return s.Error
+}
+
+// GetMessage returns the value of Message.
+func (s *ForbiddenError) GetMessage() string {
+	return s.Message
+}
+
+// SetError sets the value of Error.
+func (s *ForbiddenError) SetError(val ForbiddenErrorError) {
+	s.Error = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ForbiddenError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ForbiddenError) cancelOrderRes()     {}
+func (*ForbiddenError) getOrderHistoryRes() {}
+func (*ForbiddenError) getOrderRes()        {}
+func (*ForbiddenError) listOrdersRes()      {}
+func (*ForbiddenError) payOrderRes()        {}
+
+// Merged schema.
+type ForbiddenErrorError string
+
+const (
+	ForbiddenErrorErrorForbidden ForbiddenErrorError = "forbidden"
+)
+
+// AllValues returns all ForbiddenErrorError values.
+func (ForbiddenErrorError) AllValues() []ForbiddenErrorError {
+	return []ForbiddenErrorError{
+		ForbiddenErrorErrorForbidden,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ForbiddenErrorError) MarshalText() ([]byte, error) {
+	switch s {
+	case ForbiddenErrorErrorForbidden:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ForbiddenErrorError) UnmarshalText(data []byte) error {
+	switch ForbiddenErrorError(data) {
+	case ForbiddenErrorErrorForbidden:
+		*s = ForbiddenErrorErrorForbidden
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #
 type GenericError struct {
 	// Код ошибки.
@@ -266,6 +355,24 @@ func (s *GenericErrorStatusCode) SetResponse(val GenericError) {
 	s.Response = val
 }
 
+// Ref: #
+type GetOrderHistoryResponse struct {
+	// Изменения статуса заказа в хронологическом порядке.
+	Entries []OrderStatusHistoryEntry `json:"entries"`
+}
+
+// GetEntries returns the value of Entries.
+func (s *GetOrderHistoryResponse) GetEntries() []OrderStatusHistoryEntry {
+	return s.Entries
+}
+
+// SetEntries sets the value of Entries.
+func (s *GetOrderHistoryResponse) SetEntries(val []OrderStatusHistoryEntry) {
+	s.Entries = val
+}
+
+func (*GetOrderHistoryResponse) getOrderHistoryRes() {}
+
 // Merged schema.
 // Ref: #
 type InternalServerError struct {
@@ -295,10 +402,12 @@ func (s *InternalServerError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*InternalServerError) cancelOrderRes() {}
-func (*InternalServerError) createOrderRes() {}
-func (*InternalServerError) getOrderRes()    {}
-func (*InternalServerError) payOrderRes()    {}
+func (*InternalServerError) cancelOrderRes()     {}
+func (*InternalServerError) createOrderRes()     {}
+func (*InternalServerError) getOrderHistoryRes() {}
+func (*InternalServerError) getOrderRes()        {}
+func (*InternalServerError) listOrdersRes()      {}
+func (*InternalServerError) payOrderRes()        {}
 
 // Merged schema.
 type InternalServerErrorError string
@@ -335,6 +444,37 @@ func (s *InternalServerErrorError) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #
+type ListOrdersResponse struct {
+	// Заказы текущей страницы.
+	Orders []OrderDto `json:"orders"`
+	// Курсор следующей страницы; пустой, если страниц
+	// больше нет.
+	NextPageToken OptString `json:"next_page_token"`
+}
+
+// GetOrders returns the value of Orders.
+func (s *ListOrdersResponse) GetOrders() []OrderDto {
+	return s.Orders
+}
+
+// GetNextPageToken returns the value of NextPageToken.
+func (s *ListOrdersResponse) GetNextPageToken() OptString {
+	return s.NextPageToken
+}
+
+// SetOrders sets the value of Orders.
+func (s *ListOrdersResponse) SetOrders(val []OrderDto) {
+	s.Orders = val
+}
+
+// SetNextPageToken sets the value of NextPageToken.
+func (s *ListOrdersResponse) SetNextPageToken(val OptString) {
+	s.NextPageToken = val
+}
+
+func (*ListOrdersResponse) listOrdersRes() {}
+
 // NewNilOrderDtoPaymentMethod returns new NilOrderDtoPaymentMethod with value set to v.
 func NewNilOrderDtoPaymentMethod(v OrderDtoPaymentMethod) NilOrderDtoPaymentMethod {
 	return NilOrderDtoPaymentMethod{
@@ -380,6 +520,51 @@ func (o NilOrderDtoPaymentMethod) Or(d OrderDtoPaymentMethod) OrderDtoPaymentMet
 	return d
 }
 
+// NewNilOrderStatusHistoryEntryFromStatus returns new NilOrderStatusHistoryEntryFromStatus with value set to v.
+func NewNilOrderStatusHistoryEntryFromStatus(v OrderStatusHistoryEntryFromStatus) NilOrderStatusHistoryEntryFromStatus {
+	return NilOrderStatusHistoryEntryFromStatus{
+		Value: v,
+	}
+}
+
+// NilOrderStatusHistoryEntryFromStatus is nullable OrderStatusHistoryEntryFromStatus.
+type NilOrderStatusHistoryEntryFromStatus struct {
+	Value OrderStatusHistoryEntryFromStatus
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilOrderStatusHistoryEntryFromStatus) SetTo(v OrderStatusHistoryEntryFromStatus) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilOrderStatusHistoryEntryFromStatus) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilOrderStatusHistoryEntryFromStatus) SetToNull() {
+	o.Null = true
+	var v OrderStatusHistoryEntryFromStatus
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilOrderStatusHistoryEntryFromStatus) Get() (v OrderStatusHistoryEntryFromStatus, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilOrderStatusHistoryEntryFromStatus) Or(d OrderStatusHistoryEntryFromStatus) OrderStatusHistoryEntryFromStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Merged schema.
 // Ref: #
 type NotFoundError struct {
@@ -409,9 +594,10 @@ func (s *NotFoundError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*NotFoundError) cancelOrderRes() {}
-func (*NotFoundError) getOrderRes()    {}
-func (*NotFoundError) payOrderRes()    {}
+func (*NotFoundError) cancelOrderRes()     {}
+func (*NotFoundError) getOrderHistoryRes() {}
+func (*NotFoundError) getOrderRes()        {}
+func (*NotFoundError) payOrderRes()        {}
 
 // Merged schema.
 type NotFoundErrorError string
@@ -448,6 +634,98 @@ func (s *NotFoundErrorError) UnmarshalText(data []byte) error {
 	}
 }
 
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilUUID returns new OptNilUUID with value set to v.
 func NewOptNilUUID(v uuid.UUID) OptNilUUID {
 	return OptNilUUID{
@@ -511,6 +789,98 @@ func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
 	return d
 }
 
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Ref: #
 type OrderDto struct {
 	// UUID заказа.
@@ -526,6 +896,8 @@ type OrderDto struct {
 	// Способ оплаты (если заказ оплачен).
 	PaymentMethod *NilOrderDtoPaymentMethod `json:"payment_method"`
 	Status        OrderStatus               `json:"status"`
+	// Позиции заказа с ценой на момент оформления.
+	Items []OrderItem `json:"items"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -563,6 +935,11 @@ func (s *OrderDto) GetStatus() OrderStatus {
 	return s.Status
 }
 
+// GetItems returns the value of Items.
+func (s *OrderDto) GetItems() []OrderItem {
+	return s.Items
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *OrderDto) SetOrderUUID(val uuid.UUID) {
 	s.OrderUUID = val
@@ -598,6 +975,11 @@ func (s *OrderDto) SetStatus(val OrderStatus) {
 	s.Status = val
 }
 
+// SetItems sets the value of Items.
+func (s *OrderDto) SetItems(val []OrderItem) {
+	s.Items = val
+}
+
 func (*OrderDto) getOrderRes() {}
 
 // Merged schema.
@@ -663,15 +1045,82 @@ func (s *OrderDtoPaymentMethod) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #
+type OrderItem struct {
+	// UUID детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество деталей.
+	Quantity int32 `json:"quantity"`
+	// Цена за единицу на момент оформления заказа.
+	UnitPrice float64 `json:"unit_price"`
+	// Название детали на момент оформления заказа.
+	Name string `json:"name"`
+	// Категория детали на момент оформления заказа.
+	Category string `json:"category"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItem) GetQuantity() int32 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *OrderItem) GetUnitPrice() float64 {
+	return s.UnitPrice
+}
+
+// GetName returns the value of Name.
+func (s *OrderItem) GetName() string {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *OrderItem) GetCategory() string {
+	return s.Category
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItem) SetQuantity(val int32) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *OrderItem) SetUnitPrice(val float64) {
+	s.UnitPrice = val
+}
+
+// SetName sets the value of Name.
+func (s *OrderItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *OrderItem) SetCategory(val string) {
+	s.Category = val
+}
+
 // Статус заказа.
 // Ref: #
 type OrderStatus string
 
 const (
-	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPAID           OrderStatus = "PAID"
-	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
-	OrderStatusASSEMBLED      OrderStatus = "ASSEMBLED"
+	OrderStatusPENDINGPAYMENT    OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPAID              OrderStatus = "PAID"
+	OrderStatusCANCELLED         OrderStatus = "CANCELLED"
+	OrderStatusASSEMBLED         OrderStatus = "ASSEMBLED"
+	OrderStatusREFUNDED          OrderStatus = "REFUNDED"
+	OrderStatusPAYMENTPROCESSING OrderStatus = "PAYMENT_PROCESSING"
+	OrderStatusREFUNDING         OrderStatus = "REFUNDING"
 )
 
 // AllValues returns all OrderStatus values.
@@ -681,6 +1130,9 @@ func (OrderStatus) AllValues() []OrderStatus {
 		OrderStatusPAID,
 		OrderStatusCANCELLED,
 		OrderStatusASSEMBLED,
+		OrderStatusREFUNDED,
+		OrderStatusPAYMENTPROCESSING,
+		OrderStatusREFUNDING,
 	}
 }
 
@@ -695,6 +1147,12 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case OrderStatusASSEMBLED:
 		return []byte(s), nil
+	case OrderStatusREFUNDED:
+		return []byte(s), nil
+	case OrderStatusPAYMENTPROCESSING:
+		return []byte(s), nil
+	case OrderStatusREFUNDING:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -715,6 +1173,157 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	case OrderStatusASSEMBLED:
 		*s = OrderStatusASSEMBLED
 		return nil
+	case OrderStatusREFUNDED:
+		*s = OrderStatusREFUNDED
+		return nil
+	case OrderStatusPAYMENTPROCESSING:
+		*s = OrderStatusPAYMENTPROCESSING
+		return nil
+	case OrderStatusREFUNDING:
+		*s = OrderStatusREFUNDING
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #
+type OrderStatusHistoryEntry struct {
+	// Предыдущий статус (отсутствует для записи о создании
+	// заказа).
+	FromStatus *NilOrderStatusHistoryEntryFromStatus `json:"from_status"`
+	ToStatus   OrderStatus                           `json:"to_status"`
+	// Инициатор изменения - UUID пользователя или имя
+	// системного компонента.
+	Actor string `json:"actor"`
+	// UUID события, вызвавшего изменение.
+	EventUUID OptNilUUID `json:"event_uuid"`
+	// Время изменения статуса.
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// GetFromStatus returns the value of FromStatus.
+func (s *OrderStatusHistoryEntry) GetFromStatus() *NilOrderStatusHistoryEntryFromStatus {
+	return s.FromStatus
+}
+
+// GetToStatus returns the value of ToStatus.
+func (s *OrderStatusHistoryEntry) GetToStatus() OrderStatus {
+	return s.ToStatus
+}
+
+// GetActor returns the value of Actor.
+func (s *OrderStatusHistoryEntry) GetActor() string {
+	return s.Actor
+}
+
+// GetEventUUID returns the value of EventUUID.
+func (s *OrderStatusHistoryEntry) GetEventUUID() OptNilUUID {
+	return s.EventUUID
+}
+
+// GetChangedAt returns the value of ChangedAt.
+func (s *OrderStatusHistoryEntry) GetChangedAt() time.Time {
+	return s.ChangedAt
+}
+
+// SetFromStatus sets the value of FromStatus.
+func (s *OrderStatusHistoryEntry) SetFromStatus(val *NilOrderStatusHistoryEntryFromStatus) {
+	s.FromStatus = val
+}
+
+// SetToStatus sets the value of ToStatus.
+func (s *OrderStatusHistoryEntry) SetToStatus(val OrderStatus) {
+	s.ToStatus = val
+}
+
+// SetActor sets the value of Actor.
+func (s *OrderStatusHistoryEntry) SetActor(val string) {
+	s.Actor = val
+}
+
+// SetEventUUID sets the value of EventUUID.
+func (s *OrderStatusHistoryEntry) SetEventUUID(val OptNilUUID) {
+	s.EventUUID = val
+}
+
+// SetChangedAt sets the value of ChangedAt.
+func (s *OrderStatusHistoryEntry) SetChangedAt(val time.Time) {
+	s.ChangedAt = val
+}
+
+// Merged schema.
+type OrderStatusHistoryEntryFromStatus string
+
+const (
+	OrderStatusHistoryEntryFromStatusPENDINGPAYMENT    OrderStatusHistoryEntryFromStatus = "PENDING_PAYMENT"
+	OrderStatusHistoryEntryFromStatusPAID              OrderStatusHistoryEntryFromStatus = "PAID"
+	OrderStatusHistoryEntryFromStatusCANCELLED         OrderStatusHistoryEntryFromStatus = "CANCELLED"
+	OrderStatusHistoryEntryFromStatusASSEMBLED         OrderStatusHistoryEntryFromStatus = "ASSEMBLED"
+	OrderStatusHistoryEntryFromStatusREFUNDED          OrderStatusHistoryEntryFromStatus = "REFUNDED"
+	OrderStatusHistoryEntryFromStatusPAYMENTPROCESSING OrderStatusHistoryEntryFromStatus = "PAYMENT_PROCESSING"
+	OrderStatusHistoryEntryFromStatusREFUNDING         OrderStatusHistoryEntryFromStatus = "REFUNDING"
+)
+
+// AllValues returns all OrderStatusHistoryEntryFromStatus values.
+func (OrderStatusHistoryEntryFromStatus) AllValues() []OrderStatusHistoryEntryFromStatus {
+	return []OrderStatusHistoryEntryFromStatus{
+		OrderStatusHistoryEntryFromStatusPENDINGPAYMENT,
+		OrderStatusHistoryEntryFromStatusPAID,
+		OrderStatusHistoryEntryFromStatusCANCELLED,
+		OrderStatusHistoryEntryFromStatusASSEMBLED,
+		OrderStatusHistoryEntryFromStatusREFUNDED,
+		OrderStatusHistoryEntryFromStatusPAYMENTPROCESSING,
+		OrderStatusHistoryEntryFromStatusREFUNDING,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrderStatusHistoryEntryFromStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OrderStatusHistoryEntryFromStatusPENDINGPAYMENT:
+		return []byte(s), nil
+	case OrderStatusHistoryEntryFromStatusPAID:
+		return []byte(s), nil
+	case OrderStatusHistoryEntryFromStatusCANCELLED:
+		return []byte(s), nil
+	case OrderStatusHistoryEntryFromStatusASSEMBLED:
+		return []byte(s), nil
+	case OrderStatusHistoryEntryFromStatusREFUNDED:
+		return []byte(s), nil
+	case OrderStatusHistoryEntryFromStatusPAYMENTPROCESSING:
+		return []byte(s), nil
+	case OrderStatusHistoryEntryFromStatusREFUNDING:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrderStatusHistoryEntryFromStatus) UnmarshalText(data []byte) error {
+	switch OrderStatusHistoryEntryFromStatus(data) {
+	case OrderStatusHistoryEntryFromStatusPENDINGPAYMENT:
+		*s = OrderStatusHistoryEntryFromStatusPENDINGPAYMENT
+		return nil
+	case OrderStatusHistoryEntryFromStatusPAID:
+		*s = OrderStatusHistoryEntryFromStatusPAID
+		return nil
+	case OrderStatusHistoryEntryFromStatusCANCELLED:
+		*s = OrderStatusHistoryEntryFromStatusCANCELLED
+		return nil
+	case OrderStatusHistoryEntryFromStatusASSEMBLED:
+		*s = OrderStatusHistoryEntryFromStatusASSEMBLED
+		return nil
+	case OrderStatusHistoryEntryFromStatusREFUNDED:
+		*s = OrderStatusHistoryEntryFromStatusREFUNDED
+		return nil
+	case OrderStatusHistoryEntryFromStatusPAYMENTPROCESSING:
+		*s = OrderStatusHistoryEntryFromStatusPAYMENTPROCESSING
+		return nil
+	case OrderStatusHistoryEntryFromStatusREFUNDING:
+		*s = OrderStatusHistoryEntryFromStatusREFUNDING
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -723,6 +1332,8 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 // Ref: #
 type PayOrderRequest struct {
 	PaymentMethod PaymentMethod `json:"payment_method"`
+	// Токен карты; обязателен для способов CARD и CREDIT_CARD.
+	CardToken OptString `json:"card_token"`
 }
 
 // GetPaymentMethod returns the value of PaymentMethod.
@@ -730,15 +1341,28 @@ func (s *PayOrderRequest) GetPaymentMethod() PaymentMethod {
 	return s.PaymentMethod
 }
 
+// GetCardToken returns the value of CardToken.
+func (s *PayOrderRequest) GetCardToken() OptString {
+	return s.CardToken
+}
+
 // SetPaymentMethod sets the value of PaymentMethod.
 func (s *PayOrderRequest) SetPaymentMethod(val PaymentMethod) {
 	s.PaymentMethod = val
 }
 
+// SetCardToken sets the value of CardToken.
+func (s *PayOrderRequest) SetCardToken(val OptString) {
+	s.CardToken = val
+}
+
 // Ref: #
 type PayOrderResponse struct {
 	// UUID транзакции оплаты.
 	TransactionUUID uuid.UUID `json:"transaction_uuid"`
+	// PAID для завершенной оплаты, PAYMENT_PROCESSING для оплаты,
+	// ожидающей подтверждения провайдера.
+	Status OrderStatus `json:"status"`
 }
 
 // GetTransactionUUID returns the value of TransactionUUID.
@@ -746,11 +1370,21 @@ func (s *PayOrderResponse) GetTransactionUUID() uuid.UUID {
 	return s.TransactionUUID
 }
 
+// GetStatus returns the value of Status.
+func (s *PayOrderResponse) GetStatus() OrderStatus {
+	return s.Status
+}
+
 // SetTransactionUUID sets the value of TransactionUUID.
 func (s *PayOrderResponse) SetTransactionUUID(val uuid.UUID) {
 	s.TransactionUUID = val
 }
 
+// SetStatus sets the value of Status.
+func (s *PayOrderResponse) SetStatus(val OrderStatus) {
+	s.Status = val
+}
+
 func (*PayOrderResponse) payOrderRes() {}
 
 // Способ оплаты.
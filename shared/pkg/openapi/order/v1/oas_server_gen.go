@@ -10,7 +10,8 @@ import (
 type Handler interface {
 	// CancelOrder implements cancelOrder operation.
 	//
-	// Отменяет заказ, если он ещё не оплачен.
+	// Отменяет неоплаченный заказ. Оплаченный, но ещё не
+	// собранный заказ отменяется с возвратом средств.
 	//
 	// POST /api/v1/orders/{order_uuid}/cancel
 	CancelOrder(ctx context.Context, params CancelOrderParams) (CancelOrderRes, error)
@@ -20,13 +21,28 @@ type Handler interface {
 	// пользователем деталей.
 	//
 	// POST /api/v1/orders
-	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
+	CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (CreateOrderRes, error)
 	// GetOrder implements getOrder operation.
 	//
 	// Возвращает информацию о заказе по его UUID.
 	//
 	// GET /api/v1/orders/{order_uuid}
 	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
+	// GetOrderHistory implements getOrderHistory operation.
+	//
+	// Возвращает все изменения статуса заказа в
+	// хронологическом порядке с указанием инициатора.
+	//
+	// GET /api/v1/orders/{order_uuid}/history
+	GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (GetOrderHistoryRes, error)
+	// ListOrders implements listOrders operation.
+	//
+	// Возвращает заказы пользователя с фильтрацией по
+	// статусам и дате создания. Используется курсорная
+	// пагинация.
+	//
+	// GET /api/v1/orders
+	ListOrders(ctx context.Context, params ListOrdersParams) (ListOrdersRes, error)
 	// PayOrder implements payOrder operation.
 	//
 	// Проводит оплату ранее созданного заказа.
@@ -15,7 +15,8 @@ var _ Handler = UnimplementedHandler{}
 
 // CancelOrder implements cancelOrder operation.
 //
-// Отменяет заказ, если он ещё не оплачен.
+// Отменяет неоплаченный заказ. Оплаченный, но ещё не
+// собранный заказ отменяется с возвратом средств.
 //
 // POST /api/v1/orders/{order_uuid}/cancel
 func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderParams) (r CancelOrderRes, _ error) {
@@ -28,7 +29,7 @@ func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderP
 // пользователем деталей.
 //
 // POST /api/v1/orders
-func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest) (r CreateOrderRes, _ error) {
+func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (r CreateOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -41,6 +42,27 @@ func (UnimplementedHandler) GetOrder(ctx context.Context, params GetOrderParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderHistory implements getOrderHistory operation.
+//
+// Возвращает все изменения статуса заказа в
+// хронологическом порядке с указанием инициатора.
+//
+// GET /api/v1/orders/{order_uuid}/history
+func (UnimplementedHandler) GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (r GetOrderHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListOrders implements listOrders operation.
+//
+// Возвращает заказы пользователя с фильтрацией по
+// статусам и дате создания. Используется курсорная
+// пагинация.
+//
+// GET /api/v1/orders
+func (UnimplementedHandler) ListOrders(ctx context.Context, params ListOrdersParams) (r ListOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements payOrder operation.
 //
 // Проводит оплату ранее созданного заказа.
@@ -105,6 +105,216 @@ func (x *OrderPaid) GetTransactionUuid() string {
 	return ""
 }
 
+// Событие OrderCreated публикуется OrderService после создания заказа
+type OrderCreated struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,proto3" json:"event_uuid,omitempty"`
+	// Идентификатор созданного заказа
+	OrderUuid string `protobuf:"bytes,2,opt,name=order_uuid,proto3" json:"order_uuid,omitempty"`
+	// Идентификатор пользователя
+	UserUuid string `protobuf:"bytes,3,opt,name=user_uuid,proto3" json:"user_uuid,omitempty"`
+	// Итоговая стоимость заказа
+	TotalPrice float64 `protobuf:"fixed64,4,opt,name=total_price,proto3" json:"total_price,omitempty"`
+	// Позиции заказа
+	Items         []*OrderCreatedItem `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCreated) Reset() {
+	*x = OrderCreated{}
+	mi := &file_events_v1_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCreated) ProtoMessage() {}
+
+func (x *OrderCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCreated.ProtoReflect.Descriptor instead.
+func (*OrderCreated) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderCreated) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *OrderCreated) GetItems() []*OrderCreatedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Позиция заказа в событии OrderCreated
+type OrderCreatedItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,proto3" json:"part_uuid,omitempty"`
+	// Количество деталей
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCreatedItem) Reset() {
+	*x = OrderCreatedItem{}
+	mi := &file_events_v1_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCreatedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCreatedItem) ProtoMessage() {}
+
+func (x *OrderCreatedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCreatedItem.ProtoReflect.Descriptor instead.
+func (*OrderCreatedItem) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderCreatedItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *OrderCreatedItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Событие OrderCancelled публикуется OrderService после отмены заказа
+type OrderCancelled struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,proto3" json:"event_uuid,omitempty"`
+	// Идентификатор отмененного заказа
+	OrderUuid string `protobuf:"bytes,2,opt,name=order_uuid,proto3" json:"order_uuid,omitempty"`
+	// Идентификатор пользователя
+	UserUuid string `protobuf:"bytes,3,opt,name=user_uuid,proto3" json:"user_uuid,omitempty"`
+	// Идентификатор транзакции возврата; пустой, если заказ не был оплачен
+	RefundUuid    string `protobuf:"bytes,4,opt,name=refund_uuid,proto3" json:"refund_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCancelled) Reset() {
+	*x = OrderCancelled{}
+	mi := &file_events_v1_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCancelled) ProtoMessage() {}
+
+func (x *OrderCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCancelled.ProtoReflect.Descriptor instead.
+func (*OrderCancelled) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderCancelled) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
 // Событие ShipAssembled публикуется AssemblyService после завершения сборки корабля
 type ShipAssembled struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -122,7 +332,7 @@ type ShipAssembled struct {
 
 func (x *ShipAssembled) Reset() {
 	*x = ShipAssembled{}
-	mi := &file_events_v1_order_proto_msgTypes[1]
+	mi := &file_events_v1_order_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -134,7 +344,7 @@ func (x *ShipAssembled) String() string {
 func (*ShipAssembled) ProtoMessage() {}
 
 func (x *ShipAssembled) ProtoReflect() protoreflect.Message {
-	mi := &file_events_v1_order_proto_msgTypes[1]
+	mi := &file_events_v1_order_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -147,7 +357,7 @@ func (x *ShipAssembled) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShipAssembled.ProtoReflect.Descriptor instead.
 func (*ShipAssembled) Descriptor() ([]byte, []int) {
-	return file_events_v1_order_proto_rawDescGZIP(), []int{1}
+	return file_events_v1_order_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ShipAssembled) GetEventUuid() string {
@@ -192,7 +402,29 @@ const file_events_v1_order_proto_rawDesc = "" +
 	"order_uuid\x12&\n" +
 	"\tuser_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tuser_uuid\x12A\n" +
 	"\x0epayment_method\x18\x04 \x01(\x0e2\x19.payment.v1.PaymentMethodR\x0epayment_method\x124\n" +
-	"\x10transaction_uuid\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x10transaction_uuid\"\xb3\x01\n" +
+	"\x10transaction_uuid\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x10transaction_uuid\"\xdf\x01\n" +
+	"\fOrderCreated\x12(\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"event_uuid\x12(\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"order_uuid\x12&\n" +
+	"\tuser_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tuser_uuid\x12 \n" +
+	"\vtotal_price\x18\x04 \x01(\x01R\vtotal_price\x121\n" +
+	"\x05items\x18\x05 \x03(\v2\x1b.events.v1.OrderCreatedItemR\x05items\"_\n" +
+	"\x10OrderCreatedItem\x12&\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tpart_uuid\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bquantity\"\xbb\x01\n" +
+	"\x0eOrderCancelled\x12(\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"event_uuid\x12(\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"order_uuid\x12&\n" +
+	"\tuser_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tuser_uuid\x12-\n" +
+	"\vrefund_uuid\x18\x04 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\vrefund_uuid\"\xb3\x01\n" +
 	"\rShipAssembled\x12(\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
@@ -215,19 +447,23 @@ func file_events_v1_order_proto_rawDescGZIP() []byte {
 	return file_events_v1_order_proto_rawDescData
 }
 
-var file_events_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_events_v1_order_proto_goTypes = []any{
-	(*OrderPaid)(nil),     // 0: events.v1.OrderPaid
-	(*ShipAssembled)(nil), // 1: events.v1.ShipAssembled
-	(v1.PaymentMethod)(0), // 2: payment.v1.PaymentMethod
+	(*OrderPaid)(nil),        // 0: events.v1.OrderPaid
+	(*OrderCreated)(nil),     // 1: events.v1.OrderCreated
+	(*OrderCreatedItem)(nil), // 2: events.v1.OrderCreatedItem
+	(*OrderCancelled)(nil),   // 3: events.v1.OrderCancelled
+	(*ShipAssembled)(nil),    // 4: events.v1.ShipAssembled
+	(v1.PaymentMethod)(0),    // 5: payment.v1.PaymentMethod
 }
 var file_events_v1_order_proto_depIdxs = []int32{
-	2, // 0: events.v1.OrderPaid.payment_method:type_name -> payment.v1.PaymentMethod
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 0: events.v1.OrderPaid.payment_method:type_name -> payment.v1.PaymentMethod
+	2, // 1: events.v1.OrderCreated.items:type_name -> events.v1.OrderCreatedItem
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_events_v1_order_proto_init() }
@@ -241,7 +477,7 @@ func file_events_v1_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_order_proto_rawDesc), len(file_events_v1_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -199,6 +199,476 @@ var _ interface {
 	ErrorName() string
 } = OrderPaidValidationError{}
 
+// Validate checks the field values on OrderCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderCreated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCreated with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderCreatedMultiError, or
+// nil if none found.
+func (m *OrderCreated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCreated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEventUuid()); err != nil {
+		err = OrderCreatedValidationError{
+			field:  "EventUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = OrderCreatedValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserUuid()); err != nil {
+		err = OrderCreatedValidationError{
+			field:  "UserUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TotalPrice
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderCreatedValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderCreatedValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderCreatedValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderCreatedMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *OrderCreated) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// OrderCreatedMultiError is an error wrapping multiple validation errors
+// returned by OrderCreated.ValidateAll() if the designated constraints aren't met.
+type OrderCreatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCreatedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCreatedMultiError) AllErrors() []error { return m }
+
+// OrderCreatedValidationError is the validation error returned by
+// OrderCreated.Validate if the designated constraints aren't met.
+type OrderCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCreatedValidationError) ErrorName() string { return "OrderCreatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCreatedValidationError{}
+
+// Validate checks the field values on OrderCreatedItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderCreatedItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCreatedItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderCreatedItemMultiError, or nil if none found.
+func (m *OrderCreatedItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCreatedItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPartUuid()); err != nil {
+		err = OrderCreatedItemValidationError{
+			field:  "PartUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := OrderCreatedItemValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderCreatedItemMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *OrderCreatedItem) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// OrderCreatedItemMultiError is an error wrapping multiple validation errors
+// returned by OrderCreatedItem.ValidateAll() if the designated constraints
+// aren't met.
+type OrderCreatedItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCreatedItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCreatedItemMultiError) AllErrors() []error { return m }
+
+// OrderCreatedItemValidationError is the validation error returned by
+// OrderCreatedItem.Validate if the designated constraints aren't met.
+type OrderCreatedItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCreatedItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCreatedItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCreatedItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCreatedItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCreatedItemValidationError) ErrorName() string { return "OrderCreatedItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderCreatedItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCreatedItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCreatedItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCreatedItemValidationError{}
+
+// Validate checks the field values on OrderCancelled with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderCancelled) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCancelled with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderCancelledMultiError,
+// or nil if none found.
+func (m *OrderCancelled) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCancelled) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEventUuid()); err != nil {
+		err = OrderCancelledValidationError{
+			field:  "EventUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = OrderCancelledValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserUuid()); err != nil {
+		err = OrderCancelledValidationError{
+			field:  "UserUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRefundUuid() != "" {
+
+		if err := m._validateUuid(m.GetRefundUuid()); err != nil {
+			err = OrderCancelledValidationError{
+				field:  "RefundUuid",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderCancelledMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *OrderCancelled) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// OrderCancelledMultiError is an error wrapping multiple validation errors
+// returned by OrderCancelled.ValidateAll() if the designated constraints
+// aren't met.
+type OrderCancelledMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCancelledMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCancelledMultiError) AllErrors() []error { return m }
+
+// OrderCancelledValidationError is the validation error returned by
+// OrderCancelled.Validate if the designated constraints aren't met.
+type OrderCancelledValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCancelledValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCancelledValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCancelledValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCancelledValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCancelledValidationError) ErrorName() string { return "OrderCancelledValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderCancelledValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCancelled.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCancelledValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCancelledValidationError{}
+
 // Validate checks the field values on ShipAssembled with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
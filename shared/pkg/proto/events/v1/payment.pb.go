@@ -0,0 +1,297 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: events/v1/payment.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	v1 "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Событие PaymentCompleted публикуется PaymentService после подтверждения асинхронной оплаты
+type PaymentCompleted struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,proto3" json:"event_uuid,omitempty"`
+	// Идентификатор транзакции оплаты
+	TransactionUuid string `protobuf:"bytes,2,opt,name=transaction_uuid,proto3" json:"transaction_uuid,omitempty"`
+	// Идентификатор оплаченного заказа
+	OrderUuid string `protobuf:"bytes,3,opt,name=order_uuid,proto3" json:"order_uuid,omitempty"`
+	// Идентификатор пользователя
+	UserUuid string `protobuf:"bytes,4,opt,name=user_uuid,proto3" json:"user_uuid,omitempty"`
+	// Способ оплаты
+	PaymentMethod v1.PaymentMethod `protobuf:"varint,5,opt,name=payment_method,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Сумма в минимальных единицах валюты (копейках)
+	Amount int64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Код валюты по ISO 4217
+	Currency      string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentCompleted) Reset() {
+	*x = PaymentCompleted{}
+	mi := &file_events_v1_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCompleted) ProtoMessage() {}
+
+func (x *PaymentCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCompleted.ProtoReflect.Descriptor instead.
+func (*PaymentCompleted) Descriptor() ([]byte, []int) {
+	return file_events_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PaymentCompleted) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PaymentCompleted) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *PaymentCompleted) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *PaymentCompleted) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *PaymentCompleted) GetPaymentMethod() v1.PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return v1.PaymentMethod(0)
+}
+
+func (x *PaymentCompleted) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentCompleted) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Событие PaymentFailed публикуется PaymentService, если асинхронная оплата отклонена
+// провайдером или не подтверждена вовремя
+type PaymentFailed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,proto3" json:"event_uuid,omitempty"`
+	// Идентификатор транзакции оплаты
+	TransactionUuid string `protobuf:"bytes,2,opt,name=transaction_uuid,proto3" json:"transaction_uuid,omitempty"`
+	// Идентификатор заказа
+	OrderUuid string `protobuf:"bytes,3,opt,name=order_uuid,proto3" json:"order_uuid,omitempty"`
+	// Идентификатор пользователя
+	UserUuid string `protobuf:"bytes,4,opt,name=user_uuid,proto3" json:"user_uuid,omitempty"`
+	// Способ оплаты
+	PaymentMethod v1.PaymentMethod `protobuf:"varint,5,opt,name=payment_method,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Код отказа провайдера; TIMEOUT, если провайдер не ответил вовремя
+	DeclineCode   string `protobuf:"bytes,6,opt,name=decline_code,proto3" json:"decline_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentFailed) Reset() {
+	*x = PaymentFailed{}
+	mi := &file_events_v1_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentFailed) ProtoMessage() {}
+
+func (x *PaymentFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentFailed.ProtoReflect.Descriptor instead.
+func (*PaymentFailed) Descriptor() ([]byte, []int) {
+	return file_events_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentFailed) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PaymentFailed) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *PaymentFailed) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *PaymentFailed) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *PaymentFailed) GetPaymentMethod() v1.PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return v1.PaymentMethod(0)
+}
+
+func (x *PaymentFailed) GetDeclineCode() string {
+	if x != nil {
+		return x.DeclineCode
+	}
+	return ""
+}
+
+var File_events_v1_payment_proto protoreflect.FileDescriptor
+
+const file_events_v1_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x17events/v1/payment.proto\x12\tevents.v1\x1a\x17validate/validate.proto\x1a\x18payment/v1/payment.proto\"\xbb\x02\n" +
+	"\x10PaymentCompleted\x12(\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"event_uuid\x124\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x10transaction_uuid\x12(\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"order_uuid\x12&\n" +
+	"\tuser_uuid\x18\x04 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tuser_uuid\x12A\n" +
+	"\x0epayment_method\x18\x05 \x01(\x0e2\x19.payment.v1.PaymentMethodR\x0epayment_method\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\"\xa8\x02\n" +
+	"\rPaymentFailed\x12(\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"event_uuid\x124\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x10transaction_uuid\x12(\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"order_uuid\x12&\n" +
+	"\tuser_uuid\x18\x04 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tuser_uuid\x12A\n" +
+	"\x0epayment_method\x18\x05 \x01(\x0e2\x19.payment.v1.PaymentMethodR\x0epayment_method\x12\"\n" +
+	"\fdecline_code\x18\x06 \x01(\tR\fdecline_codeBLZJgithub.com/radiophysiker/microservices-homework/shared/pkg/proto/events/v1b\x06proto3"
+
+var (
+	file_events_v1_payment_proto_rawDescOnce sync.Once
+	file_events_v1_payment_proto_rawDescData []byte
+)
+
+func file_events_v1_payment_proto_rawDescGZIP() []byte {
+	file_events_v1_payment_proto_rawDescOnce.Do(func() {
+		file_events_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_payment_proto_rawDesc), len(file_events_v1_payment_proto_rawDesc)))
+	})
+	return file_events_v1_payment_proto_rawDescData
+}
+
+var file_events_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_v1_payment_proto_goTypes = []any{
+	(*PaymentCompleted)(nil), // 0: events.v1.PaymentCompleted
+	(*PaymentFailed)(nil),    // 1: events.v1.PaymentFailed
+	(v1.PaymentMethod)(0),    // 2: payment.v1.PaymentMethod
+}
+var file_events_v1_payment_proto_depIdxs = []int32{
+	2, // 0: events.v1.PaymentCompleted.payment_method:type_name -> payment.v1.PaymentMethod
+	2, // 1: events.v1.PaymentFailed.payment_method:type_name -> payment.v1.PaymentMethod
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_payment_proto_init() }
+func file_events_v1_payment_proto_init() {
+	if File_events_v1_payment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_payment_proto_rawDesc), len(file_events_v1_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_payment_proto_goTypes,
+		DependencyIndexes: file_events_v1_payment_proto_depIdxs,
+		MessageInfos:      file_events_v1_payment_proto_msgTypes,
+	}.Build()
+	File_events_v1_payment_proto = out.File
+	file_events_v1_payment_proto_goTypes = nil
+	file_events_v1_payment_proto_depIdxs = nil
+}
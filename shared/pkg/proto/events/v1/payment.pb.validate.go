@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: events/v1/payment.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	v1 "github.com/radiophysiker/microservices-homework/shared/pkg/proto/payment/v1"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = v1.PaymentMethod(0)
+)
+
+// define the regex for a UUID once up-front
+var _payment_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on PaymentCompleted with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PaymentCompleted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaymentCompleted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PaymentCompletedMultiError, or nil if none found.
+func (m *PaymentCompleted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaymentCompleted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEventUuid()); err != nil {
+		err = PaymentCompletedValidationError{
+			field:  "EventUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTransactionUuid()); err != nil {
+		err = PaymentCompletedValidationError{
+			field:  "TransactionUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = PaymentCompletedValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserUuid()); err != nil {
+		err = PaymentCompletedValidationError{
+			field:  "UserUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PaymentMethod
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return PaymentCompletedMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *PaymentCompleted) _validateUuid(uuid string) error {
+	if matched := _payment_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// PaymentCompletedMultiError is an error wrapping multiple validation errors
+// returned by PaymentCompleted.ValidateAll() if the designated constraints
+// aren't met.
+type PaymentCompletedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentCompletedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentCompletedMultiError) AllErrors() []error { return m }
+
+// PaymentCompletedValidationError is the validation error returned by
+// PaymentCompleted.Validate if the designated constraints aren't met.
+type PaymentCompletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentCompletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentCompletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentCompletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentCompletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentCompletedValidationError) ErrorName() string { return "PaymentCompletedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentCompletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaymentCompleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentCompletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentCompletedValidationError{}
+
+// Validate checks the field values on PaymentFailed with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PaymentFailed) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaymentFailed with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PaymentFailedMultiError, or
+// nil if none found.
+func (m *PaymentFailed) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaymentFailed) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEventUuid()); err != nil {
+		err = PaymentFailedValidationError{
+			field:  "EventUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTransactionUuid()); err != nil {
+		err = PaymentFailedValidationError{
+			field:  "TransactionUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = PaymentFailedValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserUuid()); err != nil {
+		err = PaymentFailedValidationError{
+			field:  "UserUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PaymentMethod
+
+	// no validation rules for DeclineCode
+
+	if len(errors) > 0 {
+		return PaymentFailedMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *PaymentFailed) _validateUuid(uuid string) error {
+	if matched := _payment_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// PaymentFailedMultiError is an error wrapping multiple validation errors
+// returned by PaymentFailed.ValidateAll() if the designated constraints
+// aren't met.
+type PaymentFailedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentFailedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentFailedMultiError) AllErrors() []error { return m }
+
+// PaymentFailedValidationError is the validation error returned by
+// PaymentFailed.Validate if the designated constraints aren't met.
+type PaymentFailedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentFailedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentFailedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentFailedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentFailedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentFailedValidationError) ErrorName() string { return "PaymentFailedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentFailedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaymentFailed.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentFailedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentFailedValidationError{}
@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -33,6 +34,11 @@ const (
 	OrderStatus_ORDER_STATUS_PAID            OrderStatus = 2
 	OrderStatus_ORDER_STATUS_CANCELLED       OrderStatus = 3
 	OrderStatus_ORDER_STATUS_ASSEMBLED       OrderStatus = 4
+	OrderStatus_ORDER_STATUS_REFUNDED        OrderStatus = 5
+	// Оплата асинхронным способом ожидает подтверждения платежного провайдера
+	OrderStatus_ORDER_STATUS_PAYMENT_PROCESSING OrderStatus = 6
+	// Заказ закреплен за возвратом: средства возвращаются, сборка уже невозможна
+	OrderStatus_ORDER_STATUS_REFUNDING OrderStatus = 7
 )
 
 // Enum value maps for OrderStatus.
@@ -43,13 +49,19 @@ var (
 		2: "ORDER_STATUS_PAID",
 		3: "ORDER_STATUS_CANCELLED",
 		4: "ORDER_STATUS_ASSEMBLED",
+		5: "ORDER_STATUS_REFUNDED",
+		6: "ORDER_STATUS_PAYMENT_PROCESSING",
+		7: "ORDER_STATUS_REFUNDING",
 	}
 	OrderStatus_value = map[string]int32{
-		"ORDER_STATUS_UNSPECIFIED":     0,
-		"ORDER_STATUS_PENDING_PAYMENT": 1,
-		"ORDER_STATUS_PAID":            2,
-		"ORDER_STATUS_CANCELLED":       3,
-		"ORDER_STATUS_ASSEMBLED":       4,
+		"ORDER_STATUS_UNSPECIFIED":        0,
+		"ORDER_STATUS_PENDING_PAYMENT":    1,
+		"ORDER_STATUS_PAID":               2,
+		"ORDER_STATUS_CANCELLED":          3,
+		"ORDER_STATUS_ASSEMBLED":          4,
+		"ORDER_STATUS_REFUNDED":           5,
+		"ORDER_STATUS_PAYMENT_PROCESSING": 6,
+		"ORDER_STATUS_REFUNDING":          7,
 	}
 )
 
@@ -142,8 +154,7 @@ func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
 // Запрос на создание заказа
 type CreateOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,proto3" json:"user_uuid,omitempty"`
-	PartUuids     []string               `protobuf:"bytes,2,rep,name=part_uuids,proto3" json:"part_uuids,omitempty"`
+	Items         []*CreateOrderItem     `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -178,18 +189,64 @@ func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
 	return file_order_v1_order_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *CreateOrderRequest) GetUserUuid() string {
+func (x *CreateOrderRequest) GetItems() []*CreateOrderItem {
 	if x != nil {
-		return x.UserUuid
+		return x.Items
+	}
+	return nil
+}
+
+// Позиция заказа: деталь и ее количество
+type CreateOrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,proto3" json:"part_uuid,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderItem) Reset() {
+	*x = CreateOrderItem{}
+	mi := &file_order_v1_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderItem) ProtoMessage() {}
+
+func (x *CreateOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderItem.ProtoReflect.Descriptor instead.
+func (*CreateOrderItem) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateOrderItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
 	}
 	return ""
 }
 
-func (x *CreateOrderRequest) GetPartUuids() []string {
+func (x *CreateOrderItem) GetQuantity() int32 {
 	if x != nil {
-		return x.PartUuids
+		return x.Quantity
 	}
-	return nil
+	return 0
 }
 
 // Ответ создания заказа
@@ -203,7 +260,7 @@ type CreateOrderResponse struct {
 
 func (x *CreateOrderResponse) Reset() {
 	*x = CreateOrderResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[1]
+	mi := &file_order_v1_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +272,7 @@ func (x *CreateOrderResponse) String() string {
 func (*CreateOrderResponse) ProtoMessage() {}
 
 func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[1]
+	mi := &file_order_v1_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +285,7 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{1}
+	return file_order_v1_order_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateOrderResponse) GetOrderUuid() string {
@@ -255,7 +312,7 @@ type GetOrderRequest struct {
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[2]
+	mi := &file_order_v1_order_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +324,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[2]
+	mi := &file_order_v1_order_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +337,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{2}
+	return file_order_v1_order_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetOrderRequest) GetOrderUuid() string {
@@ -300,13 +357,14 @@ type GetOrderResponse struct {
 	TransactionUuid *string                `protobuf:"bytes,5,opt,name=transaction_uuid,proto3,oneof" json:"transaction_uuid,omitempty"`
 	PaymentMethod   *PaymentMethod         `protobuf:"varint,6,opt,name=payment_method,proto3,enum=order.v1.PaymentMethod,oneof" json:"payment_method,omitempty"`
 	Status          OrderStatus            `protobuf:"varint,7,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`
+	Items           []*OrderItem           `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetOrderResponse) Reset() {
 	*x = GetOrderResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[3]
+	mi := &file_order_v1_order_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -318,7 +376,7 @@ func (x *GetOrderResponse) String() string {
 func (*GetOrderResponse) ProtoMessage() {}
 
 func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[3]
+	mi := &file_order_v1_order_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -331,7 +389,7 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{3}
+	return file_order_v1_order_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetOrderResponse) GetOrderUuid() string {
@@ -383,18 +441,447 @@ func (x *GetOrderResponse) GetStatus() OrderStatus {
 	return OrderStatus_ORDER_STATUS_UNSPECIFIED
 }
 
+func (x *GetOrderResponse) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Позиция заказа с ценой и описанием детали на момент оформления
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,proto3" json:"part_uuid,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,3,opt,name=unit_price,proto3" json:"unit_price,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_order_v1_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrderItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *OrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// Запрос на получение списка заказов
+type ListOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID пользователя; должен совпадать с пользователем текущей сессии.
+	// Для вызова другого сервиса пустое значение означает заказы всех пользователей
+	UserUuid string `protobuf:"bytes,1,opt,name=user_uuid,proto3" json:"user_uuid,omitempty"`
+	// Статусы заказов; пустой список означает любой статус
+	Statuses []OrderStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=order.v1.OrderStatus" json:"statuses,omitempty"`
+	// Нижняя граница даты создания (включительно)
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_from,proto3" json:"created_from,omitempty"`
+	// Верхняя граница даты создания (не включительно)
+	CreatedTo *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_to,proto3" json:"created_to,omitempty"`
+	// Размер страницы; 0 означает размер по умолчанию
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// Курсор следующей страницы из предыдущего ответа
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	// Нижняя граница даты последнего изменения (включительно)
+	UpdatedFrom *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_from,proto3" json:"updated_from,omitempty"`
+	// Верхняя граница даты последнего изменения (не включительно)
+	UpdatedTo *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_to,proto3" json:"updated_to,omitempty"`
+	// UUID заказов; пустой список означает любые заказы
+	OrderUuids    []string `protobuf:"bytes,9,rep,name=order_uuids,proto3" json:"order_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListOrdersRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetUpdatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetUpdatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetOrderUuids() []string {
+	if x != nil {
+		return x.OrderUuids
+	}
+	return nil
+}
+
+// Ответ со списком заказов
+type ListOrdersResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*GetOrderResponse    `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Курсор следующей страницы; пустой, если страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*GetOrderResponse {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Запрос на получение истории статусов заказа
+type GetOrderHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,proto3" json:"order_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderHistoryRequest) Reset() {
+	*x = GetOrderHistoryRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryRequest) ProtoMessage() {}
+
+func (x *GetOrderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetOrderHistoryRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+// Ответ с историей статусов заказа в хронологическом порядке
+type GetOrderHistoryResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Entries       []*OrderStatusHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderHistoryResponse) Reset() {
+	*x = GetOrderHistoryResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryResponse) ProtoMessage() {}
+
+func (x *GetOrderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetOrderHistoryResponse) GetEntries() []*OrderStatusHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Запись об изменении статуса заказа
+type OrderStatusHistoryEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Предыдущий статус; не задан для записи о создании заказа
+	FromStatus *OrderStatus `protobuf:"varint,1,opt,name=from_status,proto3,enum=order.v1.OrderStatus,oneof" json:"from_status,omitempty"`
+	ToStatus   OrderStatus  `protobuf:"varint,2,opt,name=to_status,proto3,enum=order.v1.OrderStatus" json:"to_status,omitempty"`
+	// Инициатор изменения: UUID пользователя или имя системного компонента
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// UUID события, вызвавшего изменение
+	EventUuid     *string                `protobuf:"bytes,4,opt,name=event_uuid,proto3,oneof" json:"event_uuid,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusHistoryEntry) Reset() {
+	*x = OrderStatusHistoryEntry{}
+	mi := &file_order_v1_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusHistoryEntry) ProtoMessage() {}
+
+func (x *OrderStatusHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusHistoryEntry.ProtoReflect.Descriptor instead.
+func (*OrderStatusHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OrderStatusHistoryEntry) GetFromStatus() OrderStatus {
+	if x != nil && x.FromStatus != nil {
+		return *x.FromStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderStatusHistoryEntry) GetToStatus() OrderStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderStatusHistoryEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *OrderStatusHistoryEntry) GetEventUuid() string {
+	if x != nil && x.EventUuid != nil {
+		return *x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderStatusHistoryEntry) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
 // Запрос на оплату заказа
 type PayOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,proto3" json:"order_uuid,omitempty"`
 	PaymentMethod PaymentMethod          `protobuf:"varint,2,opt,name=payment_method,proto3,enum=order.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Токен карты; обязателен для способов CARD и CREDIT_CARD
+	CardToken     string `protobuf:"bytes,3,opt,name=card_token,proto3" json:"card_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PayOrderRequest) Reset() {
 	*x = PayOrderRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[4]
+	mi := &file_order_v1_order_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +893,7 @@ func (x *PayOrderRequest) String() string {
 func (*PayOrderRequest) ProtoMessage() {}
 
 func (x *PayOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[4]
+	mi := &file_order_v1_order_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +906,7 @@ func (x *PayOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayOrderRequest.ProtoReflect.Descriptor instead.
 func (*PayOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{4}
+	return file_order_v1_order_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PayOrderRequest) GetOrderUuid() string {
@@ -436,17 +923,26 @@ func (x *PayOrderRequest) GetPaymentMethod() PaymentMethod {
 	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
 }
 
+func (x *PayOrderRequest) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
 // Ответ оплаты заказа
 type PayOrderResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,proto3" json:"transaction_uuid,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// PAID для завершенной оплаты, PAYMENT_PROCESSING для оплаты, ожидающей подтверждения
+	Status        OrderStatus `protobuf:"varint,2,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PayOrderResponse) Reset() {
 	*x = PayOrderResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[5]
+	mi := &file_order_v1_order_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -458,7 +954,7 @@ func (x *PayOrderResponse) String() string {
 func (*PayOrderResponse) ProtoMessage() {}
 
 func (x *PayOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[5]
+	mi := &file_order_v1_order_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +967,7 @@ func (x *PayOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayOrderResponse.ProtoReflect.Descriptor instead.
 func (*PayOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{5}
+	return file_order_v1_order_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PayOrderResponse) GetTransactionUuid() string {
@@ -481,6 +977,13 @@ func (x *PayOrderResponse) GetTransactionUuid() string {
 	return ""
 }
 
+func (x *PayOrderResponse) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
 // Запрос на отмену заказа
 type CancelOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -491,7 +994,7 @@ type CancelOrderRequest struct {
 
 func (x *CancelOrderRequest) Reset() {
 	*x = CancelOrderRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[6]
+	mi := &file_order_v1_order_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -503,7 +1006,7 @@ func (x *CancelOrderRequest) String() string {
 func (*CancelOrderRequest) ProtoMessage() {}
 
 func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[6]
+	mi := &file_order_v1_order_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +1019,7 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{6}
+	return file_order_v1_order_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CancelOrderRequest) GetOrderUuid() string {
@@ -530,12 +1033,13 @@ var File_order_v1_order_proto protoreflect.FileDescriptor
 
 const file_order_v1_order_proto_rawDesc = "" +
 	"\n" +
-	"\x14order/v1/order.proto\x12\border.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"f\n" +
-	"\x12CreateOrderRequest\x12&\n" +
-	"\tuser_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tuser_uuid\x12(\n" +
-	"\n" +
-	"part_uuids\x18\x02 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\n" +
-	"part_uuids\"W\n" +
+	"\x14order/v1/order.proto\x12\border.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"r\n" +
+	"\x12CreateOrderRequest\x129\n" +
+	"\x05items\x18\x03 \x03(\v2\x19.order.v1.CreateOrderItemB\b\xfaB\x05\x92\x01\x02\b\x01R\x05itemsJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\tuser_uuidR\n" +
+	"part_uuids\"^\n" +
+	"\x0fCreateOrderItem\x12&\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tpart_uuid\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bquantity\"W\n" +
 	"\x13CreateOrderResponse\x12\x1e\n" +
 	"\n" +
 	"order_uuid\x18\x01 \x01(\tR\n" +
@@ -544,7 +1048,7 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\x0fGetOrderRequest\x12(\n" +
 	"\n" +
 	"order_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
-	"order_uuid\"\xe0\x02\n" +
+	"order_uuid\"\x8b\x03\n" +
 	"\x10GetOrderResponse\x12\x1e\n" +
 	"\n" +
 	"order_uuid\x18\x01 \x01(\tR\n" +
@@ -556,36 +1060,92 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\vtotal_price\x18\x04 \x01(\x01R\vtotal_price\x12/\n" +
 	"\x10transaction_uuid\x18\x05 \x01(\tH\x00R\x10transaction_uuid\x88\x01\x01\x12D\n" +
 	"\x0epayment_method\x18\x06 \x01(\x0e2\x17.order.v1.PaymentMethodH\x01R\x0epayment_method\x88\x01\x01\x12-\n" +
-	"\x06status\x18\a \x01(\x0e2\x15.order.v1.OrderStatusR\x06statusB\x13\n" +
+	"\x06status\x18\a \x01(\x0e2\x15.order.v1.OrderStatusR\x06status\x12)\n" +
+	"\x05items\x18\b \x03(\v2\x13.order.v1.OrderItemR\x05itemsB\x13\n" +
 	"\x11_transaction_uuidB\x11\n" +
-	"\x0f_payment_method\"\x86\x01\n" +
+	"\x0f_payment_method\"\x95\x01\n" +
+	"\tOrderItem\x12\x1c\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\tpart_uuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1e\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\x01R\n" +
+	"unit_price\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\"\xf6\x03\n" +
+	"\x11ListOrdersRequest\x12)\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\tuser_uuid\x12B\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2\x15.order.v1.OrderStatusB\x0f\xfaB\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00R\bstatuses\x12>\n" +
+	"\fcreated_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcreated_from\x12:\n" +
+	"\n" +
+	"created_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_to\x12'\n" +
+	"\tpage_size\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\n" +
+	"page_token\x12>\n" +
+	"\fupdated_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fupdated_from\x12:\n" +
+	"\n" +
+	"updated_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_to\x121\n" +
+	"\vorder_uuids\x18\t \x03(\tB\x0f\xfaB\f\x92\x01\t\x10d\"\x05r\x03\xb0\x01\x01R\vorder_uuids\"r\n" +
+	"\x12ListOrdersResponse\x122\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1a.order.v1.GetOrderResponseR\x06orders\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"B\n" +
+	"\x16GetOrderHistoryRequest\x12(\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"order_uuid\"V\n" +
+	"\x17GetOrderHistoryResponse\x12;\n" +
+	"\aentries\x18\x01 \x03(\v2!.order.v1.OrderStatusHistoryEntryR\aentries\"\xa2\x02\n" +
+	"\x17OrderStatusHistoryEntry\x12<\n" +
+	"\vfrom_status\x18\x01 \x01(\x0e2\x15.order.v1.OrderStatusH\x00R\vfrom_status\x88\x01\x01\x123\n" +
+	"\tto_status\x18\x02 \x01(\x0e2\x15.order.v1.OrderStatusR\tto_status\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12#\n" +
+	"\n" +
+	"event_uuid\x18\x04 \x01(\tH\x01R\n" +
+	"event_uuid\x88\x01\x01\x12:\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changed_atB\x0e\n" +
+	"\f_from_statusB\r\n" +
+	"\v_event_uuid\"\xaf\x01\n" +
 	"\x0fPayOrderRequest\x12(\n" +
 	"\n" +
 	"order_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
 	"order_uuid\x12I\n" +
-	"\x0epayment_method\x18\x02 \x01(\x0e2\x17.order.v1.PaymentMethodB\b\xfaB\x05\x82\x01\x02\x10\x01R\x0epayment_method\">\n" +
+	"\x0epayment_method\x18\x02 \x01(\x0e2\x17.order.v1.PaymentMethodB\b\xfaB\x05\x82\x01\x02\x10\x01R\x0epayment_method\x12'\n" +
+	"\n" +
+	"card_token\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\n" +
+	"card_token\"m\n" +
 	"\x10PayOrderResponse\x12*\n" +
-	"\x10transaction_uuid\x18\x01 \x01(\tR\x10transaction_uuid\">\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x10transaction_uuid\x12-\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x15.order.v1.OrderStatusR\x06status\">\n" +
 	"\x12CancelOrderRequest\x12(\n" +
 	"\n" +
 	"order_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
-	"order_uuid*\x9c\x01\n" +
+	"order_uuid*\xf8\x01\n" +
 	"\vOrderStatus\x12\x1c\n" +
 	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cORDER_STATUS_PENDING_PAYMENT\x10\x01\x12\x15\n" +
 	"\x11ORDER_STATUS_PAID\x10\x02\x12\x1a\n" +
 	"\x16ORDER_STATUS_CANCELLED\x10\x03\x12\x1a\n" +
-	"\x16ORDER_STATUS_ASSEMBLED\x10\x04*t\n" +
+	"\x16ORDER_STATUS_ASSEMBLED\x10\x04\x12\x19\n" +
+	"\x15ORDER_STATUS_REFUNDED\x10\x05\x12#\n" +
+	"\x1fORDER_STATUS_PAYMENT_PROCESSING\x10\x06\x12\x1a\n" +
+	"\x16ORDER_STATUS_REFUNDING\x10\a*t\n" +
 	"\rPaymentMethod\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aUNKNOWN\x10\x01\x12\b\n" +
 	"\x04CARD\x10\x02\x12\a\n" +
 	"\x03SBP\x10\x03\x12\x0f\n" +
 	"\vCREDIT_CARD\x10\x04\x12\x12\n" +
-	"\x0eINVESTOR_MONEY\x10\x052\xbd\x03\n" +
+	"\x0eINVESTOR_MONEY\x10\x052\xa4\x05\n" +
 	"\fOrderService\x12e\n" +
-	"\vCreateOrder\x12\x1c.order.v1.CreateOrderRequest\x1a\x1d.order.v1.CreateOrderResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/orders\x12f\n" +
-	"\bGetOrder\x12\x19.order.v1.GetOrderRequest\x1a\x1a.order.v1.GetOrderResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/orders/{order_uuid}\x12m\n" +
+	"\vCreateOrder\x12\x1c.order.v1.CreateOrderRequest\x1a\x1d.order.v1.CreateOrderResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/orders\x12_\n" +
+	"\n" +
+	"ListOrders\x12\x1b.order.v1.ListOrdersRequest\x1a\x1c.order.v1.ListOrdersResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/orders\x12f\n" +
+	"\bGetOrder\x12\x19.order.v1.GetOrderRequest\x1a\x1a.order.v1.GetOrderResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/orders/{order_uuid}\x12\x83\x01\n" +
+	"\x0fGetOrderHistory\x12 .order.v1.GetOrderHistoryRequest\x1a!.order.v1.GetOrderHistoryResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/orders/{order_uuid}/history\x12m\n" +
 	"\bPayOrder\x12\x19.order.v1.PayOrderRequest\x1a\x1a.order.v1.PayOrderResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/orders/{order_uuid}/pay\x12o\n" +
 	"\vCancelOrder\x12\x1c.order.v1.CancelOrderRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$\"\"/api/v1/orders/{order_uuid}/cancelBKZIgithub.com/radiophysiker/microservices-homework/shared/pkg/proto/order/v1b\x06proto3"
 
@@ -602,36 +1162,61 @@ func file_order_v1_order_proto_rawDescGZIP() []byte {
 }
 
 var file_order_v1_order_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_order_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_order_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_order_v1_order_proto_goTypes = []any{
-	(OrderStatus)(0),            // 0: order.v1.OrderStatus
-	(PaymentMethod)(0),          // 1: order.v1.PaymentMethod
-	(*CreateOrderRequest)(nil),  // 2: order.v1.CreateOrderRequest
-	(*CreateOrderResponse)(nil), // 3: order.v1.CreateOrderResponse
-	(*GetOrderRequest)(nil),     // 4: order.v1.GetOrderRequest
-	(*GetOrderResponse)(nil),    // 5: order.v1.GetOrderResponse
-	(*PayOrderRequest)(nil),     // 6: order.v1.PayOrderRequest
-	(*PayOrderResponse)(nil),    // 7: order.v1.PayOrderResponse
-	(*CancelOrderRequest)(nil),  // 8: order.v1.CancelOrderRequest
-	(*emptypb.Empty)(nil),       // 9: google.protobuf.Empty
+	(OrderStatus)(0),                // 0: order.v1.OrderStatus
+	(PaymentMethod)(0),              // 1: order.v1.PaymentMethod
+	(*CreateOrderRequest)(nil),      // 2: order.v1.CreateOrderRequest
+	(*CreateOrderItem)(nil),         // 3: order.v1.CreateOrderItem
+	(*CreateOrderResponse)(nil),     // 4: order.v1.CreateOrderResponse
+	(*GetOrderRequest)(nil),         // 5: order.v1.GetOrderRequest
+	(*GetOrderResponse)(nil),        // 6: order.v1.GetOrderResponse
+	(*OrderItem)(nil),               // 7: order.v1.OrderItem
+	(*ListOrdersRequest)(nil),       // 8: order.v1.ListOrdersRequest
+	(*ListOrdersResponse)(nil),      // 9: order.v1.ListOrdersResponse
+	(*GetOrderHistoryRequest)(nil),  // 10: order.v1.GetOrderHistoryRequest
+	(*GetOrderHistoryResponse)(nil), // 11: order.v1.GetOrderHistoryResponse
+	(*OrderStatusHistoryEntry)(nil), // 12: order.v1.OrderStatusHistoryEntry
+	(*PayOrderRequest)(nil),         // 13: order.v1.PayOrderRequest
+	(*PayOrderResponse)(nil),        // 14: order.v1.PayOrderResponse
+	(*CancelOrderRequest)(nil),      // 15: order.v1.CancelOrderRequest
+	(*timestamppb.Timestamp)(nil),   // 16: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 17: google.protobuf.Empty
 }
 var file_order_v1_order_proto_depIdxs = []int32{
-	1, // 0: order.v1.GetOrderResponse.payment_method:type_name -> order.v1.PaymentMethod
-	0, // 1: order.v1.GetOrderResponse.status:type_name -> order.v1.OrderStatus
-	1, // 2: order.v1.PayOrderRequest.payment_method:type_name -> order.v1.PaymentMethod
-	2, // 3: order.v1.OrderService.CreateOrder:input_type -> order.v1.CreateOrderRequest
-	4, // 4: order.v1.OrderService.GetOrder:input_type -> order.v1.GetOrderRequest
-	6, // 5: order.v1.OrderService.PayOrder:input_type -> order.v1.PayOrderRequest
-	8, // 6: order.v1.OrderService.CancelOrder:input_type -> order.v1.CancelOrderRequest
-	3, // 7: order.v1.OrderService.CreateOrder:output_type -> order.v1.CreateOrderResponse
-	5, // 8: order.v1.OrderService.GetOrder:output_type -> order.v1.GetOrderResponse
-	7, // 9: order.v1.OrderService.PayOrder:output_type -> order.v1.PayOrderResponse
-	9, // 10: order.v1.OrderService.CancelOrder:output_type -> google.protobuf.Empty
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3,  // 0: order.v1.CreateOrderRequest.items:type_name -> order.v1.CreateOrderItem
+	1,  // 1: order.v1.GetOrderResponse.payment_method:type_name -> order.v1.PaymentMethod
+	0,  // 2: order.v1.GetOrderResponse.status:type_name -> order.v1.OrderStatus
+	7,  // 3: order.v1.GetOrderResponse.items:type_name -> order.v1.OrderItem
+	0,  // 4: order.v1.ListOrdersRequest.statuses:type_name -> order.v1.OrderStatus
+	16, // 5: order.v1.ListOrdersRequest.created_from:type_name -> google.protobuf.Timestamp
+	16, // 6: order.v1.ListOrdersRequest.created_to:type_name -> google.protobuf.Timestamp
+	16, // 7: order.v1.ListOrdersRequest.updated_from:type_name -> google.protobuf.Timestamp
+	16, // 8: order.v1.ListOrdersRequest.updated_to:type_name -> google.protobuf.Timestamp
+	6,  // 9: order.v1.ListOrdersResponse.orders:type_name -> order.v1.GetOrderResponse
+	12, // 10: order.v1.GetOrderHistoryResponse.entries:type_name -> order.v1.OrderStatusHistoryEntry
+	0,  // 11: order.v1.OrderStatusHistoryEntry.from_status:type_name -> order.v1.OrderStatus
+	0,  // 12: order.v1.OrderStatusHistoryEntry.to_status:type_name -> order.v1.OrderStatus
+	16, // 13: order.v1.OrderStatusHistoryEntry.changed_at:type_name -> google.protobuf.Timestamp
+	1,  // 14: order.v1.PayOrderRequest.payment_method:type_name -> order.v1.PaymentMethod
+	0,  // 15: order.v1.PayOrderResponse.status:type_name -> order.v1.OrderStatus
+	2,  // 16: order.v1.OrderService.CreateOrder:input_type -> order.v1.CreateOrderRequest
+	8,  // 17: order.v1.OrderService.ListOrders:input_type -> order.v1.ListOrdersRequest
+	5,  // 18: order.v1.OrderService.GetOrder:input_type -> order.v1.GetOrderRequest
+	10, // 19: order.v1.OrderService.GetOrderHistory:input_type -> order.v1.GetOrderHistoryRequest
+	13, // 20: order.v1.OrderService.PayOrder:input_type -> order.v1.PayOrderRequest
+	15, // 21: order.v1.OrderService.CancelOrder:input_type -> order.v1.CancelOrderRequest
+	4,  // 22: order.v1.OrderService.CreateOrder:output_type -> order.v1.CreateOrderResponse
+	9,  // 23: order.v1.OrderService.ListOrders:output_type -> order.v1.ListOrdersResponse
+	6,  // 24: order.v1.OrderService.GetOrder:output_type -> order.v1.GetOrderResponse
+	11, // 25: order.v1.OrderService.GetOrderHistory:output_type -> order.v1.GetOrderHistoryResponse
+	14, // 26: order.v1.OrderService.PayOrder:output_type -> order.v1.PayOrderResponse
+	17, // 27: order.v1.OrderService.CancelOrder:output_type -> google.protobuf.Empty
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_order_v1_order_proto_init() }
@@ -639,14 +1224,15 @@ func file_order_v1_order_proto_init() {
 	if File_order_v1_order_proto != nil {
 		return
 	}
-	file_order_v1_order_proto_msgTypes[3].OneofWrappers = []any{}
+	file_order_v1_order_proto_msgTypes[4].OneofWrappers = []any{}
+	file_order_v1_order_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_v1_order_proto_rawDesc), len(file_order_v1_order_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   7,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},